@@ -0,0 +1,123 @@
+// Package batchsplit splits batch writes exceeding the record limits of the provider catalog
+// into several consecutive requests.
+//
+// Limits come from providers.BatchWriteSupport, an object specific limit takes precedence over the default one.
+// Results of the requests are merged in the order of records.
+// Only batches which explicitly allow partial success (AllOrNone set to false) are split.
+// Batches which must be applied as a whole cannot be split and are rejected instead,
+// this includes batches leaving AllOrNone to the provider default, which is all or none for some providers.
+//
+// When a request fails with an error, the records which were not sent yet are reported as failures
+// with ErrBatchUnprocessedRecord. Records of the requests which already succeeded stay written.
+// Every record has its result, records missing in the result of a request are reported as unprocessed too.
+package batchsplit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+)
+
+// ErrBatchTooLarge is returned when a batch not allowing partial success exceeds the record limit.
+var ErrBatchTooLarge = errors.New("batch exceeds the record limit and cannot be split")
+
+var _ connectors.BatchWriteConnector = &BatchWriteConnector{}
+
+// BatchWriteConnector decorates connectors.BatchWriteConnector, splitting oversized batches.
+type BatchWriteConnector struct {
+	connectors.BatchWriteConnector
+
+	support *providers.BatchWriteSupport
+}
+
+// NewBatchWriteConnector wraps the connector, batches are split according to the catalog support.
+func NewBatchWriteConnector(
+	conn connectors.BatchWriteConnector, support *providers.BatchWriteSupport,
+) *BatchWriteConnector {
+	return &BatchWriteConnector{
+		BatchWriteConnector: conn,
+		support:             support,
+	}
+}
+
+func (c *BatchWriteConnector) BatchWrite(
+	ctx context.Context, params *common.BatchWriteParam,
+) (*common.BatchWriteResult, error) {
+	size := params.Size()
+
+	limit := c.support.RecordLimit(params.Type, params.ObjectName.String())
+	if limit < 1 || size <= limit {
+		return c.BatchWriteConnector.BatchWrite(ctx, params)
+	}
+
+	if params.AllOrNone == nil || *params.AllOrNone {
+		return nil, fmt.Errorf("%w: %v records, limit for %v is %v",
+			ErrBatchTooLarge, size, params.ObjectName, limit)
+	}
+
+	var (
+		results   = make([]common.WriteResult, 0, size)
+		failures  []any
+		successes int
+	)
+
+	for start := 0; start < size; start += limit {
+		end := min(start+limit, size)
+
+		result, err := c.BatchWriteConnector.BatchWrite(ctx, chunk(params, start, end))
+		if err != nil {
+			if start == 0 {
+				// Nothing was written, the whole batch can be retried.
+				return nil, err
+			}
+
+			// Records of this and the following chunks are not processed.
+			failures = append(failures, err)
+			results = appendUnprocessed(results, size-start)
+
+			break
+		}
+
+		if len(result.Results) > end-start {
+			return nil, fmt.Errorf("%w: %v results for %v records",
+				errors.Join(common.ErrInvalidImplementation, common.ErrNumWriteResultExceedsTotalRecords),
+				len(result.Results), end-start)
+		}
+
+		results = append(results, result.Results...)
+		results = appendUnprocessed(results, end-start-len(result.Results))
+		failures = append(failures, result.Errors...)
+		successes += result.SuccessCount
+	}
+
+	return common.NewBatchWriteResult(results, successes, size, failures)
+}
+
+// appendUnprocessed reports the given number of records as never processed by the provider.
+func appendUnprocessed(results []common.WriteResult, count int) []common.WriteResult {
+	for range count {
+		results = append(results, common.WriteResult{
+			Success: false,
+			Errors:  []any{common.ErrBatchUnprocessedRecord},
+		})
+	}
+
+	return results
+}
+
+// chunk returns parameters writing records within [start, end).
+func chunk(params *common.BatchWriteParam, start, end int) *common.BatchWriteParam {
+	part := *params
+
+	if params.IsDelete() {
+		part.RecordIds = params.RecordIds[start:end]
+	} else {
+		part.Batch = params.Batch[start:end]
+	}
+
+	return &part
+}
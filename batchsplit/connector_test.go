@@ -0,0 +1,242 @@
+package batchsplit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/goutils"
+	"github.com/amp-labs/connectors/providers"
+)
+
+var errProvider = errors.New("provider is unavailable")
+
+func testSupport() *providers.BatchWriteSupport {
+	return &providers.BatchWriteSupport{
+		Create: providers.BatchWriteSupportConfig{
+			DefaultRecordLimit: goutils.Pointer(3),
+			ObjectRecordLimits: &map[string]int{"leads": 2},
+			Supported:          true,
+		},
+		Delete: providers.BatchWriteSupportConfig{
+			DefaultRecordLimit: goutils.Pointer(2),
+			Supported:          true,
+		},
+	}
+}
+
+func records(names ...string) common.BatchItems {
+	items := make(common.BatchItems, len(names))
+	for index, name := range names {
+		items[index] = common.BatchItem{Record: map[string]any{"name": name}}
+	}
+
+	return items
+}
+
+func TestBatchIsSplitByObjectLimit(t *testing.T) {
+	t.Parallel()
+
+	conn := &batchConnector{}
+	split := NewBatchWriteConnector(conn, testSupport())
+
+	result, err := split.BatchWrite(t.Context(), &common.BatchWriteParam{
+		ObjectName: "leads",
+		Type:       common.BatchWriteTypeCreate,
+		Batch:      records("A", "B", "C", "D", "E"),
+		AllOrNone:  goutils.Pointer(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conn.calls) != 3 || len(conn.calls[0].Batch) != 2 || len(conn.calls[2].Batch) != 1 {
+		t.Fatalf("expected batches of 2, 2 and 1 records, got %v", conn.calls)
+	}
+
+	if result.Status != common.BatchStatusSuccess || result.SuccessCount != 5 {
+		t.Fatalf("unexpected batch status %v with %v successes", result.Status, result.SuccessCount)
+	}
+
+	if result.Results[0].RecordId != "A" || result.Results[4].RecordId != "E" {
+		t.Fatalf("results are not in the order of records: %v", result.Results)
+	}
+}
+
+func TestSmallBatchIsPassedThrough(t *testing.T) {
+	t.Parallel()
+
+	conn := &batchConnector{}
+	split := NewBatchWriteConnector(conn, testSupport())
+
+	if _, err := split.BatchWrite(t.Context(), &common.BatchWriteParam{
+		ObjectName: "contacts",
+		Type:       common.BatchWriteTypeCreate,
+		Batch:      records("A", "B", "C"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conn.calls) != 1 {
+		t.Fatalf("expected a single call, got %v", len(conn.calls))
+	}
+}
+
+func TestAllOrNoneBatchIsNotSplit(t *testing.T) {
+	t.Parallel()
+
+	// Provider default may be all or none, therefore only explicit partial success allows splitting.
+	for _, allOrNone := range []*bool{goutils.Pointer(true), nil} {
+		conn := &batchConnector{}
+		split := NewBatchWriteConnector(conn, testSupport())
+
+		_, err := split.BatchWrite(t.Context(), &common.BatchWriteParam{
+			ObjectName: "contacts",
+			Type:       common.BatchWriteTypeCreate,
+			Batch:      records("A", "B", "C", "D"),
+			AllOrNone:  allOrNone,
+		})
+		if !errors.Is(err, ErrBatchTooLarge) {
+			t.Fatalf("expected too large batch error, got %v", err)
+		}
+
+		if len(conn.calls) != 0 {
+			t.Fatalf("expected no calls, got %v", len(conn.calls))
+		}
+	}
+}
+
+func TestFailedChunkStopsWriting(t *testing.T) {
+	t.Parallel()
+
+	conn := &batchConnector{failAt: 1}
+	split := NewBatchWriteConnector(conn, testSupport())
+
+	result, err := split.BatchWrite(t.Context(), &common.BatchWriteParam{
+		ObjectName: "contacts",
+		Type:       common.BatchWriteTypeDelete,
+		RecordIds:  []string{"1", "2", "3", "4", "5"},
+		AllOrNone:  goutils.Pointer(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conn.calls) != 2 {
+		t.Fatalf("expected writing to stop after the failure, got %v calls", len(conn.calls))
+	}
+
+	if result.Status != common.BatchStatusPartial || result.SuccessCount != 2 || result.FailureCount != 3 {
+		t.Fatalf("unexpected batch status %v with %v successes and %v failures",
+			result.Status, result.SuccessCount, result.FailureCount)
+	}
+
+	if len(result.Errors) != 1 || !errors.Is(result.Errors[0].(error), errProvider) { // nolint:forcetypeassert
+		t.Fatalf("expected provider error, got %v", result.Errors)
+	}
+
+	// Records which were never sent have their results.
+	if len(result.Results) != 5 || result.Results[1].RecordId != "2" {
+		t.Fatalf("expected a result for every record, got %v", result.Results)
+	}
+
+	for _, unsent := range result.Results[2:] {
+		if unsent.Success || len(unsent.Errors) != 1 || unsent.Errors[0] != common.ErrBatchUnprocessedRecord {
+			t.Fatalf("expected unprocessed record, got %v", unsent)
+		}
+	}
+
+	// Failure of the first chunk is returned as is.
+	conn = &batchConnector{failAt: 0}
+	split = NewBatchWriteConnector(conn, testSupport())
+
+	if _, err = split.BatchWrite(t.Context(), &common.BatchWriteParam{
+		ObjectName: "contacts",
+		Type:       common.BatchWriteTypeDelete,
+		RecordIds:  []string{"1", "2", "3"},
+		AllOrNone:  goutils.Pointer(false),
+	}); !errors.Is(err, errProvider) {
+		t.Fatalf("expected provider error, got %v", err)
+	}
+}
+
+func TestMissingResultsArePadded(t *testing.T) {
+	t.Parallel()
+
+	conn := &batchConnector{omitLast: true}
+	split := NewBatchWriteConnector(conn, testSupport())
+
+	result, err := split.BatchWrite(t.Context(), &common.BatchWriteParam{
+		ObjectName: "leads",
+		Type:       common.BatchWriteTypeCreate,
+		Batch:      records("A", "B", "C", "D", "E"),
+		AllOrNone:  goutils.Pointer(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Every chunk misses the result of its last record.
+	expected := []string{"A", "", "C", "", ""}
+
+	if len(result.Results) != len(expected) {
+		t.Fatalf("expected a result for every record, got %v", result.Results)
+	}
+
+	for index, recordID := range expected {
+		written := result.Results[index]
+
+		if recordID == "" {
+			if written.Success || len(written.Errors) != 1 || written.Errors[0] != common.ErrBatchUnprocessedRecord {
+				t.Fatalf("expected unprocessed record at %v, got %v", index, written)
+			}
+		} else if written.RecordId != recordID {
+			t.Fatalf("expected record %v at %v, got %v", recordID, index, written)
+		}
+	}
+
+	if result.Status != common.BatchStatusPartial || result.SuccessCount != 2 || result.FailureCount != 3 {
+		t.Fatalf("unexpected batch status %v with %v successes and %v failures",
+			result.Status, result.SuccessCount, result.FailureCount)
+	}
+}
+
+// batchConnector writes records using their name or identifier as record identifier.
+type batchConnector struct {
+	connectors.Connector
+
+	calls []*common.BatchWriteParam
+	// failAt is the index of the delete call failing with errProvider.
+	failAt int
+	// omitLast drops the result of the last record of every call.
+	omitLast bool
+}
+
+func (c *batchConnector) BatchWrite(
+	_ context.Context, params *common.BatchWriteParam,
+) (*common.BatchWriteResult, error) {
+	c.calls = append(c.calls, params)
+
+	if params.IsDelete() && len(c.calls)-1 == c.failAt {
+		return nil, errProvider
+	}
+
+	results := make([]common.WriteResult, 0, params.Size())
+
+	for _, item := range params.Batch {
+		name, _ := item.Record["name"].(string)
+		results = append(results, common.WriteResult{Success: true, RecordId: name})
+	}
+
+	for _, identifier := range params.RecordIds {
+		results = append(results, common.WriteResult{Success: true, RecordId: identifier})
+	}
+
+	if c.omitLast {
+		results = results[:len(results)-1]
+	}
+
+	return common.NewBatchWriteResult(results, len(results), len(results), nil)
+}
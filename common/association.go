@@ -0,0 +1,109 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+)
+
+var (
+	// ErrInvalidAssociationInput is returned when the association to write is malformed.
+	ErrInvalidAssociationInput = errors.New("invalid association input")
+
+	// ErrUnsupportedAssociation is returned when the connector cannot link the objects.
+	ErrUnsupportedAssociation = errors.New("association is not supported")
+)
+
+// AssociationInput links the written record with another record.
+// It is the write side counterpart of Association.
+//
+// Connectors write associations either as part of the record, where the link is a lookup field
+// or the provider accepts associations of created records, or with separate calls made after the record is written.
+// In the latter case failed associations don't undo the write,
+// the record is reported as not successful with the failure in WriteResult.Errors.
+type AssociationInput struct {
+	// TargetObject is the name of the associated object, e.g. "companies".
+	TargetObject string
+	// TargetId is the ID of the associated record.
+	TargetId string // required
+	// AssociationType names the relationship, its meaning is connector specific:
+	//	* HubSpot: association type ID, or Association.AssociationType as returned by reads.
+	//	  Default association is used when empty.
+	//	* Salesforce: lookup field, e.g. "AccountId".
+	//	* Pipedrive: lookup field, e.g. "org_id", derived from TargetObject when empty.
+	//	* Memstore: association field of the schema, derived from TargetObject when empty.
+	AssociationType string // optional
+	// Label is a user defined association label, for providers supporting labelled associations.
+	Label string // optional
+	// Operation tells if the link is created or removed, AssociationOperationAssociate is used when empty.
+	Operation AssociationOperation // optional
+}
+
+// AssociationOperation is the change applied to the link between records.
+type AssociationOperation string
+
+const (
+	AssociationOperationAssociate  AssociationOperation = "associate"
+	AssociationOperationDissociate AssociationOperation = "dissociate"
+)
+
+// IsDissociate tells if the link should be removed.
+func (a AssociationInput) IsDissociate() bool {
+	return a.Operation == AssociationOperationDissociate
+}
+
+// validateAssociations checks associations of a record.
+// Only existing records can be dissociated.
+func validateAssociations(associations []AssociationInput, isCreate bool) error {
+	for _, association := range associations {
+		if association.TargetId == "" {
+			return fmt.Errorf("%w: target id is required", ErrInvalidAssociationInput)
+		}
+
+		switch association.Operation {
+		case "", AssociationOperationAssociate:
+		case AssociationOperationDissociate:
+			if isCreate {
+				return fmt.Errorf("%w: cannot dissociate a record that is being created", ErrInvalidAssociationInput)
+			}
+		default:
+			return fmt.Errorf("%w: unknown operation %q", ErrInvalidAssociationInput, association.Operation)
+		}
+	}
+
+	return nil
+}
+
+// LookupResolver returns the record field holding the link and the value referencing the target.
+type LookupResolver func(association AssociationInput) (field string, value any, err error)
+
+// ApplyLookupAssociations is used by connectors where records are linked through lookup fields.
+// It returns a copy of the record where each association sets its lookup field,
+// while dissociation clears the field.
+func ApplyLookupAssociations(
+	record Record, associations []AssociationInput, resolve LookupResolver,
+) (Record, error) {
+	if len(associations) == 0 {
+		return record, nil
+	}
+
+	result := maps.Clone(record)
+	if result == nil {
+		result = make(Record)
+	}
+
+	for _, association := range associations {
+		field, value, err := resolve(association)
+		if err != nil {
+			return nil, err
+		}
+
+		if association.IsDissociate() {
+			value = nil
+		}
+
+		result[field] = value
+	}
+
+	return result, nil
+}
@@ -0,0 +1,172 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+var (
+	// ErrUnsupportedBulkOperation is returned when the provider cannot run the bulk operation.
+	ErrUnsupportedBulkOperation = errors.New("bulk operation is not supported")
+
+	// ErrMissingBulkData is returned when a bulk job has neither records nor CSV data, or has both.
+	ErrMissingBulkData = errors.New("bulk job requires either records or CSV data")
+
+	// ErrMissingExternalIdField is returned when an upsert doesn't name the field matching existing records.
+	ErrMissingExternalIdField = errors.New("external id field is required")
+
+	// ErrBulkJobNotFound is returned when the job doesn't exist or is no longer retained by the provider.
+	ErrBulkJobNotFound = errors.New("bulk job not found")
+
+	// ErrBulkJobNotFinished is returned when results are requested before the job reached a terminal state.
+	ErrBulkJobNotFinished = errors.New("bulk job is not finished")
+
+	// ErrBulkJobFinished is returned when a job in a terminal state is aborted.
+	ErrBulkJobFinished = errors.New("bulk job is already finished")
+)
+
+// BulkOperation is the kind of write applied to every row of a bulk job.
+type BulkOperation string
+
+const (
+	BulkOperationInsert BulkOperation = "insert"
+	BulkOperationUpdate BulkOperation = "update"
+	BulkOperationUpsert BulkOperation = "upsert"
+	BulkOperationDelete BulkOperation = "delete"
+)
+
+// BulkJobState is the provider neutral lifecycle of a bulk job.
+type BulkJobState string
+
+const (
+	// BulkJobStatePending means the job was accepted and waits to be processed.
+	BulkJobStatePending BulkJobState = "pending"
+	// BulkJobStateInProgress means rows are being processed.
+	BulkJobStateInProgress BulkJobState = "inProgress"
+	// BulkJobStateComplete means every row was processed, some rows may still have failed.
+	BulkJobStateComplete BulkJobState = "complete"
+	// BulkJobStateFailed means the job as a whole failed, see BulkJob.ErrorMessage.
+	BulkJobStateFailed BulkJobState = "failed"
+	// BulkJobStateAborted means the job was stopped before it was complete.
+	BulkJobStateAborted BulkJobState = "aborted"
+)
+
+// BulkJobRowIndexUnknown is the index of the result row which cannot be traced back to the submitted row.
+const BulkJobRowIndexUnknown = -1
+
+// IsTerminal tells if the job will not change its state anymore.
+func (s BulkJobState) IsTerminal() bool {
+	return s == BulkJobStateComplete || s == BulkJobStateFailed || s == BulkJobStateAborted
+}
+
+// BulkJobParams describes an asynchronous job writing many records of one object.
+// Rows come either from Records or from CSVData with a header row.
+type BulkJobParams struct {
+	// The name of the object we are writing, e.g. "Account".
+	ObjectName string // required
+
+	// Operation applied to every row.
+	Operation BulkOperation // required
+
+	// ExternalIdField is the field used to match existing records on upsert.
+	ExternalIdField string // required for upsert
+
+	// Records is a stream of rows, the position of the record is its row index.
+	// Connectors may iterate the sequence more than once, ex: to find CSV columns before rows are encoded,
+	// every iteration must yield the same records.
+	Records iter.Seq[Record]
+
+	// CSVData is the alternative to Records, the first line must be a header.
+	// Row index counts data lines, the header is not counted.
+	CSVData io.Reader
+}
+
+// BulkJob is the status of the asynchronous job.
+type BulkJob struct {
+	// ID identifies the job on the provider side.
+	ID string
+	// ObjectName is the object written by the job.
+	ObjectName string
+	// Operation is applied to every row.
+	Operation BulkOperation
+	// State is the lifecycle stage of the job.
+	State BulkJobState
+	// RecordsProcessed counts rows processed so far, including failed ones.
+	RecordsProcessed int
+	// RecordsFailed counts rows which were rejected.
+	RecordsFailed int
+	// ErrorMessage explains why the job failed.
+	ErrorMessage string
+}
+
+// BulkJobResultsParams identifies the finished job whose results are requested.
+//
+// Providers which don't report results in the order of rows trace them back to the submitted rows by their values.
+// Such providers read the submitted data once more, given either as Records or as CSVData.
+// Without it row indices may be BulkJobRowIndexUnknown.
+// Rows with identical values cannot be told apart, they are given indices in the order of submission.
+type BulkJobResultsParams struct {
+	// JobID is the identifier returned by SubmitBulkJob.
+	JobID string // required
+
+	// Records repeat the records of BulkJobParams in the same order.
+	Records iter.Seq[Record] // optional
+
+	// CSVData repeats the CSV of BulkJobParams.
+	CSVData io.Reader // optional
+}
+
+// BulkJobResultRow is an outcome of a single submitted row.
+type BulkJobResultRow struct {
+	// Index is the position of the row in the submitted data, starting at zero.
+	// BulkJobRowIndexUnknown is used when the provider result cannot be matched to the submitted row.
+	Index int
+	// Success tells if the row was written.
+	Success bool
+	// RecordId is the identifier of the written record, if known.
+	RecordId string
+	// Fields are row values echoed back by the provider.
+	Fields map[string]any
+	// Errors describe why the row was rejected.
+	Errors []any
+}
+
+// BulkJobResultHandler receives result rows one at a time.
+// Returning an error stops the iteration, the error is returned to the caller.
+type BulkJobResultHandler func(row BulkJobResultRow) error
+
+func (p BulkJobResultsParams) ValidateParams() error {
+	if len(p.JobID) == 0 {
+		return ErrBulkJobNotFound
+	}
+
+	if p.Records != nil && p.CSVData != nil {
+		return ErrMissingBulkData
+	}
+
+	return nil
+}
+
+func (p BulkJobParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	switch p.Operation {
+	case BulkOperationInsert, BulkOperationUpdate, BulkOperationDelete:
+	case BulkOperationUpsert:
+		if len(p.ExternalIdField) == 0 {
+			return ErrMissingExternalIdField
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedBulkOperation, p.Operation)
+	}
+
+	if (p.Records == nil) == (p.CSVData == nil) {
+		return ErrMissingBulkData
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+)
+
+// Names of credential values read by the standard auth types.
+// Custom auth reads values named after the custom inputs of the provider.
+const (
+	CredentialApiKey   = "apiKey"   // nolint:gosec
+	CredentialUsername = "username" // nolint:gosec
+	CredentialPassword = "password" // nolint:gosec
+)
+
+// ErrMissingCredential is returned when the credential source doesn't hold a required value.
+var ErrMissingCredential = errors.New("missing credential")
+
+// Credentials are secret values of the connection keyed by name, ex: CredentialApiKey.
+type Credentials map[string]string
+
+// Get returns the named value, an empty value is treated as missing.
+func (c Credentials) Get(name string) (string, error) {
+	value := c[name]
+	if value == "" {
+		return "", fmt.Errorf("%w: %s", ErrMissingCredential, name)
+	}
+
+	return value, nil
+}
+
+// CredentialSource loads the latest credentials of the connection, ex: from a secret store.
+// It is called whenever a request of an api key, basic or custom auth client is authenticated,
+// it must be safe for concurrent use. OAuth clients refresh their tokens instead.
+type CredentialSource interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialInvalidator is implemented by credential sources that keep credentials between calls.
+// Invalidate is called when the provider rejects credentials, the next call should load them again.
+type CredentialInvalidator interface {
+	Invalidate()
+}
+
+// CredentialSourceFunc adapts a function to the CredentialSource interface.
+type CredentialSourceFunc func(ctx context.Context) (Credentials, error)
+
+func (f CredentialSourceFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials is a credential source that never changes.
+type StaticCredentials Credentials
+
+func (s StaticCredentials) Credentials(context.Context) (Credentials, error) {
+	return Credentials(s), nil
+}
+
+// CachedCredentialSource keeps credentials loaded from the underlying source,
+// they are loaded again once the TTL passes or after they are invalidated.
+// Loading is serialized, concurrent requests share the result of a single load.
+type CachedCredentialSource struct {
+	source CredentialSource
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu       sync.Mutex
+	cached   Credentials
+	loadedAt time.Time
+}
+
+var (
+	_ CredentialSource      = &CachedCredentialSource{}
+	_ CredentialInvalidator = &CachedCredentialSource{}
+)
+
+// NewCachedCredentialSource caches credentials of the source.
+// Zero TTL keeps credentials until they are invalidated.
+func NewCachedCredentialSource(source CredentialSource, ttl time.Duration) *CachedCredentialSource {
+	return &CachedCredentialSource{
+		source: source,
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+func (s *CachedCredentialSource) Credentials(ctx context.Context) (Credentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && (s.ttl == 0 || s.now().Sub(s.loadedAt) < s.ttl) {
+		return maps.Clone(s.cached), nil
+	}
+
+	credentials, err := s.source.Credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cached = maps.Clone(credentials)
+	s.loadedAt = s.now()
+
+	return credentials, nil
+}
+
+// Invalidate drops cached credentials.
+func (s *CachedCredentialSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cached = nil
+}
+
+// InvalidateCredentials drops credentials cached by the source, if it caches any.
+func InvalidateCredentials(source CredentialSource) {
+	if invalidator, ok := source.(CredentialInvalidator); ok {
+		invalidator.Invalidate()
+	}
+}
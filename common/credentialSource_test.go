@@ -0,0 +1,71 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedCredentialSource(t *testing.T) {
+	t.Parallel()
+
+	var loads atomic.Int32
+
+	source := NewCachedCredentialSource(CredentialSourceFunc(func(context.Context) (Credentials, error) {
+		version := loads.Add(1)
+
+		return Credentials{CredentialApiKey: "key-" + string('0'+rune(version))}, nil
+	}), time.Minute)
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	source.now = func() time.Time { return now }
+
+	var waitGroup sync.WaitGroup
+
+	for range 10 {
+		waitGroup.Go(func() {
+			credentials, err := source.Credentials(t.Context())
+			assert.NoError(t, err)
+			assert.Equal(t, "key-1", credentials[CredentialApiKey])
+		})
+	}
+
+	waitGroup.Wait()
+	assert.Equal(t, int32(1), loads.Load(), "concurrent requests must share a single load")
+
+	// Credentials are loaded again when TTL passes.
+	now = now.Add(time.Minute)
+
+	credentials, err := source.Credentials(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", credentials[CredentialApiKey])
+
+	// Invalidation forces the load before TTL passes.
+	InvalidateCredentials(source)
+
+	credentials, err = source.Credentials(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "key-3", credentials[CredentialApiKey])
+}
+
+func TestCachedCredentialSourceError(t *testing.T) {
+	t.Parallel()
+
+	errStore := errors.New("secret store is unavailable")
+
+	source := NewCachedCredentialSource(CredentialSourceFunc(func(context.Context) (Credentials, error) {
+		return nil, errStore
+	}), 0)
+
+	_, err := source.Credentials(t.Context())
+	require.ErrorIs(t, err, errStore)
+
+	_, err = Credentials{}.Get(CredentialPassword)
+	require.ErrorIs(t, err, ErrMissingCredential)
+}
@@ -0,0 +1,147 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+var (
+	// ErrMissingScope means the credentials are valid but were not granted the required scope.
+	ErrMissingScope = errors.New("missing scope")
+
+	// ErrProviderUnreachable means the provider couldn't be reached or failed to respond.
+	ErrProviderUnreachable = errors.New("provider unreachable")
+
+	// ErrNoHealthCheck is returned when there is no way to probe the credentials.
+	ErrNoHealthCheck = errors.New("no credentials health check")
+)
+
+// CredentialsStatus is the outcome of the credentials health check.
+type CredentialsStatus string
+
+const (
+	CredentialsHealthy CredentialsStatus = "healthy"
+	// CredentialsExpired means the access token was rejected, a refresh may fix it.
+	CredentialsExpired CredentialsStatus = "expired"
+	// CredentialsRevoked means the grant is no longer valid, the user must reauthorize.
+	CredentialsRevoked CredentialsStatus = "revoked"
+	// CredentialsMissingScope means the user must reauthorize granting more scopes.
+	CredentialsMissingScope CredentialsStatus = "missingScope"
+	// CredentialsUnreachable means the check is inconclusive, it should be repeated later.
+	CredentialsUnreachable CredentialsStatus = "unreachable"
+	// CredentialsUnknown means the provider responded with an unexpected status code.
+	CredentialsUnknown CredentialsStatus = "unknown"
+)
+
+// CredentialsHealth describes the result of probing the credentials.
+type CredentialsHealth struct {
+	Status CredentialsStatus
+	// StatusCode of the probe response, zero when the provider didn't respond.
+	StatusCode int
+	// Err explains unhealthy status, it wraps one of ErrAccessToken, ErrInvalidGrant,
+	// ErrMissingScope, ErrProviderUnreachable or ErrUnknown.
+	Err error
+}
+
+// IsHealthy returns true if the credentials can be used.
+func (h CredentialsHealth) IsHealthy() bool {
+	return h.Status == CredentialsHealthy
+}
+
+// NewCredentialsHealth classifies the outcome of a health check request.
+// The requestErr is an error returned by the HTTP client, in which case the response is ignored.
+// Success status codes default to 200 and 204.
+func NewCredentialsHealth(rsp *http.Response, body []byte, requestErr error, successCodes []int) *CredentialsHealth {
+	if requestErr != nil {
+		return classifyCredentialsRequestError(requestErr)
+	}
+
+	if len(successCodes) == 0 {
+		successCodes = []int{http.StatusOK, http.StatusNoContent}
+	}
+
+	if slices.Contains(successCodes, rsp.StatusCode) {
+		return &CredentialsHealth{
+			Status:     CredentialsHealthy,
+			StatusCode: rsp.StatusCode,
+		}
+	}
+
+	health := &CredentialsHealth{
+		StatusCode: rsp.StatusCode,
+		Err:        InterpretError(rsp, body),
+	}
+
+	// Bearer token errors are described by RFC 6750, section 3.1.
+	authenticate := rsp.Header.Get("WWW-Authenticate")
+
+	switch {
+	case strings.Contains(authenticate, "insufficient_scope"):
+		health.Status = CredentialsMissingScope
+		health.Err = errors.Join(ErrMissingScope, health.Err)
+	case strings.Contains(string(body), "invalid_grant"):
+		health.Status = CredentialsRevoked
+		health.Err = errors.Join(ErrInvalidGrant, health.Err)
+	case rsp.StatusCode == http.StatusUnauthorized:
+		health.Status = CredentialsExpired
+	case rsp.StatusCode == http.StatusForbidden:
+		health.Status = CredentialsMissingScope
+		health.Err = errors.Join(ErrMissingScope, health.Err)
+	case rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= http.StatusInternalServerError:
+		health.Status = CredentialsUnreachable
+		health.Err = errors.Join(ErrProviderUnreachable, health.Err)
+	default:
+		health.Status = CredentialsUnknown
+		health.Err = fmt.Errorf("%w: unexpected status code %d", ErrUnknown, rsp.StatusCode)
+	}
+
+	return health
+}
+
+// classifyCredentialsRequestError handles failures to get a response.
+// The token refresh happens inside the client, therefore an invalid grant surfaces here.
+func classifyCredentialsRequestError(err error) *CredentialsHealth {
+	if err = transformOauth2LibraryError(err); errors.Is(err, ErrInvalidGrant) {
+		return &CredentialsHealth{
+			Status: CredentialsRevoked,
+			Err:    err,
+		}
+	}
+
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.Response != nil {
+		// Token endpoint rejected the refresh for other reasons, ex: revoked client.
+		if code := retrieveErr.Response.StatusCode; code == http.StatusBadRequest || code == http.StatusUnauthorized {
+			return &CredentialsHealth{
+				Status: CredentialsRevoked,
+				Err:    errors.Join(ErrInvalidGrant, err),
+			}
+		}
+	}
+
+	if errors.Is(err, ErrMissingRefreshToken) {
+		return &CredentialsHealth{
+			Status: CredentialsExpired,
+			Err:    errors.Join(ErrAccessToken, err),
+		}
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return &CredentialsHealth{
+			Status: CredentialsUnreachable,
+			Err:    errors.Join(ErrProviderUnreachable, err),
+		}
+	}
+
+	return &CredentialsHealth{
+		Status: CredentialsUnknown,
+		Err:    errors.Join(ErrUnknown, err),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/amp-labs/connectors/common/logging"
@@ -35,26 +36,56 @@ func (j *JSONHTTPClient) PutCSV(ctx context.Context, url string, reqBody []byte,
 	return body, nil
 }
 
+// PutCSVStream uploads CSV read from the reader, the data is never held in memory as a whole.
+// The request fails with the error returned by the reader.
+func (j *JSONHTTPClient) PutCSVStream(
+	ctx context.Context, url string, reqBody io.Reader, headers ...Header,
+) ([]byte, error) {
+	fullURL, err := j.HTTPClient.getURL(url)
+	if err != nil {
+		return nil, j.ErrorPostProcessor.handleError(err)
+	}
+
+	req, err := makeTextCSVPutRequest(ctx, fullURL, headers, reqBody)
+	if err != nil {
+		return nil, j.ErrorPostProcessor.handleError(err)
+	}
+
+	_, body, err := j.sendCSV(ctx, req, fullURL, nil) // nolint:bodyclose
+	if err != nil {
+		return nil, j.ErrorPostProcessor.handleError(err)
+	}
+
+	return body, nil
+}
+
 func (j *JSONHTTPClient) httpPutCSV(ctx context.Context, url string,
 	headers []Header, body []byte,
 ) (*http.Response, []byte, error) {
-	req, err := makeTextCSVPutRequest(ctx, url, headers, body)
+	req, err := makeTextCSVPutRequest(ctx, url, headers, bytes.NewReader(body))
 	if err != nil {
 		return nil, nil, err
 	}
 
+	return j.sendCSV(ctx, req, url, body)
+}
+
+// sendCSV sends the upload request, the body is logged only when given.
+func (j *JSONHTTPClient) sendCSV(ctx context.Context, req *http.Request,
+	url string, body []byte,
+) (*http.Response, []byte, error) {
 	correlationId := uuid.Must(uuid.NewRandom()).String()
 
-	if logging.IsVerboseLogging(ctx) {
+	if logging.IsVerboseLogging(ctx) && body != nil {
 		logRequestWithBody(logging.VerboseLogger(ctx), req, "PUT", correlationId, url, body)
 	} else {
 		logRequestWithoutBody(logging.Logger(ctx), req, "PUT", correlationId, url)
 	}
 
-	rsp, body, err := j.HTTPClient.sendRequest(req)
+	rsp, rspBody, err := j.HTTPClient.sendRequest(req)
 
 	if logging.IsVerboseLogging(ctx) {
-		logResponseWithBody(logging.VerboseLogger(ctx), rsp, "PUT", correlationId, url, body)
+		logResponseWithBody(logging.VerboseLogger(ctx), rsp, "PUT", correlationId, url, rspBody)
 	} else {
 		logResponseWithoutBody(logging.Logger(ctx), rsp, "PUT", correlationId, url)
 	}
@@ -67,11 +98,11 @@ func (j *JSONHTTPClient) httpPutCSV(ctx context.Context, url string,
 		return nil, nil, err
 	}
 
-	return rsp, body, nil
+	return rsp, rspBody, nil
 }
 
-func makeTextCSVPutRequest(ctx context.Context, url string, headers []Header, body []byte) (*http.Request, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(body))
+func makeTextCSVPutRequest(ctx context.Context, url string, headers []Header, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
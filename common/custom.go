@@ -103,6 +103,18 @@ func WithCustomDynamicQueryParams(f DynamicQueryParamsGenerator) CustomAuthClien
 	}
 }
 
+// WithCustomDynamicAuth sets a function that will be called on every request to
+// get additional headers and query params at once. Use this when both are derived
+// from the same external authority, which then is consulted once per request.
+func WithCustomDynamicAuth(f DynamicAuthGenerator) CustomAuthClientOption {
+	return func(params *customClientParams) {
+		params.dynamicAuth = f
+	}
+}
+
+// DynamicAuthGenerator is a function that returns additional headers and query params for a request.
+type DynamicAuthGenerator func(*http.Request) (Headers, QueryParams, error)
+
 // oauthClientParams is the internal configuration for the oauth http client.
 type customClientParams struct {
 	client         *http.Client
@@ -110,6 +122,7 @@ type customClientParams struct {
 	params         QueryParams
 	dynamicHeaders DynamicHeadersGenerator
 	dynamicParams  DynamicQueryParamsGenerator
+	dynamicAuth    DynamicAuthGenerator
 	debug          func(req *http.Request, rsp *http.Response)
 	unauthorized   func(hdrs []Header, params []QueryParam, req *http.Request, rsp *http.Response) (*http.Response, error)
 	isUnauthorized func(rsp *http.Response) (bool, error)
@@ -131,6 +144,7 @@ func newCustomAuthClient(_ context.Context, params *customClientParams) Authenti
 		dynamicHeaders: params.dynamicHeaders,
 		params:         params.params,
 		dynamicParams:  params.dynamicParams,
+		dynamicAuth:    params.dynamicAuth,
 		debug:          params.debug,
 		unauthorized:   params.unauthorized,
 		isUnauthorized: params.isUnauthorized,
@@ -143,6 +157,7 @@ type customAuthClient struct {
 	params         QueryParams
 	dynamicHeaders DynamicHeadersGenerator
 	dynamicParams  DynamicQueryParamsGenerator
+	dynamicAuth    DynamicAuthGenerator
 	debug          func(req *http.Request, rsp *http.Response)
 	unauthorized   func(hdrs []Header, params []QueryParam, req *http.Request, rsp *http.Response) (*http.Response, error)
 	isUnauthorized func(rsp *http.Response) (bool, error)
@@ -212,6 +227,19 @@ func (c *customAuthClient) Do(req *http.Request) (*http.Response, error) {
 		params.ApplyToRequest(req2)
 	}
 
+	if c.dynamicAuth != nil {
+		authHeaders, authParams, err := c.dynamicAuth(req2)
+		if err != nil {
+			return nil, err
+		}
+
+		authHeaders.ApplyToRequest(req2)
+
+		if len(authParams) != 0 {
+			authParams.ApplyToRequest(req2)
+		}
+	}
+
 	modifier, hasModifier := getRequestModifier(req2.Context()) //nolint:contextcheck
 	if hasModifier {
 		modifier(req2)
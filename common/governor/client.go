@@ -0,0 +1,75 @@
+package governor
+
+import (
+	"io"
+	"net/http"
+)
+
+// HTTPClient is the subset of common.AuthenticatedHTTPClient, which is governed.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+	CloseIdleConnections()
+}
+
+// Client sends requests within the limits of the governor.
+// It satisfies common.AuthenticatedHTTPClient.
+type Client struct {
+	client   HTTPClient
+	governor *Governor
+}
+
+// NewClient wraps the client, so that every request waits for the governor.
+// A request stays in flight until its response body is closed.
+func NewClient(client HTTPClient, governor *Governor) *Client {
+	return &Client{
+		client:   client,
+		governor: governor,
+	}
+}
+
+// Governor returns the governor of the client.
+func (c *Client) Governor() *Governor {
+	return c.governor
+}
+
+// Of returns the governor of a client created by NewClient, otherwise nil.
+func Of(client any) *Governor {
+	if governed, ok := client.(*Client); ok {
+		return governed.governor
+	}
+
+	return nil
+}
+
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	release, err := c.governor.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.client.Do(req)
+	if err != nil || rsp == nil || rsp.Body == nil {
+		release()
+
+		return rsp, err
+	}
+
+	rsp.Body = &releasingBody{ReadCloser: rsp.Body, release: release}
+
+	return rsp, nil
+}
+
+func (c *Client) CloseIdleConnections() {
+	c.client.CloseIdleConnections()
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+
+	return b.ReadCloser.Close()
+}
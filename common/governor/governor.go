@@ -0,0 +1,211 @@
+// Package governor limits the pace and the concurrency of requests made on behalf of a connection.
+//
+// A Governor combines a token bucket (requests per second with a burst) and a cap on requests in flight.
+// Governors are shared by provider and workspace via Registry, so that several connectors
+// talking to the same tenant draw from the same budget instead of starving each other.
+//
+// connector.New wraps the authenticated client of common.ConnectorParams with NewClient
+// when the Governor field is set, so every connector it builds sends requests through the governor.
+// Connectors constructed directly must be given a client wrapped by NewClient.
+// Fan-outs of the simultaneously package, which receive the governor via the context (see WithContext),
+// don't run more jobs than the governor allows in flight.
+package governor
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limits describe the budget of a connection. Zero values mean no limit.
+type Limits struct {
+	// RequestsPerSecond is the rate at which tokens are added to the bucket.
+	RequestsPerSecond float64
+	// Burst is the capacity of the bucket. Defaults to 1 when only the rate is set.
+	Burst int
+	// MaxInFlight is the number of requests that can be awaiting a response at the same time.
+	MaxInFlight int
+}
+
+// normalized fills in defaults, so that limits can be compared with the ones of a governor.
+func (l Limits) normalized() Limits {
+	if l.RequestsPerSecond > 0 && l.Burst < 1 {
+		l.Burst = 1
+	}
+
+	return l
+}
+
+// Governor enforces Limits. A nil Governor imposes no limits.
+type Governor struct {
+	mu     sync.Mutex
+	limits Limits
+
+	// Token bucket state.
+	tokens  float64
+	updated time.Time
+
+	// Requests in flight and a channel closed whenever one completes.
+	inFlight int
+	released chan struct{}
+
+	now func() time.Time
+}
+
+// New creates a governor with the given limits.
+func New(limits Limits) *Governor {
+	governor := &Governor{
+		released: make(chan struct{}),
+		now:      time.Now,
+	}
+	governor.SetLimits(limits)
+
+	return governor
+}
+
+// SetLimits changes limits of a live governor, ex: after the license tier of a customer changes.
+// Requests already in flight are not affected.
+func (g *Governor) SetLimits(limits Limits) {
+	limits = limits.normalized()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.limits = limits
+	g.tokens = float64(limits.Burst)
+	g.updated = g.now()
+
+	g.notify()
+}
+
+// Limits returns the current limits.
+func (g *Governor) Limits() Limits {
+	if g == nil {
+		return Limits{}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.limits
+}
+
+// Concurrency returns how many jobs a fan-out should run at the same time.
+// Requests in flight allowed by the governor take precedence over the connector default,
+// which is used when the governor doesn't cap them.
+func (g *Governor) Concurrency(defaultConcurrency int) int {
+	if maxInFlight := g.Limits().MaxInFlight; maxInFlight > 0 {
+		return maxInFlight
+	}
+
+	return defaultConcurrency
+}
+
+// Acquire blocks until the request is allowed to be sent.
+// The returned function must be called once the response is consumed.
+func (g *Governor) Acquire(ctx context.Context) (func(), error) {
+	if g == nil {
+		return func() {}, nil
+	}
+
+	if err := g.acquireSlot(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := g.waitForToken(ctx); err != nil {
+		g.releaseSlot()
+
+		return nil, err
+	}
+
+	var once sync.Once
+
+	return func() { once.Do(g.releaseSlot) }, nil
+}
+
+func (g *Governor) acquireSlot(ctx context.Context) error {
+	for {
+		g.mu.Lock()
+
+		if g.limits.MaxInFlight < 1 || g.inFlight < g.limits.MaxInFlight {
+			g.inFlight++
+			g.mu.Unlock()
+
+			return nil
+		}
+
+		released := g.released
+		g.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+func (g *Governor) releaseSlot() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.inFlight--
+	g.notify()
+}
+
+// notify wakes up everyone waiting for a slot. Must be called holding the lock.
+func (g *Governor) notify() {
+	close(g.released)
+	g.released = make(chan struct{})
+}
+
+// waitForToken takes a token from the bucket, waiting for the refill if needed.
+// The token is reserved upfront, so that waiting requests are served in order.
+func (g *Governor) waitForToken(ctx context.Context) error {
+	delay := g.reserveToken()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		g.cancelToken()
+
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (g *Governor) reserveToken() time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	rate := g.limits.RequestsPerSecond
+	if rate <= 0 {
+		return 0
+	}
+
+	now := g.now()
+	g.tokens = min(float64(g.limits.Burst), g.tokens+now.Sub(g.updated).Seconds()*rate)
+	g.updated = now
+	g.tokens--
+
+	if g.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-g.tokens / rate * float64(time.Second))
+}
+
+func (g *Governor) cancelToken() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.limits.RequestsPerSecond > 0 {
+		g.tokens++
+	}
+}
@@ -0,0 +1,159 @@
+package governor
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernorMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	governor := New(Limits{MaxInFlight: 1})
+
+	release, err := governor.Acquire(t.Context())
+	require.NoError(t, err)
+
+	// The only slot is taken.
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = governor.Acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	acquired := make(chan struct{})
+
+	go func() {
+		next, err := governor.Acquire(t.Context())
+		if err == nil {
+			next()
+		}
+
+		close(acquired)
+	}()
+
+	release()
+	release() // Releasing twice is harmless.
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("waiting request was not admitted after release")
+	}
+}
+
+func TestGovernorTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(0, 0)
+	governor := &Governor{released: make(chan struct{}), now: func() time.Time { return now }}
+	governor.SetLimits(Limits{RequestsPerSecond: 2, Burst: 2})
+
+	// Burst is spent right away.
+	assert.Equal(t, time.Duration(0), governor.reserveToken())
+	assert.Equal(t, time.Duration(0), governor.reserveToken())
+
+	// Then requests are spaced by the rate.
+	assert.Equal(t, 500*time.Millisecond, governor.reserveToken())
+	assert.Equal(t, time.Second, governor.reserveToken())
+
+	// Cancelled reservation gives the token back.
+	governor.cancelToken()
+
+	now = now.Add(2 * time.Second)
+	assert.Equal(t, time.Duration(0), governor.reserveToken())
+}
+
+func TestGovernorConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var unlimited *Governor
+
+	assert.Equal(t, 2, unlimited.Concurrency(2))
+	assert.Equal(t, 2, New(Limits{RequestsPerSecond: 10}).Concurrency(2))
+	assert.Equal(t, 10, New(Limits{MaxInFlight: 10}).Concurrency(2))
+}
+
+func TestRegistryShared(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	key := Key{Provider: "netsuite", Workspace: "1234567"}
+
+	first := registry.Get(key, Limits{MaxInFlight: 5})
+	second := registry.Get(key, Limits{MaxInFlight: 15})
+	other := registry.Get(Key{Provider: "netsuite", Workspace: "7654321"}, Limits{MaxInFlight: 5})
+
+	assert.Same(t, first, second)
+	assert.NotSame(t, first, other)
+	assert.Equal(t, Limits{MaxInFlight: 15}, first.Limits())
+
+	registry.Remove(key)
+	assert.NotSame(t, first, registry.Get(key, Limits{}))
+}
+
+func TestRegistryKeepsBucket(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	key := Key{Provider: "netsuite", Workspace: "1234567"}
+
+	// Burst defaults to 1, looking the governor up with the same limits must not refill the bucket.
+	governor := registry.Get(key, Limits{RequestsPerSecond: 1})
+	assert.Equal(t, time.Duration(0), governor.reserveToken())
+
+	registry.Get(key, Limits{RequestsPerSecond: 1})
+	assert.Positive(t, governor.reserveToken())
+}
+
+type stubClient struct {
+	requests atomic.Int32
+}
+
+func (c *stubClient) Do(*http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func (c *stubClient) CloseIdleConnections() {}
+
+func TestClientReleasesOnBodyClose(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubClient{}
+	client := NewClient(stub, New(Limits{MaxInFlight: 1}))
+
+	assert.Same(t, client.Governor(), Of(client))
+	assert.Nil(t, Of(stub))
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://example.com", nil)
+	require.NoError(t, err)
+
+	rsp, err := client.Do(req)
+	require.NoError(t, err)
+
+	// Response is not consumed yet, so the request is still in flight.
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Do(req.WithContext(ctx))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, rsp.Body.Close())
+
+	rsp, err = client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, rsp.Body.Close())
+	assert.Equal(t, int32(2), stub.requests.Load())
+}
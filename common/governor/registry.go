@@ -0,0 +1,89 @@
+package governor
+
+import (
+	"context"
+	"sync"
+)
+
+// Key identifies the tenant whose budget is shared.
+type Key struct {
+	Provider  string
+	Workspace string
+}
+
+// Registry hands out one governor per key.
+type Registry struct {
+	mu        sync.Mutex
+	governors map[Key]*Governor
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		governors: make(map[Key]*Governor),
+	}
+}
+
+// Get returns the governor of the key, creating it on first use.
+// Limits of an existing governor are replaced, the latest caller knows the current tier best.
+// The bucket of the governor is refilled only when the limits actually change.
+func (r *Registry) Get(key Key, limits Limits) *Governor {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if governor, ok := r.governors[key]; ok {
+		if governor.Limits() != limits.normalized() {
+			governor.SetLimits(limits)
+		}
+
+		return governor
+	}
+
+	governor := New(limits)
+	r.governors[key] = governor
+
+	return governor
+}
+
+// Remove forgets the governor of the key, ex: once the connection is deleted.
+func (r *Registry) Remove(key Key) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.governors, key)
+}
+
+var defaultRegistry = NewRegistry() // nolint:gochecknoglobals
+
+// For returns the process wide governor of the provider workspace.
+func For(provider, workspace string, limits Limits) *Governor {
+	return defaultRegistry.Get(Key{Provider: provider, Workspace: workspace}, limits)
+}
+
+type contextKey string
+
+const governorContextKey contextKey = "governor"
+
+// WithContext attaches the governor to the context.
+func WithContext(ctx context.Context, governor *Governor) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if governor == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, governorContextKey, governor)
+}
+
+// FromContext returns the governor attached to the context, or nil.
+func FromContext(ctx context.Context) *Governor {
+	if ctx == nil {
+		return nil
+	}
+
+	governor, _ := ctx.Value(governorContextKey).(*Governor)
+
+	return governor
+}
@@ -13,6 +13,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/common/logging"
 	"github.com/google/uuid"
 )
@@ -133,6 +134,12 @@ type HTTPClient struct {
 	ShouldHandleError ShouldHandleError
 }
 
+// Governor returns the governor limiting requests of the client, nil when requests are not limited.
+// Fan-outs should attach it to the context, see governor.WithContext.
+func (h *HTTPClient) Governor() *governor.Governor {
+	return governor.Of(h.Client)
+}
+
 // getURL returns the base prefixed URL.
 func (h *HTTPClient) getURL(url string) (string, error) { // nolint:funcorder
 	return getURL(h.Base, url)
@@ -0,0 +1,28 @@
+package common
+
+// IdempotencyMode describes how a connector honours idempotency keys of WriteParams.
+// Keys of BatchItem are honoured only on the client side.
+type IdempotencyMode string
+
+const (
+	// IdempotencyModeNone means the key is ignored, retried writes may create duplicates.
+	IdempotencyModeNone IdempotencyMode = "none"
+	// IdempotencyModeNative means the key is forwarded to the provider, which deduplicates requests.
+	IdempotencyModeNative IdempotencyMode = "native"
+	// IdempotencyModeClientSide means results are remembered locally and repeated writes are not sent.
+	IdempotencyModeClientSide IdempotencyMode = "clientSide"
+)
+
+// IdempotencyHeader returns the header which passes the key to the provider.
+// Nothing is returned when the key is empty.
+func IdempotencyHeader(name, key string) []Header {
+	if key == "" {
+		return nil
+	}
+
+	return []Header{{
+		Key:   name,
+		Value: key,
+		Mode:  HeaderModeOverwrite,
+	}}
+}
@@ -13,6 +13,8 @@ const (
 	UpsertMetadataActionUpdate UpsertMetadataAction = "update"
 	// UpsertMetadataActionNone indicates that the object/field was not changed.
 	UpsertMetadataActionNone UpsertMetadataAction = "none"
+	// UpsertMetadataActionDelete indicates that the field was deleted or archived.
+	UpsertMetadataActionDelete UpsertMetadataAction = "delete"
 )
 
 // IsValid checks if the UpsertMetadataAction is known.
@@ -20,7 +22,8 @@ func (a UpsertMetadataAction) IsValid() bool {
 	switch a {
 	case UpsertMetadataActionCreate,
 		UpsertMetadataActionUpdate,
-		UpsertMetadataActionNone:
+		UpsertMetadataActionNone,
+		UpsertMetadataActionDelete:
 		return true
 	default:
 		return false
@@ -88,8 +91,34 @@ func (a AssociationOnDeleteAction) IsValid() bool {
 
 // UpsertMetadataParams represents parameters for upserting metadata.
 type UpsertMetadataParams struct {
+	// Maps object names to custom object definitions.
+	// Objects are upserted before fields, so that Fields can extend newly created objects.
+	Objects map[string]ObjectDefinition `json:"objects,omitempty"`
 	// Maps object names to field definitions.
 	Fields map[string][]FieldDefinition `json:"fields"`
+	// Maps object names to names of custom fields that should be removed.
+	// Providers that keep removed fields, such as HubSpot, archive them instead.
+	DeleteFields map[string][]string `json:"deleteFields,omitempty"`
+	// DryRun returns the planned changes without applying them.
+	// Actions of the result are resolved against the current schema of the provider.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// ObjectDefinition represents a custom object definition. Note that not all
+// providers will support all attributes. This is a best-effort attempt
+// to create a common schema for custom objects across providers.
+//
+// Unsupported attributes are ignored, and a warning is added to the ObjectUpsertResult.
+type ObjectDefinition struct {
+	// DisplayName is the singular human-readable name of the object, e.g. "Vehicle".
+	DisplayName string `json:"displayName"`
+	// PluralDisplayName is the plural human-readable name, e.g. "Vehicles".
+	// Derived from the DisplayName when omitted.
+	PluralDisplayName string `json:"pluralDisplayName,omitempty"`
+	// Description is an optional description of the object.
+	Description string `json:"description,omitempty"`
+	// PrimaryDisplayField identifies records in the provider UI and is created together with the object.
+	PrimaryDisplayField FieldDefinition `json:"primaryDisplayField"`
 }
 
 var ErrFieldTypeUnknown = errors.New("unrecognized field type")
@@ -247,6 +276,12 @@ type UpsertMetadataResult struct {
 	// Indicates if the upsert operation was successful.
 	Success bool `json:"success"`
 
+	// DryRun is true when actions describe planned changes that were not applied.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Maps object name -> upsert result of the custom object.
+	Objects map[string]ObjectUpsertResult `json:"objects,omitempty"`
+
 	// Maps object name -> field name -> upsert result.
 	Fields map[string]map[string]FieldUpsertResult `json:"fields"`
 }
@@ -266,3 +301,17 @@ type FieldUpsertResult struct {
 	// such as unsupported field attributes.
 	Warnings []string `json:"warnings,omitempty"`
 }
+
+// ObjectUpsertResult is the result of an upsert operation for a single custom object.
+type ObjectUpsertResult struct {
+	// ObjectName is the name of the object.
+	ObjectName string `json:"objectName"`
+	// Action indicates what action was taken (create, update, none).
+	Action UpsertMetadataAction `json:"action"`
+	// Metadata contains provider-specific metadata about the object (if any).
+	// Specific keys/values will vary by provider. Considered strictly informational.
+	Metadata map[string]any `json:"metadata,omitempty"`
+	// Warnings contains any warnings that occurred during the upsert operation,
+	// such as unsupported object attributes.
+	Warnings []string `json:"warnings,omitempty"`
+}
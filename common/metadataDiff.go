@@ -0,0 +1,193 @@
+package common
+
+import (
+	"maps"
+	"slices"
+)
+
+// ObjectMetadataDiff describes how the object schema changed between two snapshots.
+// Field names are sorted alphabetically.
+type ObjectMetadataDiff struct {
+	// ObjectRemoved tells that the object is gone, fields of the previous snapshot are listed as removed.
+	ObjectRemoved bool
+
+	AddedFields   []string
+	RemovedFields []string
+	ChangedFields []FieldMetadataChange
+}
+
+// FieldMetadataChange describes the change of a field present in both snapshots.
+// Type fields are populated only when the type changed, limit fields only when a limit changed.
+type FieldMetadataChange struct {
+	FieldName string
+
+	PreviousValueType ValueType
+	CurrentValueType  ValueType
+
+	PreviousProviderType string
+	CurrentProviderType  string
+
+	PreviousItemType ValueType
+	CurrentItemType  ValueType
+
+	// AddedValues and RemovedValues list picklist values by FieldValue.Value.
+	AddedValues   []string
+	RemovedValues []string
+
+	// AddedReferences and RemovedReferences list objects the reference field can point to.
+	AddedReferences   []string
+	RemovedReferences []string
+
+	PreviousMaxLength *int
+	CurrentMaxLength  *int
+
+	PreviousPrecision *int
+	CurrentPrecision  *int
+
+	PreviousScale *int
+	CurrentScale  *int
+}
+
+// IsTypeChanged returns true if either Ampersand, provider or array item type differs.
+func (c FieldMetadataChange) IsTypeChanged() bool {
+	return c.PreviousValueType != c.CurrentValueType || c.PreviousProviderType != c.CurrentProviderType ||
+		c.PreviousItemType != c.CurrentItemType
+}
+
+// IsLimitChanged returns true if maximum length, precision or scale differs.
+func (c FieldMetadataChange) IsLimitChanged() bool {
+	return !equalLimits(c.PreviousMaxLength, c.CurrentMaxLength) ||
+		!equalLimits(c.PreviousPrecision, c.CurrentPrecision) ||
+		!equalLimits(c.PreviousScale, c.CurrentScale)
+}
+
+// IsEmpty returns true if snapshots describe the same schema.
+func (d ObjectMetadataDiff) IsEmpty() bool {
+	return !d.ObjectRemoved && len(d.AddedFields) == 0 && len(d.RemovedFields) == 0 && len(d.ChangedFields) == 0
+}
+
+// DiffObjectMetadata compares the previous snapshot of object metadata with the current one.
+// Display names are not compared. Objects using the deprecated FieldsMap are compared by field names only.
+// Nil current snapshot means the object was removed.
+func DiffObjectMetadata(previous, current *ObjectMetadata) *ObjectMetadataDiff {
+	before := metadataFields(previous)
+	after := metadataFields(current)
+
+	diff := &ObjectMetadataDiff{
+		ObjectRemoved: previous != nil && current == nil,
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(after)) {
+		if _, ok := before[name]; !ok {
+			diff.AddedFields = append(diff.AddedFields, name)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(before)) {
+		field, ok := after[name]
+		if !ok {
+			diff.RemovedFields = append(diff.RemovedFields, name)
+
+			continue
+		}
+
+		if change, changed := diffFieldMetadata(name, before[name], field); changed {
+			diff.ChangedFields = append(diff.ChangedFields, change)
+		}
+	}
+
+	return diff
+}
+
+func diffFieldMetadata(name string, previous, current FieldMetadata) (FieldMetadataChange, bool) {
+	change := FieldMetadataChange{
+		FieldName:         name,
+		AddedValues:       fieldValuesDifference(current.Values, previous.Values),
+		RemovedValues:     fieldValuesDifference(previous.Values, current.Values),
+		AddedReferences:   stringsDifference(current.ReferenceTo, previous.ReferenceTo),
+		RemovedReferences: stringsDifference(previous.ReferenceTo, current.ReferenceTo),
+	}
+
+	if previous.ValueType != current.ValueType || previous.ProviderType != current.ProviderType ||
+		previous.ItemType != current.ItemType {
+		change.PreviousValueType = previous.ValueType
+		change.CurrentValueType = current.ValueType
+		change.PreviousProviderType = previous.ProviderType
+		change.CurrentProviderType = current.ProviderType
+		change.PreviousItemType = previous.ItemType
+		change.CurrentItemType = current.ItemType
+	}
+
+	if !equalLimits(previous.MaxLength, current.MaxLength) ||
+		!equalLimits(previous.Precision, current.Precision) ||
+		!equalLimits(previous.Scale, current.Scale) {
+		change.PreviousMaxLength = previous.MaxLength
+		change.CurrentMaxLength = current.MaxLength
+		change.PreviousPrecision = previous.Precision
+		change.CurrentPrecision = current.Precision
+		change.PreviousScale = previous.Scale
+		change.CurrentScale = current.Scale
+	}
+
+	changed := change.IsTypeChanged() || change.IsLimitChanged() ||
+		len(change.AddedValues) != 0 || len(change.RemovedValues) != 0 ||
+		len(change.AddedReferences) != 0 || len(change.RemovedReferences) != 0
+
+	return change, changed
+}
+
+// fieldValuesDifference returns values of the first list missing in the second one.
+func fieldValuesDifference(values, other []FieldValue) []string {
+	known := make(map[string]bool, len(other))
+	for _, value := range other {
+		known[value.Value] = true
+	}
+
+	var result []string
+
+	for _, value := range values {
+		if !known[value.Value] {
+			result = append(result, value.Value)
+		}
+	}
+
+	return result
+}
+
+// stringsDifference returns strings of the first list missing in the second one.
+func stringsDifference(values, other []string) []string {
+	var result []string
+
+	for _, value := range values {
+		if !slices.Contains(other, value) {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+// equalLimits compares optional limits, unknown limit is equal only to another unknown limit.
+func equalLimits(first, second *int) bool {
+	if first == nil || second == nil {
+		return first == second
+	}
+
+	return *first == *second
+}
+
+// metadataFields merges Fields with the deprecated FieldsMap.
+func metadataFields(metadata *ObjectMetadata) FieldsMetadata {
+	fields := make(FieldsMetadata)
+	if metadata == nil {
+		return fields
+	}
+
+	for name, displayName := range metadata.FieldsMap {
+		fields[name] = FieldMetadata{DisplayName: displayName}
+	}
+
+	maps.Copy(fields, metadata.Fields)
+
+	return fields
+}
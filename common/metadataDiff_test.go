@@ -0,0 +1,83 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffObjectMetadata(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	two, four, ten := 2, 4, 10
+
+	tests := []struct {
+		name     string
+		previous FieldMetadata
+		current  FieldMetadata
+		expected []FieldMetadataChange
+	}{
+		{
+			name:     "Same field",
+			previous: FieldMetadata{ValueType: ValueTypeFloat, Precision: &ten, Scale: &two},
+			current:  FieldMetadata{ValueType: ValueTypeFloat, Precision: &ten, Scale: &two},
+			expected: nil,
+		},
+		{
+			name:     "Array item type changed",
+			previous: FieldMetadata{ValueType: ValueTypeArray, ItemType: ValueTypeString},
+			current:  FieldMetadata{ValueType: ValueTypeArray, ItemType: ValueTypeInt},
+			expected: []FieldMetadataChange{{
+				FieldName:         "field",
+				PreviousValueType: ValueTypeArray,
+				CurrentValueType:  ValueTypeArray,
+				PreviousItemType:  ValueTypeString,
+				CurrentItemType:   ValueTypeInt,
+			}},
+		},
+		{
+			name:     "Scale changed",
+			previous: FieldMetadata{ValueType: ValueTypeFloat, Precision: &ten, Scale: &two},
+			current:  FieldMetadata{ValueType: ValueTypeFloat, Precision: &ten, Scale: &four},
+			expected: []FieldMetadataChange{{
+				FieldName:         "field",
+				PreviousPrecision: &ten,
+				CurrentPrecision:  &ten,
+				PreviousScale:     &two,
+				CurrentScale:      &four,
+			}},
+		},
+		{
+			name:     "Maximum length became unknown",
+			previous: FieldMetadata{ValueType: ValueTypeString, MaxLength: &ten},
+			current:  FieldMetadata{ValueType: ValueTypeString},
+			expected: []FieldMetadataChange{{
+				FieldName:         "field",
+				PreviousMaxLength: &ten,
+			}},
+		},
+		{
+			name:     "Reference targets changed",
+			previous: FieldMetadata{ValueType: ValueTypeReference, ReferenceTo: []string{"users", "groups"}},
+			current:  FieldMetadata{ValueType: ValueTypeReference, ReferenceTo: []string{"users", "queues"}},
+			expected: []FieldMetadataChange{{
+				FieldName:         "field",
+				AddedReferences:   []string{"queues"},
+				RemovedReferences: []string{"groups"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diff := DiffObjectMetadata(
+				&ObjectMetadata{Fields: FieldsMetadata{"field": tt.previous}},
+				&ObjectMetadata{Fields: FieldsMetadata{"field": tt.current}},
+			)
+
+			assert.Equal(t, tt.expected, diff.ChangedFields)
+		})
+	}
+}
@@ -44,6 +44,23 @@ func NewOAuthHTTPClient(ctx context.Context, opts ...OAuthOption) (Authenticated
 	return newOAuthClient(ctx, params), nil
 }
 
+// NewOAuthTokenSource returns the token source an OAuth client would be built with, given the same options.
+// Passing it to several clients with WithTokenSource lets them share the token,
+// so that rebuilding the client doesn't refresh the token again.
+func NewOAuthTokenSource(ctx context.Context, opts ...OAuthOption) (oauth2.TokenSource, error) { //nolint:ireturn
+	params := &oauthClientParams{}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	params, err := params.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	return newObservedTokenSource(ctx, params), nil
+}
+
 // oauthClientParams is the internal configuration for the oauth http client.
 type oauthClientParams struct {
 	client         *http.Client
@@ -55,6 +72,8 @@ type oauthClientParams struct {
 	unauthorized   func(token *oauth2.Token, req *http.Request, rsp *http.Response) (*http.Response, error)
 	debug          func(req *http.Request, rsp *http.Response)
 	isUnauthorized func(rsp *http.Response) (bool, error)
+	refreshGroup   *TokenRefreshGroup
+	connectionID   string
 }
 
 // WithOAuthClient sets the http client to use for the connector. Its usage is optional.
@@ -134,6 +153,16 @@ func WithTokenHeaderAttachment(tokenHeader *TokenHeaderAttachment) OAuthOption {
 	}
 }
 
+// WithTokenRefreshGroup serializes token refreshes with other clients of the same connection.
+// It is applied when the token is refreshed using the oauth config, not a custom token source.
+// Without it, clients created from the same refresh token share the refreshes within the process.
+func WithTokenRefreshGroup(group *TokenRefreshGroup, connectionID string) OAuthOption {
+	return func(params *oauthClientParams) {
+		params.refreshGroup = group
+		params.connectionID = connectionID
+	}
+}
+
 // prepare finalizes and validates the connector configuration, and returns an error if it's invalid.
 func (p *oauthClientParams) prepare() (*oauthClientParams, error) {
 	if p.client == nil {
@@ -150,22 +179,23 @@ func (p *oauthClientParams) prepare() (*oauthClientParams, error) {
 		}
 	}
 
+	if p.refreshGroup != nil && p.connectionID == "" {
+		return nil, ErrMissingConnectionID
+	}
+
+	if p.refreshGroup == nil && p.tokenSource == nil {
+		if key, ok := defaultRefreshKey(p.config, p.token); ok {
+			p.refreshGroup = defaultTokenRefreshGroup
+			p.connectionID = key
+		}
+	}
+
 	return p, nil
 }
 
 // newHTTPClient returns a new http client for the connector, with automatic OAuth authentication.
 func newOAuthClient(ctx context.Context, params *oauthClientParams) AuthenticatedHTTPClient { //nolint:ireturn
-	// This is how the key refresher accepts a custom http client
-	ctx = context.WithValue(ctx, oauth2.HTTPClient, params.client)
-
-	tokenSource := getTokenSource(ctx, params)
-	if params.tokenUpdated != nil {
-		tokenSource = &observableTokenSource{
-			tokenUpdated: params.tokenUpdated,
-			lastKnown:    params.token,
-			tokenSource:  tokenSource,
-		}
-	}
+	tokenSource := newObservedTokenSource(ctx, params)
 
 	// Returns a new client which automatically refreshes the access token
 	// whenever the current one expires.
@@ -181,6 +211,23 @@ func newOAuthClient(ctx context.Context, params *oauthClientParams) Authenticate
 	}
 }
 
+// newObservedTokenSource returns the token source, which reports refreshed tokens if requested.
+func newObservedTokenSource(ctx context.Context, params *oauthClientParams) oauth2.TokenSource { //nolint:ireturn
+	// This is how the key refresher accepts a custom http client
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, params.client)
+
+	tokenSource := getTokenSource(ctx, params)
+	if params.tokenUpdated != nil {
+		tokenSource = &observableTokenSource{
+			tokenUpdated: params.tokenUpdated,
+			lastKnown:    params.token,
+			tokenSource:  tokenSource,
+		}
+	}
+
+	return tokenSource
+}
+
 type oauth2Transport struct {
 	Source         oauth2.TokenSource
 	Header         *TokenHeaderAttachment
@@ -316,6 +363,10 @@ func getTokenSource(ctx context.Context, params *oauthClientParams) oauth2.Token
 		}
 	}
 
+	if params.refreshGroup != nil {
+		return params.refreshGroup.tokenSource(ctx, params.connectionID, params.config, params.token)
+	}
+
 	return params.config.TokenSource(ctx, params.token)
 }
 
@@ -386,10 +437,10 @@ func (w *observableTokenSource) HasChanged(tok *oauth2.Token) bool {
 		return true
 	}
 
-	return w.lastKnown.AccessToken == tok.AccessToken ||
-		w.lastKnown.RefreshToken == tok.RefreshToken ||
-		w.lastKnown.TokenType == tok.TokenType ||
-		w.lastKnown.Expiry.Equal(tok.Expiry)
+	return w.lastKnown.AccessToken != tok.AccessToken ||
+		w.lastKnown.RefreshToken != tok.RefreshToken ||
+		w.lastKnown.TokenType != tok.TokenType ||
+		!w.lastKnown.Expiry.Equal(tok.Expiry)
 }
 
 // TokenHeaderAttachment defines an HTTP header channel used to convey an
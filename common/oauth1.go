@@ -0,0 +1,359 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // HMAC-SHA1 is mandated by RFC 5849
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OAuth1SignatureMethod is the method used to sign OAuth 1.0a requests.
+type OAuth1SignatureMethod string
+
+const (
+	OAuth1HMACSHA1   OAuth1SignatureMethod = "HMAC-SHA1"
+	OAuth1HMACSHA256 OAuth1SignatureMethod = "HMAC-SHA256"
+	OAuth1PlainText  OAuth1SignatureMethod = "PLAINTEXT"
+)
+
+var (
+	ErrOAuth1MissingCredentials   = errors.New("oauth1 consumer key and secret are required")
+	ErrOAuth1UnsupportedSignature = errors.New("unsupported oauth1 signature method")
+)
+
+const oauth1NonceLength = 16
+
+// OAuth1Credentials are the consumer and token credentials used to sign requests.
+// The token pair is empty for two-legged (consumer only) authentication.
+type OAuth1Credentials struct {
+	ConsumerKey    string
+	ConsumerSecret string
+	Token          string
+	TokenSecret    string
+}
+
+// OAuth1Signer signs requests following RFC 5849 (OAuth 1.0a).
+// Every request gets a fresh nonce and timestamp, the signature covers the method,
+// the normalized URL, query parameters and form-encoded bodies.
+type OAuth1Signer struct {
+	Credentials OAuth1Credentials
+	// Method defaults to HMAC-SHA1 when empty.
+	Method OAuth1SignatureMethod
+	// Realm is an optional protection realm sent in the Authorization header. It's not signed.
+	Realm string
+
+	// Nonce and clock can be replaced to produce deterministic signatures.
+	nonce func() (string, error)
+	now   func() time.Time
+}
+
+// Headers produces the Authorization header for the request.
+// It matches DynamicHeadersGenerator, so the signer can be plugged into the custom auth client.
+func (s *OAuth1Signer) Headers(req *http.Request) ([]Header, error) {
+	authorization, err := s.Authorization(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Header{{
+		Key:   "Authorization",
+		Value: authorization,
+		Mode:  HeaderModeOverwrite,
+	}}, nil
+}
+
+// Authorization signs the request and returns the value of the Authorization header.
+// A form-encoded body is read to be signed and is restored afterwards.
+func (s *OAuth1Signer) Authorization(req *http.Request) (string, error) {
+	if s.Credentials.ConsumerKey == "" || s.Credentials.ConsumerSecret == "" {
+		return "", ErrOAuth1MissingCredentials
+	}
+
+	nonce, err := s.getNonce()
+	if err != nil {
+		return "", err
+	}
+
+	protocolParams := s.protocolParams(nonce, s.getNow())
+
+	requestParams, err := oauth1RequestParams(req)
+	if err != nil {
+		return "", err
+	}
+
+	baseString := oauth1SignatureBaseString(req.Method, req.URL,
+		append(requestParams, protocolParams...))
+
+	signature, err := s.sign(baseString)
+	if err != nil {
+		return "", err
+	}
+
+	protocolParams = append(protocolParams, oauth1Param{key: "oauth_signature", value: signature})
+
+	return s.authorizationHeader(protocolParams), nil
+}
+
+func (s *OAuth1Signer) protocolParams(nonce string, now time.Time) []oauth1Param {
+	params := []oauth1Param{
+		{key: "oauth_consumer_key", value: s.Credentials.ConsumerKey},
+		{key: "oauth_nonce", value: nonce},
+		{key: "oauth_signature_method", value: string(s.method())},
+		{key: "oauth_timestamp", value: strconv.FormatInt(now.Unix(), 10)},
+	}
+
+	// The optional oauth_version is omitted, servers assume 1.0.
+	if s.Credentials.Token != "" {
+		params = append(params, oauth1Param{key: "oauth_token", value: s.Credentials.Token})
+	}
+
+	return params
+}
+
+func (s *OAuth1Signer) sign(baseString string) (string, error) {
+	key := oauth1Escape(s.Credentials.ConsumerSecret) + "&" + oauth1Escape(s.Credentials.TokenSecret)
+
+	var newHash func() hash.Hash
+
+	switch s.method() {
+	case OAuth1HMACSHA1:
+		newHash = sha1.New
+	case OAuth1HMACSHA256:
+		newHash = sha256.New
+	case OAuth1PlainText:
+		return key, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrOAuth1UnsupportedSignature, s.Method)
+	}
+
+	mac := hmac.New(newHash, []byte(key))
+	mac.Write([]byte(baseString))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// authorizationHeader formats protocol parameters as described by RFC 5849, section 3.5.1.
+func (s *OAuth1Signer) authorizationHeader(params []oauth1Param) string {
+	parts := make([]string, 0, len(params)+1)
+
+	if s.Realm != "" {
+		parts = append(parts, fmt.Sprintf(`realm="%s"`, oauth1Escape(s.Realm)))
+	}
+
+	for _, param := range params {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, oauth1Escape(param.key), oauth1Escape(param.value)))
+	}
+
+	return "OAuth " + strings.Join(parts, ", ")
+}
+
+func (s *OAuth1Signer) method() OAuth1SignatureMethod {
+	if s.Method == "" {
+		return OAuth1HMACSHA1
+	}
+
+	return s.Method
+}
+
+func (s *OAuth1Signer) getNonce() (string, error) {
+	if s.nonce != nil {
+		return s.nonce()
+	}
+
+	buf := make([]byte, oauth1NonceLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate oauth1 nonce: %w", err)
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+func (s *OAuth1Signer) getNow() time.Time {
+	if s.now != nil {
+		return s.now()
+	}
+
+	return time.Now()
+}
+
+// NewOAuth1HTTPClient returns a new http client, which signs every request
+// with OAuth 1.0a credentials. Options of the custom auth client are accepted,
+// except for dynamic headers, which are taken by the signer.
+func NewOAuth1HTTPClient( //nolint:ireturn
+	ctx context.Context,
+	signer *OAuth1Signer,
+	opts ...CustomAuthClientOption,
+) (AuthenticatedHTTPClient, error) {
+	if signer.Credentials.ConsumerKey == "" || signer.Credentials.ConsumerSecret == "" {
+		return nil, ErrOAuth1MissingCredentials
+	}
+
+	if _, err := signer.sign(""); err != nil {
+		return nil, err
+	}
+
+	return NewCustomAuthHTTPClient(ctx, append(opts, WithCustomDynamicHeaders(signer.Headers))...)
+}
+
+type oauth1Param struct {
+	key   string
+	value string
+}
+
+// oauth1RequestParams collects query parameters and parameters of a form-encoded body.
+func oauth1RequestParams(req *http.Request) ([]oauth1Param, error) {
+	query, err := url.ParseQuery(req.URL.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query for oauth1 signature: %w", err)
+	}
+
+	params := oauth1ParamsFromValues(query)
+
+	if !isFormURLEncoded(req) {
+		return params, nil
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse form body for oauth1 signature: %w", err)
+	}
+
+	return append(params, oauth1ParamsFromValues(form)...), nil
+}
+
+func oauth1ParamsFromValues(values url.Values) []oauth1Param {
+	var params []oauth1Param
+
+	for key, list := range values {
+		for _, value := range list {
+			params = append(params, oauth1Param{key: key, value: value})
+		}
+	}
+
+	return params
+}
+
+func isFormURLEncoded(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+
+	return err == nil && mediaType == "application/x-www-form-urlencoded"
+}
+
+// readRequestBody returns the body and leaves the request readable for sending.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		reader, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		defer reader.Close()
+
+		return io.ReadAll(reader)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = req.Body.Close()
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
+
+// oauth1SignatureBaseString is described by RFC 5849, section 3.4.1.
+func oauth1SignatureBaseString(method string, requestURL *url.URL, params []oauth1Param) string {
+	encoded := make([]oauth1Param, len(params))
+	for index, param := range params {
+		encoded[index] = oauth1Param{key: oauth1Escape(param.key), value: oauth1Escape(param.value)}
+	}
+
+	slices.SortFunc(encoded, func(a, b oauth1Param) int {
+		if a.key != b.key {
+			return strings.Compare(a.key, b.key)
+		}
+
+		return strings.Compare(a.value, b.value)
+	})
+
+	pairs := make([]string, len(encoded))
+	for index, param := range encoded {
+		pairs[index] = param.key + "=" + param.value
+	}
+
+	return strings.Join([]string{
+		oauth1Escape(strings.ToUpper(method)),
+		oauth1Escape(oauth1BaseURI(requestURL)),
+		oauth1Escape(strings.Join(pairs, "&")),
+	}, "&")
+}
+
+// oauth1BaseURI drops the query, fragment and default ports, scheme and host are lower-cased.
+func oauth1BaseURI(requestURL *url.URL) string {
+	scheme := strings.ToLower(requestURL.Scheme)
+	host := strings.ToLower(requestURL.Hostname())
+
+	if port := requestURL.Port(); port != "" &&
+		!(scheme == "http" && port == "80") && !(scheme == "https" && port == "443") {
+		host += ":" + port
+	}
+
+	path := requestURL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	return scheme + "://" + host + path
+}
+
+// oauth1Escape percent-encodes everything except unreserved characters (RFC 3986, section 2.3).
+func oauth1Escape(value string) string {
+	var builder strings.Builder
+
+	for _, char := range []byte(value) {
+		if isOAuth1Unreserved(char) {
+			builder.WriteByte(char)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", char)
+		}
+	}
+
+	return builder.String()
+}
+
+func isOAuth1Unreserved(char byte) bool {
+	return 'A' <= char && char <= 'Z' ||
+		'a' <= char && char <= 'z' ||
+		'0' <= char && char <= '9' ||
+		char == '-' || char == '.' || char == '_' || char == '~'
+}
@@ -0,0 +1,184 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixedOAuth1Signer(signer *OAuth1Signer, nonce string, timestamp int64) *OAuth1Signer {
+	signer.nonce = func() (string, error) { return nonce, nil }
+	signer.now = func() time.Time { return time.Unix(timestamp, 0) }
+
+	return signer
+}
+
+// RFC 5849, section 1.2.
+func TestOAuth1SignerHMACSHA1(t *testing.T) {
+	t.Parallel()
+
+	signer := fixedOAuth1Signer(&OAuth1Signer{
+		Credentials: OAuth1Credentials{
+			ConsumerKey:    "dpf43f3p2l4k3l03",
+			ConsumerSecret: "kd94hf93k423kf44",
+			Token:          "nnch734d00sl2jdk",
+			TokenSecret:    "pfkkdhi9sl3r4s00",
+		},
+		Realm: "Photos",
+	}, "chapoH", 137131202)
+
+	req, err := http.NewRequest(http.MethodGet,
+		"http://photos.example.net/photos?file=vacation.jpg&size=original", nil)
+	require.NoError(t, err)
+
+	authorization, err := signer.Authorization(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, `OAuth realm="Photos", `+
+		`oauth_consumer_key="dpf43f3p2l4k3l03", `+
+		`oauth_nonce="chapoH", `+
+		`oauth_signature_method="HMAC-SHA1", `+
+		`oauth_timestamp="137131202", `+
+		`oauth_token="nnch734d00sl2jdk", `+
+		`oauth_signature="MdpQcU8iPSUjWoN%2FUDMsK2sui9I%3D"`, authorization)
+}
+
+// Request of RFC 5849, section 1.2, signed with HMAC-SHA256.
+// The signature was computed independently over the same signature base string.
+func TestOAuth1SignerHMACSHA256(t *testing.T) {
+	t.Parallel()
+
+	signer := fixedOAuth1Signer(&OAuth1Signer{
+		Credentials: OAuth1Credentials{
+			ConsumerKey:    "dpf43f3p2l4k3l03",
+			ConsumerSecret: "kd94hf93k423kf44",
+			Token:          "nnch734d00sl2jdk",
+			TokenSecret:    "pfkkdhi9sl3r4s00",
+		},
+		Method: OAuth1HMACSHA256,
+	}, "chapoH", 137131202)
+
+	req, err := http.NewRequest(http.MethodGet,
+		"http://photos.example.net/photos?file=vacation.jpg&size=original", nil)
+	require.NoError(t, err)
+
+	authorization, err := signer.Authorization(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, `OAuth oauth_consumer_key="dpf43f3p2l4k3l03", `+
+		`oauth_nonce="chapoH", `+
+		`oauth_signature_method="HMAC-SHA256", `+
+		`oauth_timestamp="137131202", `+
+		`oauth_token="nnch734d00sl2jdk", `+
+		`oauth_signature="HtMwoX2zenlFjgGg%2FSNEoKEQmL7CzxYFEKzs7er044Y%3D"`, authorization)
+}
+
+// RFC 5849, section 3.4.1.
+func TestOAuth1SignatureBaseString(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequest(http.MethodPost,
+		"http://EXAMPLE.COM:80/request?b5=%3D%253D&a3=a&c%40=&a2=r%20b", strings.NewReader("c2&a3=2+q"))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	signer := &OAuth1Signer{Credentials: OAuth1Credentials{
+		ConsumerKey: "9djdj82h48djs9d2",
+		Token:       "kkk9d7dh3k39sjv7",
+	}}
+
+	params, err := oauth1RequestParams(req)
+	require.NoError(t, err)
+
+	params = append(params, signer.protocolParams("7d8f3e4a", time.Unix(137131201, 0))...)
+
+	assert.Equal(t, "POST&http%3A%2F%2Fexample.com%2Frequest&a2%3Dr%2520b%26a3%3D2%2520q"+
+		"%26a3%3Da%26b5%3D%253D%25253D%26c%2540%3D%26c2%3D%26oauth_consumer_key%3D9djdj82h48djs9d2"+
+		"%26oauth_nonce%3D7d8f3e4a%26oauth_signature_method%3DHMAC-SHA1%26oauth_timestamp%3D137131201"+
+		"%26oauth_token%3Dkkk9d7dh3k39sjv7",
+		oauth1SignatureBaseString(req.Method, req.URL, params))
+
+	// Body is still available for sending.
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "c2&a3=2+q", string(body))
+}
+
+func TestOAuth1SignerPlainText(t *testing.T) {
+	t.Parallel()
+
+	signer := fixedOAuth1Signer(&OAuth1Signer{
+		Credentials: OAuth1Credentials{
+			ConsumerKey:    "key",
+			ConsumerSecret: "secret&1",
+		},
+		Method: OAuth1PlainText,
+	}, "nonce", 1)
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	require.NoError(t, err)
+
+	authorization, err := signer.Authorization(req)
+	require.NoError(t, err)
+	assert.Contains(t, authorization, `oauth_signature="secret%25261%26"`)
+}
+
+func TestOAuth1HTTPClient(t *testing.T) {
+	t.Parallel()
+
+	var (
+		authorization string
+		body          string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewOAuth1HTTPClient(context.Background(), &OAuth1Signer{
+		Credentials: OAuth1Credentials{
+			ConsumerKey:    "key",
+			ConsumerSecret: "secret",
+			Token:          "token",
+			TokenSecret:    "tokenSecret",
+		},
+		Method: OAuth1HMACSHA256,
+		Realm:  "1234567_SB1",
+	}, WithCustomClient(server.Client()))
+	require.NoError(t, err)
+
+	form := url.Values{"name": {"Acme Inc"}}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/record?limit=5", strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rsp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, rsp.Body.Close())
+
+	assert.True(t, strings.HasPrefix(authorization, `OAuth realm="1234567_SB1", oauth_consumer_key="key", `))
+	assert.Contains(t, authorization, `oauth_signature_method="HMAC-SHA256"`)
+	assert.Contains(t, authorization, `oauth_token="token"`)
+	assert.Equal(t, "name=Acme+Inc", body)
+
+	_, err = NewOAuth1HTTPClient(context.Background(), &OAuth1Signer{
+		Credentials: OAuth1Credentials{ConsumerKey: "key", ConsumerSecret: "secret"},
+		Method:      "RSA-SHA1",
+	})
+	require.ErrorIs(t, err, ErrOAuth1UnsupportedSignature)
+}
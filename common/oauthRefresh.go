@@ -0,0 +1,178 @@
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"runtime"
+	"sync"
+	"weak"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrMissingConnectionID is returned when the client joins the token refresh group without the connection identifier.
+var ErrMissingConnectionID = errors.New("token refresh group requires a connection identifier")
+
+// TokenRefreshGroup serializes refreshes of OAuth2 tokens shared by clients of the same connection.
+//
+// Workers running in parallel usually create their own clients from the same stored token.
+// Each client would refresh the expired token on its own, while providers rotating refresh tokens
+// accept every refresh token only once, so all but the first refresh would fail.
+// Clients joining the group under the same connection identifier refresh one at a time,
+// the token obtained by the first refresh is reused by the others.
+//
+// OAuth clients which don't join a group explicitly share a process-wide group,
+// where tokens are grouped by their refresh token and dropped once no client uses them.
+type TokenRefreshGroup struct {
+	mu          sync.Mutex
+	connections map[string]*sharedToken
+	// released holds tokens weakly, they are forgotten when their clients are garbage collected.
+	released map[string]weak.Pointer[sharedToken]
+}
+
+// defaultTokenRefreshGroup serializes refreshes of clients which don't join a group explicitly.
+var defaultTokenRefreshGroup = &TokenRefreshGroup{ //nolint:gochecknoglobals
+	released: make(map[string]weak.Pointer[sharedToken]),
+}
+
+// defaultRefreshKey identifies the token in the default group,
+// clients created from the same refresh token share it.
+// Returns false when the token can't be refreshed.
+func defaultRefreshKey(config *oauth2.Config, token *oauth2.Token) (string, bool) {
+	if config == nil || token == nil || token.RefreshToken == "" {
+		return "", false
+	}
+
+	hash := sha256.New()
+	for _, part := range []string{config.Endpoint.TokenURL, config.ClientID, token.RefreshToken} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
+
+// NewTokenRefreshGroup creates a group, it should be shared by all clients of the process.
+func NewTokenRefreshGroup() *TokenRefreshGroup {
+	return &TokenRefreshGroup{
+		connections: make(map[string]*sharedToken),
+	}
+}
+
+// Forget removes the token of the connection, ex: when the connection is deleted or reauthorized.
+func (g *TokenRefreshGroup) Forget(connectionID string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.connections, connectionID)
+	delete(g.released, connectionID)
+}
+
+// sharedToken is the latest token of the connection.
+// The lock is held for the duration of the refresh.
+type sharedToken struct {
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+// join returns the token of the connection.
+// The given token replaces the known one only if it expires later, a client created
+// from an outdated copy of the token must not roll back a token refreshed by another client.
+// The group lock is released before waiting on the token, which stays locked during a refresh,
+// so joining one connection never blocks clients of the others.
+func (g *TokenRefreshGroup) join(connectionID string, token *oauth2.Token) *sharedToken {
+	g.mu.Lock()
+
+	shared, ok := g.lookup(connectionID)
+	if !ok {
+		shared = &sharedToken{token: token}
+		g.store(connectionID, shared)
+	}
+
+	g.mu.Unlock()
+
+	if !ok {
+		return shared
+	}
+
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+
+	if token != nil && (shared.token == nil || token.Expiry.After(shared.token.Expiry)) {
+		shared.token = token
+	}
+
+	return shared
+}
+
+func (g *TokenRefreshGroup) lookup(connectionID string) (*sharedToken, bool) {
+	if g.released == nil {
+		shared, ok := g.connections[connectionID]
+
+		return shared, ok
+	}
+
+	shared := g.released[connectionID].Value()
+
+	return shared, shared != nil
+}
+
+func (g *TokenRefreshGroup) store(connectionID string, shared *sharedToken) {
+	if g.released == nil {
+		g.connections[connectionID] = shared
+
+		return
+	}
+
+	g.released[connectionID] = weak.Make(shared)
+
+	runtime.AddCleanup(shared, g.forgetReleased, connectionID)
+}
+
+// forgetReleased removes the collected token, unless the connection has joined again since then.
+func (g *TokenRefreshGroup) forgetReleased(connectionID string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if reference, ok := g.released[connectionID]; ok && reference.Value() == nil {
+		delete(g.released, connectionID)
+	}
+}
+
+func (g *TokenRefreshGroup) tokenSource( //nolint:ireturn
+	ctx context.Context, connectionID string, config *oauth2.Config, token *oauth2.Token,
+) oauth2.TokenSource {
+	return &serializedTokenSource{
+		ctx:    ctx,
+		config: config,
+		shared: g.join(connectionID, token),
+	}
+}
+
+// serializedTokenSource refreshes the shared token when it is no longer valid.
+type serializedTokenSource struct {
+	ctx    context.Context // nolint:containedctx
+	config *oauth2.Config
+	shared *sharedToken
+}
+
+func (s *serializedTokenSource) Token() (*oauth2.Token, error) {
+	s.shared.mu.Lock()
+	defer s.shared.mu.Unlock()
+
+	if s.shared.token.Valid() {
+		return s.shared.token, nil
+	}
+
+	// Refreshed token keeps the current refresh token if the provider doesn't return a new one.
+	token, err := s.config.TokenSource(s.ctx, s.shared.token).Token()
+	if err != nil {
+		return nil, err
+	}
+
+	s.shared.token = token
+
+	return token, nil
+}
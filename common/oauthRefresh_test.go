@@ -0,0 +1,195 @@
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// rotatingTokenServer issues a new refresh token on every refresh and accepts each refresh token only once.
+type rotatingTokenServer struct {
+	mu        sync.Mutex
+	current   string
+	refreshes atomic.Int32
+}
+
+func (s *rotatingTokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/token" {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.FormValue("refresh_token") != s.current {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+
+		return
+	}
+
+	version := s.refreshes.Add(1)
+	s.current = "refresh-" + strconv.Itoa(int(version))
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token":  "access-" + strconv.Itoa(int(version)),
+		"refresh_token": s.current,
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+	})
+}
+
+func TestTokenRefreshGroup(t *testing.T) {
+	t.Parallel()
+
+	tokenServer := &rotatingTokenServer{current: "refresh-0"}
+	server := httptest.NewServer(tokenServer)
+	t.Cleanup(server.Close)
+
+	config := &oauth2.Config{
+		ClientID: "client",
+		Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token", AuthStyle: oauth2.AuthStyleInParams},
+	}
+
+	// Every worker starts from the same stored, expired token.
+	expired := &oauth2.Token{
+		AccessToken:  "access-0",
+		RefreshToken: "refresh-0",
+		Expiry:       time.Now().Add(-time.Hour),
+	}
+
+	group := NewTokenRefreshGroup()
+
+	var waitGroup sync.WaitGroup
+
+	for range 8 {
+		client, err := NewOAuthHTTPClient(t.Context(),
+			WithOAuthConfig(config),
+			WithOAuthToken(expired),
+			WithTokenRefreshGroup(group, "connection-1"),
+		)
+		require.NoError(t, err)
+
+		waitGroup.Go(func() {
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/api", nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			rsp, err := client.Do(req)
+			if assert.NoError(t, err) {
+				_ = rsp.Body.Close()
+				assert.Equal(t, http.StatusOK, rsp.StatusCode)
+			}
+		})
+	}
+
+	waitGroup.Wait()
+
+	assert.Equal(t, int32(1), tokenServer.refreshes.Load(), "token must be refreshed once per connection")
+}
+
+func TestDefaultTokenRefreshGroup(t *testing.T) {
+	t.Parallel()
+
+	tokenServer := &rotatingTokenServer{current: "refresh-0"}
+	server := httptest.NewServer(tokenServer)
+	t.Cleanup(server.Close)
+
+	config := &oauth2.Config{
+		ClientID: "client",
+		Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token", AuthStyle: oauth2.AuthStyleInParams},
+	}
+
+	expired := &oauth2.Token{
+		AccessToken:  "access-0",
+		RefreshToken: "refresh-0",
+		Expiry:       time.Now().Add(-time.Hour),
+	}
+
+	// Clients which don't join a group explicitly are grouped by the refresh token,
+	// as long as they are in use.
+	clients := make([]AuthenticatedHTTPClient, 0, 3)
+
+	for range 3 {
+		client, err := NewOAuthHTTPClient(t.Context(),
+			WithOAuthConfig(config),
+			WithOAuthToken(expired),
+		)
+		require.NoError(t, err)
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/api", nil)
+		require.NoError(t, err)
+
+		rsp, err := client.Do(req)
+		require.NoError(t, err)
+
+		_ = rsp.Body.Close()
+		assert.Equal(t, http.StatusOK, rsp.StatusCode)
+
+		clients = append(clients, client)
+	}
+
+	assert.Equal(t, int32(1), tokenServer.refreshes.Load(), "refresh token must be spent once")
+	assert.Len(t, clients, 3)
+}
+
+func TestTokenRefreshGroupJoinDuringRefresh(t *testing.T) {
+	t.Parallel()
+
+	group := NewTokenRefreshGroup()
+	refreshing := group.join("connection-1", &oauth2.Token{AccessToken: "access-0"})
+
+	// The token of the connection stays locked while it is being refreshed.
+	refreshing.mu.Lock()
+	defer refreshing.mu.Unlock()
+
+	go group.join("connection-1", &oauth2.Token{AccessToken: "access-1"})
+
+	// Let the client of the same connection start waiting on the token.
+	time.Sleep(50 * time.Millisecond)
+
+	joined := make(chan struct{})
+
+	go func() {
+		group.join("connection-2", &oauth2.Token{AccessToken: "access-2"})
+		group.Forget("connection-2")
+		close(joined)
+	}()
+
+	select {
+	case <-joined:
+	case <-time.After(time.Second):
+		t.Fatal("clients of other connections must not wait for the refresh")
+	}
+}
+
+func TestTokenRefreshGroupRequiresConnection(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewOAuthHTTPClient(t.Context(),
+		WithOAuthConfig(&oauth2.Config{}),
+		WithOAuthToken(&oauth2.Token{}),
+		WithTokenRefreshGroup(NewTokenRefreshGroup(), ""),
+	)
+	require.ErrorIs(t, err, ErrMissingConnectionID)
+}
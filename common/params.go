@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+
+	"github.com/amp-labs/connectors/common/governor"
 )
 
 // ConnectorParams can be used to pass input parameters to the connector.
@@ -27,6 +29,12 @@ type ConnectorParams struct {
 	// CustomAuthenticatedClient [optional] is useful for connectors that work over non-http protocols or want
 	// to use custom non-http clients. Connectors that need this will know to check for it & use it if available.
 	CustomAuthenticatedClient any
+
+	// Governor [optional] limits the rate and the concurrency of requests sent by the connector.
+	// connector.New wraps AuthenticatedClient with it, connectors constructed directly expect
+	// a client already wrapped by governor.NewClient.
+	// Use governor.For to share the budget with other connectors of the same provider workspace.
+	Governor *governor.Governor
 }
 
 var (
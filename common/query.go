@@ -142,12 +142,22 @@ func WithQueryParamDebug(f func(req *http.Request, rsp *http.Response)) QueryPar
 	}
 }
 
+// WithDynamicQueryParams sets a function that will be called on every request to
+// get additional query params to use. Use this for things like rotating keys
+// or loading query params from some external authority.
+func WithDynamicQueryParams(f DynamicQueryParamsGenerator) QueryParamAuthClientOption {
+	return func(params *queryParamClientParams) {
+		params.dynamicParams = f
+	}
+}
+
 type DynamicQueryParamsGenerator func(*http.Request) (QueryParams, error)
 
 // queryParamClientParams is the internal configuration for the oauth http client.
 type queryParamClientParams struct {
 	client         *http.Client
 	params         []QueryParam
+	dynamicParams  DynamicQueryParamsGenerator
 	debug          func(req *http.Request, rsp *http.Response)
 	unauthorized   func(params []QueryParam, req *http.Request, rsp *http.Response) (*http.Response, error)
 	isUnauthorized func(rsp *http.Response) (bool, error)
@@ -169,6 +179,7 @@ func newQueryParamAuthClient( //nolint:ireturn
 	return &queryParamAuthClient{
 		client:         params.client,
 		params:         params.params,
+		dynamicParams:  params.dynamicParams,
 		debug:          params.debug,
 		unauthorized:   params.unauthorized,
 		isUnauthorized: params.isUnauthorized,
@@ -178,6 +189,7 @@ func newQueryParamAuthClient( //nolint:ireturn
 type queryParamAuthClient struct {
 	client         *http.Client
 	params         QueryParams
+	dynamicParams  DynamicQueryParamsGenerator
 	debug          func(req *http.Request, rsp *http.Response)
 	unauthorized   func(params []QueryParam, req *http.Request, rsp *http.Response) (*http.Response, error)
 	isUnauthorized func(rsp *http.Response) (bool, error)
@@ -190,6 +202,15 @@ func (c *queryParamAuthClient) Do(req *http.Request) (*http.Response, error) {
 	// Add on the query parameters
 	c.params.ApplyToRequest(req2)
 
+	if c.dynamicParams != nil {
+		params, err := c.dynamicParams(req2)
+		if err != nil {
+			return nil, err
+		}
+
+		params.ApplyToRequest(req2)
+	}
+
 	modifier, hasModifier := getRequestModifier(req.Context()) //nolint:contextcheck
 	if hasModifier {
 		modifier(req2)
@@ -35,6 +35,10 @@ var Fields = struct { // nolint:gochecknoglobals
 	Scopes Field
 	Secret Field
 	Token  Field
+	// Oauth1
+	ConsumerKey    Field
+	ConsumerSecret Field
+	TokenSecret    Field
 }{
 	Provider: Field{
 		Name:      "provider",
@@ -116,6 +120,21 @@ var Fields = struct { // nolint:gochecknoglobals
 		PathJSON:  "token",
 		SuffixENV: "TOKEN",
 	},
+	ConsumerKey: Field{
+		Name:      "consumerKey",
+		PathJSON:  "consumerKey",
+		SuffixENV: "CONSUMER_KEY",
+	},
+	ConsumerSecret: Field{
+		Name:      "consumerSecret",
+		PathJSON:  "consumerSecret",
+		SuffixENV: "CONSUMER_SECRET",
+	},
+	TokenSecret: Field{
+		Name:      "tokenSecret",
+		PathJSON:  "tokenSecret",
+		SuffixENV: "TOKEN_SECRET",
+	},
 }
 
 type Field struct {
@@ -164,6 +183,9 @@ func getFields(info providers.ProviderInfo,
 	case providers.Basic:
 		lists.Add(requiredType, Fields.Username, Fields.Password)
 	case providers.None:
+	case providers.Oauth1:
+		lists.Add(requiredType, Fields.ConsumerKey, Fields.ConsumerSecret)
+		lists.Add(optionalType, Fields.Token, Fields.TokenSecret)
 	case providers.Oauth2:
 		lists.Add(requiredType, Fields.ClientId, Fields.ClientSecret)
 	case providers.Jwt:
@@ -0,0 +1,197 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+var (
+	// ErrUnsupportedSearchFilter is returned when the provider query language cannot express the filter.
+	ErrUnsupportedSearchFilter = errors.New("search filter is not supported by the provider")
+
+	// ErrUnsupportedSearchSort is returned when the provider cannot apply requested sort keys.
+	ErrUnsupportedSearchSort = errors.New("search sort is not supported by the provider")
+)
+
+// SearchParams describes a provider agnostic search query.
+// Connectors translate it into the native query language, see connectors.SearchConnector.
+type SearchParams struct {
+	// The name of the object we are searching, e.g. "contacts".
+	ObjectName string // required
+
+	// The fields we are reading from the object, e.g. ["email", "firstname"].
+	Fields datautils.StringSet // required, at least one field needed
+
+	// Filter narrows down the records, every record matches when nil.
+	Filter *SearchFilter // optional
+
+	// SortBy lists sort keys in the order of precedence.
+	SortBy []SearchSort // optional
+
+	// PageSize specifies the # of records to request, provider default is used when zero.
+	PageSize int // optional
+
+	// NextPage is an opaque token that can be used to get the next page of results.
+	NextPage NextPageToken // optional, only set this if you want to read the next page of results
+}
+
+// SearchFilter is a tree of conditions joined by the logical operator.
+//
+// Example, email ends with "@example.com" and lifecycle stage is either "lead" or "customer":
+//
+//	&SearchFilter{
+//		Conditions: []SearchCondition{{
+//			FieldName: "email", Operator: SearchOperatorContains, Value: "@example.com",
+//		}, {
+//			FieldName: "lifecyclestage", Operator: SearchOperatorIn, Value: []string{"lead", "customer"},
+//		}},
+//	}
+//
+// Providers differ in how deep the tree can go, connectors return ErrUnsupportedSearchFilter
+// for filters that cannot be expressed.
+type SearchFilter struct {
+	// Logic joins conditions and nested groups, SearchLogicAnd is used when empty.
+	Logic SearchLogic
+	// Conditions are checked against record fields.
+	Conditions []SearchCondition
+	// Groups are nested filters.
+	Groups []SearchFilter
+}
+
+// SearchCondition compares the field with the value.
+type SearchCondition struct {
+	FieldName string
+	Operator  SearchOperator
+	// Value is a scalar, a slice for SearchOperatorIn/SearchOperatorNotIn,
+	// and is ignored by SearchOperatorExists/SearchOperatorNotExists.
+	Value any
+}
+
+// SearchSort orders records by the field.
+type SearchSort struct {
+	FieldName  string
+	Descending bool
+}
+
+type (
+	SearchLogic    string
+	SearchOperator string
+)
+
+const (
+	SearchLogicAnd SearchLogic = "and"
+	SearchLogicOr  SearchLogic = "or"
+
+	SearchOperatorEQ          SearchOperator = "eq"
+	SearchOperatorNEQ         SearchOperator = "neq"
+	SearchOperatorGT          SearchOperator = "gt"
+	SearchOperatorGTE         SearchOperator = "gte"
+	SearchOperatorLT          SearchOperator = "lt"
+	SearchOperatorLTE         SearchOperator = "lte"
+	SearchOperatorIn          SearchOperator = "in"
+	SearchOperatorNotIn       SearchOperator = "notIn"
+	SearchOperatorContains    SearchOperator = "contains"
+	SearchOperatorNotContains SearchOperator = "notContains"
+	SearchOperatorExists      SearchOperator = "exists"
+	SearchOperatorNotExists   SearchOperator = "notExists"
+)
+
+func (p SearchParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	if len(p.Fields) == 0 {
+		return ErrMissingFields
+	}
+
+	if p.Filter != nil {
+		if err := p.Filter.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, sort := range p.SortBy {
+		if sort.FieldName == "" {
+			return fmt.Errorf("%w: sort key has no field", ErrUnsupportedSearchSort)
+		}
+	}
+
+	return nil
+}
+
+// JoinLogic returns the logical operator of the group.
+func (f SearchFilter) JoinLogic() SearchLogic {
+	if f.Logic == "" {
+		return SearchLogicAnd
+	}
+
+	return f.Logic
+}
+
+// IsFlat tells if the filter has no nested groups.
+func (f SearchFilter) IsFlat() bool {
+	return len(f.Groups) == 0
+}
+
+func (f SearchFilter) validate() error {
+	if logic := f.JoinLogic(); logic != SearchLogicAnd && logic != SearchLogicOr {
+		return fmt.Errorf("%w: unknown logic %q", ErrUnsupportedSearchFilter, logic)
+	}
+
+	for _, condition := range f.Conditions {
+		if condition.FieldName == "" {
+			return fmt.Errorf("%w: condition has no field", ErrUnsupportedSearchFilter)
+		}
+
+		if condition.Operator.IsList() {
+			if _, err := condition.Values(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, group := range f.Groups {
+		if err := group.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsList tells if the operator compares the field with a list of values.
+func (o SearchOperator) IsList() bool {
+	return o == SearchOperatorIn || o == SearchOperatorNotIn
+}
+
+// Values returns the condition value as a list of strings.
+// Scalars are formatted and returned as a single item list.
+func (c SearchCondition) Values() ([]string, error) {
+	switch value := c.Value.(type) {
+	case []string:
+		return value, nil
+	case []any:
+		values := make([]string, len(value))
+		for index, item := range value {
+			values[index] = fmt.Sprint(item)
+		}
+
+		return values, nil
+	case nil:
+		return nil, fmt.Errorf("%w: field %s has no value", ErrUnsupportedSearchFilter, c.FieldName)
+	default:
+		return []string{fmt.Sprint(value)}, nil
+	}
+}
+
+// StringValue returns the condition value formatted as a string.
+func (c SearchCondition) StringValue() string {
+	if c.Value == nil {
+		return ""
+	}
+
+	return fmt.Sprint(c.Value)
+}
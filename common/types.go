@@ -186,10 +186,26 @@ type ReadParams struct {
 	//		Reference: https://docs.stripe.com/expand#how-it-works
 	//	* Capsule: Embeds objects in response.
 	//		Reference: https://developer.capsulecrm.com/v2/overview/reading-from-the-api
+	//	* Zendesk Support: Sideloads related records, e.g. "users" and "groups" of tickets.
+	//		Reference: https://developer.zendesk.com/documentation/ticketing/using-the-zendesk-api/side_loading/
 	AssociatedObjects []string // optional
 
 	// PageSize specifies the # of records to request when making a read request.
 	PageSize int // optional
+
+	// SyncToken is an opaque checkpoint previously returned via ReadResult.SyncToken.
+	// When set, connectors with token based change tracking return only records
+	// that were created, updated or deleted after the token was issued.
+	// A token which is no longer accepted by the provider fails the read with ErrCursorGone,
+	// the caller must then discard the token and replicate the object again without it.
+	// It is optional and supported by the following connectors:
+	//	* Google Calendar: events, using Calendar sync tokens.
+	//		Reference: https://developers.google.com/workspace/calendar/api/guides/sync
+	//	* Gmail: messages, using the mailbox history ID.
+	//		Reference: https://developers.google.com/workspace/gmail/api/guides/sync
+	//	* Zendesk Support: tickets and users, using the cursor of incremental exports.
+	//		Reference: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/
+	SyncToken string // optional
 }
 
 type WriteHeader struct {
@@ -209,10 +225,14 @@ type WriteParams struct {
 	// or fields of data we want to modify in case of an update
 	RecordData any // required
 
-	// Associations contains associations between the object and other objects.
-	Associations any // optional
+	// Associations links the record with other records, see AssociationInput.
+	Associations []AssociationInput // optional
 
 	Headers []WriteHeader // optional
+
+	// IdempotencyKey identifies the write across retries, so that it is applied at most once.
+	// Connectors report how the key is honoured via IdempotencyMode.
+	IdempotencyKey string // optional
 }
 
 // GetRecord converts WriteParams.RecordData into a map-based Record.
@@ -292,6 +312,10 @@ type ReadResult struct {
 	NextPage NextPageToken `json:"nextPage,omitempty"`
 	// Done is true if there are no more pages to read.
 	Done bool `json:"done,omitempty"`
+	// SyncToken is an opaque checkpoint for the next incremental read, see ReadParams.SyncToken.
+	// Connectors may report it on any page, callers should keep the most recent non-empty value
+	// and persist it once Done is true.
+	SyncToken string `json:"syncToken,omitempty"`
 }
 
 // ReadResultRow is a single row of data returned from a Read call, which contains
@@ -307,6 +331,9 @@ type ReadResultRow struct {
 	Raw map[string]any `json:"raw"`
 	// RecordId is the ID of the record.
 	Id string `json:"id,omitempty"`
+	// Deleted is true if the record was removed in the provider.
+	// Change feeds, such as reads driven by ReadParams.SyncToken, mix deleted and active records.
+	Deleted bool `json:"deleted,omitempty"`
 }
 
 // Association is a struct that represents an association between two objects.
@@ -354,17 +381,30 @@ type BatchWriteType string
 const (
 	BatchWriteTypeCreate BatchWriteType = "create"
 	BatchWriteTypeUpdate BatchWriteType = "update"
+	// BatchWriteTypeUpsert matches records by the ExternalIdField, existing ones are updated, others are created.
+	BatchWriteTypeUpsert BatchWriteType = "upsert"
+	// BatchWriteTypeDelete removes records listed by RecordIds.
+	BatchWriteTypeDelete BatchWriteType = "delete"
 )
 
 // BatchWriteParam defines the input required to execute a batch write operation.
-// It allows creating, updating, or upserting multiple records in a single request.
+// It allows creating, updating, upserting or deleting multiple records in a single request.
 type BatchWriteParam struct {
 	// ObjectName identifies the target object for the write operation.
 	ObjectName ObjectName
-	// Type defines how the records should be processed: create, update, or upsert.
+	// Type defines how the records should be processed: create, update, upsert or delete.
 	Type BatchWriteType
 	// Batch contains the collection of record payloads to be written.
+	// Not used by delete.
 	Batch BatchItems
+	// ExternalIdField is the field which identifies records during upsert.
+	// Every record of the batch must have a value for it.
+	ExternalIdField string
+	// RecordIds lists records to be removed by delete.
+	RecordIds []string
+	// AllOrNone rolls back the whole batch when any record fails.
+	// When nil, the provider default applies.
+	AllOrNone *bool // optional
 	// Headers contains additional headers to be added to the request.
 	Headers []WriteHeader // optional
 }
@@ -384,7 +424,10 @@ func TransformWriteHeaders(headers []WriteHeader, mode HeaderMode) []Header {
 
 type BatchItem struct {
 	Record       map[string]any
-	Associations any
+	Associations []AssociationInput
+	// IdempotencyKey identifies this record write across retries of the batch.
+	// Connectors don't forward it to the provider, it is honoured only by idempotency.BatchWriteConnector.
+	IdempotencyKey string // optional
 }
 
 func (i BatchItem) GetRecord() (Record, error) {
@@ -401,6 +444,23 @@ func (p BatchWriteParam) IsUpdate() bool {
 	return p.Type == BatchWriteTypeUpdate
 }
 
+func (p BatchWriteParam) IsUpsert() bool {
+	return p.Type == BatchWriteTypeUpsert
+}
+
+func (p BatchWriteParam) IsDelete() bool {
+	return p.Type == BatchWriteTypeDelete
+}
+
+// Size is the number of records affected by the batch.
+func (p BatchWriteParam) Size() int {
+	if p.IsDelete() {
+		return len(p.RecordIds)
+	}
+
+	return len(p.Batch)
+}
+
 type Record map[string]any
 
 func (p BatchWriteParam) GetRecords() ([]Record, error) {
@@ -596,6 +656,22 @@ type FieldMetadata struct {
 	// Values is a list of possible values for this field.
 	// It is applicable only if the type is either singleSelect or multiSelect, otherwise slice is nil.
 	Values []FieldValue
+
+	// ReferenceTo lists objects the field can point to.
+	// It is applicable only if the type is reference, polymorphic lookups have many targets.
+	ReferenceTo []string
+
+	// ItemType is the type of array elements, applicable only if the type is array.
+	ItemType ValueType
+
+	// MaxLength is the maximum number of characters of a textual value, nil if not limited or unknown.
+	MaxLength *int
+
+	// Precision is the total number of digits of a numeric value, nil if unknown.
+	Precision *int
+
+	// Scale is the number of digits after the decimal point of a numeric value, nil if unknown.
+	Scale *int
 }
 
 type FieldsMetadata map[string]FieldMetadata
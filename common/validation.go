@@ -3,6 +3,8 @@ package common
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 )
 
 var (
@@ -27,6 +29,9 @@ var (
 
 	// ErrMissingFieldsMetadata is returned when the list of fields to create via UpsertMetadata is empty.
 	ErrMissingFieldsMetadata = errors.New("no fields metadata provided in UpsertMetadata")
+
+	// ErrMissingObjectDisplayName is returned when a custom object definition has no display name.
+	ErrMissingObjectDisplayName = errors.New("custom object definition must have a display name")
 )
 
 func (p ReadParams) ValidateParams(withRequiredFields bool) error {
@@ -58,7 +63,7 @@ func (p WriteParams) ValidateParams() error {
 		return ErrMissingRecordData
 	}
 
-	return nil
+	return validateAssociations(p.Associations, p.RecordId == "")
 }
 
 func (p DeleteParams) ValidateParams() error {
@@ -80,13 +85,25 @@ var (
 	ErrUnsupportedBatchWriteType = errors.New("batch write type is not supported")
 )
 
+// nolint:cyclop
 func (p BatchWriteParam) ValidateParams() error {
 	if len(p.ObjectName) == 0 {
 		return ErrMissingObjects
 	}
 
-	// Neither "create" nor "update".
-	if p.Type != BatchWriteTypeCreate && p.Type != BatchWriteTypeUpdate {
+	switch p.Type {
+	case BatchWriteTypeCreate, BatchWriteTypeUpdate:
+	case BatchWriteTypeUpsert:
+		if p.ExternalIdField == "" {
+			return ErrMissingExternalIdField
+		}
+	case BatchWriteTypeDelete:
+		if len(p.RecordIds) == 0 || slices.Contains(p.RecordIds, "") {
+			return ErrMissingRecordID
+		}
+
+		return nil
+	default:
 		return ErrUnknownBatchWriteType
 	}
 
@@ -94,6 +111,12 @@ func (p BatchWriteParam) ValidateParams() error {
 		return ErrMissingRecordData
 	}
 
+	for _, item := range p.Batch {
+		if err := validateAssociations(item.Associations, p.IsCreate()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -102,9 +125,15 @@ func (p *UpsertMetadataParams) ValidateParams() error {
 		return ErrMissingFieldsMetadata
 	}
 
-	if len(p.Fields) == 0 {
+	if len(p.Fields) == 0 && len(p.Objects) == 0 && len(p.DeleteFields) == 0 {
 		return ErrMissingFieldsMetadata
 	}
 
+	for objectName, definition := range p.Objects {
+		if definition.DisplayName == "" {
+			return fmt.Errorf("%w: %s", ErrMissingObjectDisplayName, objectName)
+		}
+	}
+
 	return nil
 }
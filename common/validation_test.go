@@ -3,6 +3,7 @@ package common
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -93,3 +94,137 @@ func TestReadParamsValidateParams(t *testing.T) { // nolint:funlen
 		})
 	}
 }
+
+func TestSearchParamsValidateParams(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		params  SearchParams
+		wantErr error
+	}{
+		{
+			name:    "Missing object name",
+			params:  SearchParams{},
+			wantErr: ErrMissingObjects,
+		},
+		{
+			name:    "Missing fields",
+			params:  SearchParams{ObjectName: "test"},
+			wantErr: ErrMissingFields,
+		},
+		{
+			name: "Unknown logic in nested group",
+			params: SearchParams{
+				ObjectName: "test",
+				Fields:     datautils.NewSet("id"),
+				Filter: &SearchFilter{
+					Groups: []SearchFilter{{Logic: "xor"}},
+				},
+			},
+			wantErr: ErrUnsupportedSearchFilter,
+		},
+		{
+			name: "List operator without values",
+			params: SearchParams{
+				ObjectName: "test",
+				Fields:     datautils.NewSet("id"),
+				Filter: &SearchFilter{
+					Conditions: []SearchCondition{{FieldName: "status", Operator: SearchOperatorIn}},
+				},
+			},
+			wantErr: ErrUnsupportedSearchFilter,
+		},
+		{
+			name: "Valid params",
+			params: SearchParams{
+				ObjectName: "test",
+				Fields:     datautils.NewSet("id"),
+				Filter: &SearchFilter{
+					Logic: SearchLogicOr,
+					Conditions: []SearchCondition{
+						{FieldName: "status", Operator: SearchOperatorIn, Value: []any{"open", 1}},
+						{FieldName: "email", Operator: SearchOperatorExists},
+					},
+				},
+				SortBy: []SearchSort{{FieldName: "id"}},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.params.ValidateParams()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBulkJobParamsValidateParams(t *testing.T) {
+	t.Parallel()
+
+	records := func(yield func(Record) bool) {}
+
+	tests := []struct {
+		name    string
+		params  BulkJobParams
+		wantErr error
+	}{
+		{
+			name:    "Missing object name",
+			params:  BulkJobParams{},
+			wantErr: ErrMissingObjects,
+		},
+		{
+			name:    "Unknown operation",
+			params:  BulkJobParams{ObjectName: "test", Operation: "merge", Records: records},
+			wantErr: ErrUnsupportedBulkOperation,
+		},
+		{
+			name:    "Upsert without external id",
+			params:  BulkJobParams{ObjectName: "test", Operation: BulkOperationUpsert, Records: records},
+			wantErr: ErrMissingExternalIdField,
+		},
+		{
+			name:    "Missing data",
+			params:  BulkJobParams{ObjectName: "test", Operation: BulkOperationInsert},
+			wantErr: ErrMissingBulkData,
+		},
+		{
+			name: "Both records and CSV",
+			params: BulkJobParams{
+				ObjectName: "test",
+				Operation:  BulkOperationDelete,
+				Records:    records,
+				CSVData:    strings.NewReader("id\n1\n"),
+			},
+			wantErr: ErrMissingBulkData,
+		},
+		{
+			name: "Valid params",
+			params: BulkJobParams{
+				ObjectName:      "test",
+				Operation:       BulkOperationUpsert,
+				ExternalIdField: "external_id",
+				CSVData:         strings.NewReader("external_id\n1\n"),
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.params.ValidateParams()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
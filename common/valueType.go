@@ -15,5 +15,31 @@ const (
 	ValueTypeSingleSelect = "singleSelect"
 	ValueTypeMultiSelect  = "multiSelect"
 
+	// ValueTypeReference holds an identifier of another record, see FieldMetadata.ReferenceTo.
+	ValueTypeReference = "reference"
+	// ValueTypeCurrency is a monetary amount, see FieldMetadata.Precision and FieldMetadata.Scale.
+	ValueTypeCurrency = "currency"
+	ValueTypeEmail    = "email"
+	ValueTypeURL      = "url"
+	ValueTypePhone    = "phone"
+	// ValueTypeJSON is a nested object or a value without a fixed structure.
+	ValueTypeJSON = "json"
+	// ValueTypeArray is a list of values, see FieldMetadata.ItemType.
+	ValueTypeArray = "array"
+
 	ValueTypeOther = "other"
 )
+
+// BaseType returns the primitive type the value is transferred as.
+// Specialized string types, such as email or reference, are strings and currency is a float.
+// It allows checking compatibility between fields described with different precision.
+func (t ValueType) BaseType() ValueType {
+	switch t {
+	case ValueTypeReference, ValueTypeEmail, ValueTypeURL, ValueTypePhone:
+		return ValueTypeString
+	case ValueTypeCurrency:
+		return ValueTypeFloat
+	default:
+		return t
+	}
+}
@@ -0,0 +1,232 @@
+package connector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
+	"github.com/amp-labs/connectors/providers"
+)
+
+var (
+	// ErrMissingProvider is returned when the credential bundle doesn't name the provider.
+	ErrMissingProvider = errors.New("provider not given")
+
+	// ErrMissingSecret is returned when the secrets required by the auth type are not given.
+	ErrMissingSecret = errors.New("secret not given")
+
+	// ErrUnsupportedAuthType is returned when the auth type cannot be used with the provider.
+	ErrUnsupportedAuthType = errors.New("auth type is not supported by the provider")
+)
+
+// Credentials is everything needed to open a connection to a provider.
+// The bundle is serializable, it can be stored as JSON and given to Open as is.
+type Credentials struct {
+	Provider providers.Provider `json:"provider"`
+
+	// Module defaults to the default module of the provider.
+	Module common.ModuleID `json:"module,omitempty"`
+
+	// AuthType defaults to the auth type of the provider.
+	// Providers accepting OAuth 1.0a next to their primary auth type can be opened with oauth1.
+	AuthType providers.AuthType `json:"authType,omitempty"`
+
+	Secrets Secrets `json:"secrets"`
+
+	// Metadata holds values of the provider metadata, the workspace included.
+	// Both user inputs and values collected after authentication are stored here.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Secrets holds the credentials of every auth type, only the ones of the chosen auth type are used.
+//
+//   - oauth2 authorization code and password grants: ClientId, ClientSecret, Scopes and tokens.
+//   - oauth2 client credentials grant: ClientId, ClientSecret and Scopes.
+//   - apiKey: ApiKey.
+//   - basic: Username and Password.
+//   - custom: Custom values keyed by the input names of the provider.
+//   - oauth1: ConsumerKey, ConsumerSecret, Token, TokenSecret and optional Realm.
+type Secrets struct {
+	ClientId     string    `json:"clientId,omitempty"`
+	ClientSecret string    `json:"clientSecret,omitempty"`
+	Scopes       []string  `json:"scopes,omitempty"`
+	AccessToken  string    `json:"accessToken,omitempty"`
+	RefreshToken string    `json:"refreshToken,omitempty"`
+	Expiry       time.Time `json:"expiry,omitzero"`
+
+	ApiKey   string `json:"apiKey,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+
+	Custom map[string]string `json:"custom,omitempty"`
+
+	ConsumerKey    string `json:"consumerKey,omitempty"`
+	ConsumerSecret string `json:"consumerSecret,omitempty"`
+	Token          string `json:"token,omitempty"`
+	TokenSecret    string `json:"tokenSecret,omitempty"`
+	Realm          string `json:"realm,omitempty"`
+}
+
+// String lists the given secrets without revealing them.
+func (s Secrets) String() string {
+	return "Secrets{" + strings.Join(s.givenNames(), ", ") + "}"
+}
+
+// GoString prevents the secrets from being printed with the %#v verb.
+func (s Secrets) GoString() string {
+	return s.String()
+}
+
+// LogValue lists the given secrets without revealing them.
+func (s Secrets) LogValue() slog.Value {
+	return slog.AnyValue(s.givenNames())
+}
+
+func (s Secrets) givenNames() []string {
+	secrets := []struct {
+		name  string
+		given bool
+	}{
+		{"clientId", s.ClientId != ""},
+		{"clientSecret", s.ClientSecret != ""},
+		{"accessToken", s.AccessToken != ""},
+		{"refreshToken", s.RefreshToken != ""},
+		{"apiKey", s.ApiKey != ""},
+		{"username", s.Username != ""},
+		{"password", s.Password != ""},
+		{"custom", len(s.Custom) != 0},
+		{"consumerKey", s.ConsumerKey != ""},
+		{"consumerSecret", s.ConsumerSecret != ""},
+		{"token", s.Token != ""},
+		{"tokenSecret", s.TokenSecret != ""},
+	}
+
+	names := make([]string, 0, len(secrets))
+
+	for _, secret := range secrets {
+		if secret.given {
+			names = append(names, secret.name)
+		}
+	}
+
+	return names
+}
+
+// Workspace returns the workspace stored with the metadata.
+func (c Credentials) Workspace() string {
+	return c.Metadata[catalogreplacer.VariableWorkspace]
+}
+
+// authType resolves the auth type of the connection against the catalog.
+func (c Credentials) authType(info *providers.ProviderInfo) (providers.AuthType, error) {
+	switch {
+	case c.AuthType == "" || c.AuthType == info.AuthType:
+		return info.AuthType, nil
+	case c.AuthType == providers.Oauth1 && info.Oauth1Options() != nil:
+		return providers.Oauth1, nil
+	default:
+		return "", fmt.Errorf("%w: %s uses %s, not %s", ErrUnsupportedAuthType, c.Provider, info.AuthType, c.AuthType)
+	}
+}
+
+// validateMetadata checks that every metadata input, which has no default value and applies to the module,
+// is given. Missing inputs are reported together.
+func (c Credentials) validateMetadata(info *providers.ProviderInfo, module common.ModuleID) error {
+	if info.Metadata == nil {
+		return nil
+	}
+
+	var missing []string
+
+	for _, input := range info.Metadata.Input {
+		if input.DefaultValue != "" || !appliesToModule(input.ModuleDependencies, module) {
+			continue
+		}
+
+		if c.Metadata[input.Name] == "" {
+			missing = append(missing, input.Name)
+		}
+	}
+
+	if len(missing) != 0 {
+		return fmt.Errorf("%w: %s", common.ErrMissingMetadata, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// validate checks that the secrets required by the auth type are given.
+func (s Secrets) validate(info *providers.ProviderInfo, authType providers.AuthType) error {
+	var required map[string]string
+
+	switch authType {
+	case providers.None:
+		return nil
+	case providers.Oauth2:
+		if info.Oauth2Opts != nil && info.Oauth2Opts.GrantType == providers.ClientCredentials {
+			required = map[string]string{"clientId": s.ClientId, "clientSecret": s.ClientSecret}
+
+			break
+		}
+
+		if s.AccessToken == "" && s.RefreshToken == "" {
+			return fmt.Errorf("%w: accessToken or refreshToken", ErrMissingSecret)
+		}
+
+		return nil
+	case providers.ApiKey:
+		required = map[string]string{"apiKey": s.ApiKey}
+	case providers.Basic:
+		required = map[string]string{"username": s.Username}
+	case providers.Custom:
+		required = make(map[string]string)
+
+		if info.CustomOpts != nil {
+			for _, input := range info.CustomOpts.Inputs {
+				required["custom."+input.Name] = s.Custom[input.Name]
+			}
+		}
+	case providers.Oauth1:
+		required = map[string]string{
+			"consumerKey":    s.ConsumerKey,
+			"consumerSecret": s.ConsumerSecret,
+			"token":          s.Token,
+			"tokenSecret":    s.TokenSecret,
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedAuthType, authType)
+	}
+
+	var missing []string
+
+	for name, value := range required {
+		if value == "" {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) != 0 {
+		slices.Sort(missing)
+
+		return fmt.Errorf("%w: %s", ErrMissingSecret, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// appliesToModule tells if a metadata item is needed by the module.
+// Items without dependencies are needed by every module.
+func appliesToModule(dependencies *providers.ModuleDependencies, module common.ModuleID) bool {
+	if dependencies == nil || len(*dependencies) == 0 {
+		return true
+	}
+
+	_, ok := (*dependencies)[module]
+
+	return ok
+}
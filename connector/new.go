@@ -5,6 +5,7 @@ import (
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/providers"
 	"github.com/amp-labs/connectors/providers/acuityscheduling"
 	"github.com/amp-labs/connectors/providers/aha"
@@ -105,6 +106,7 @@ import (
 	"github.com/amp-labs/connectors/providers/sellsy"
 	"github.com/amp-labs/connectors/providers/servicenow"
 	"github.com/amp-labs/connectors/providers/shopify"
+	"github.com/amp-labs/connectors/providers/slack"
 	"github.com/amp-labs/connectors/providers/smartlead"
 	"github.com/amp-labs/connectors/providers/snapchatads"
 	"github.com/amp-labs/connectors/providers/snowflake"
@@ -127,6 +129,11 @@ func New(provider providers.Provider, params common.ConnectorParams) (connectors
 		return nil, ErrInvalidProvider
 	}
 
+	if params.Governor != nil && params.AuthenticatedClient != nil {
+		// Every request of the connector waits for the governor, regardless of how the connector is built.
+		params.AuthenticatedClient = governor.NewClient(params.AuthenticatedClient, params.Governor)
+	}
+
 	return constructor(params)
 }
 
@@ -230,6 +237,7 @@ var connectorConstructors = map[providers.Provider]outputConstructorFunc{ // nol
 	providers.Sellsy:                  wrapper(newSellsyConnector),
 	providers.ServiceNow:              wrapper(newServiceNowConnector),
 	providers.Shopify:                 wrapper(newShopifyConnector),
+	providers.Slack:                   wrapper(newSlackConnector),
 	providers.Smartlead:               wrapper(newSmartleadConnector),
 	providers.SnapchatAds:             wrapper(newSnapchatAdsConnector),
 	providers.Snowflake:               wrapper(newSnowflakeConnector),
@@ -408,32 +416,18 @@ func newPipedriveConnector(
 func newZohoConnector(
 	params common.ConnectorParams,
 ) (*zoho.Connector, error) {
-	domains, err := zoho.GetDomainsForLocation("us")
+	// Data center of the account is named by the OAuth callback or the token response,
+	// US data center is used otherwise.
+	authMetadata, err := zoho.NewAuthMetadataVars(params.Metadata).Resolve()
 	if err != nil {
 		return nil, err
 	}
 
-	if params.Metadata != nil {
-		apiDomain, found := params.Metadata["zoho_api_domain"]
-		if found && apiDomain != "" {
-			domains.ApiDomain = apiDomain
-		}
-
-		deskDomain, found := params.Metadata["zoho_desk_domain"]
-		if found && deskDomain != "" {
-			domains.DeskDomain = deskDomain
-		}
-
-		tokenDomain, found := params.Metadata["zoho_token_domain"]
-		if found && tokenDomain != "" {
-			domains.TokenDomain = tokenDomain
-		}
-	}
-
 	return zoho.NewConnector(
 		zoho.WithAuthenticatedClient(params.AuthenticatedClient),
 		zoho.WithModule(params.Module),
-		zoho.WithDomains(domains),
+		zoho.WithLocation(authMetadata.Location),
+		zoho.WithDomains(&authMetadata.LocationDomains),
 	)
 }
 
@@ -959,6 +953,12 @@ func newShopifyConnector(
 	return shopify.NewConnector(params)
 }
 
+func newSlackConnector(
+	params common.ConnectorParams,
+) (*slack.Connector, error) {
+	return slack.NewConnector(params)
+}
+
 func newKaseyaVSAXConnector(
 	params common.ConnectorParams,
 ) (*kaseyavsax.Connector, error) {
@@ -0,0 +1,70 @@
+package connector
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+)
+
+// Stripe is built with options, the governor reaches it only through the client wrapped by New.
+func TestNewAppliesGovernor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutex       sync.Mutex
+		inFlight    int
+		maxInFlight int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		inFlight--
+		mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"cus_1","object":"customer"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	conn, err := New(providers.Stripe, common.ConnectorParams{
+		AuthenticatedClient: mockutils.NewRedirectClient(server.URL),
+		Governor:            governor.New(governor.Limits{MaxInFlight: 1}),
+	})
+	if err != nil {
+		t.Fatalf("failed to create connector: %v", err)
+	}
+
+	reader, ok := conn.(connectors.BatchRecordReaderConnector)
+	if !ok {
+		t.Fatalf("expected batch record reader, got %T", conn)
+	}
+
+	rows, err := reader.GetRecordsByIds(t.Context(), "customers",
+		[]string{"cus_1", "cus_2", "cus_3", "cus_4"}, []string{"id"}, nil)
+	if err != nil {
+		t.Fatalf("failed to read records: %v", err)
+	}
+
+	if len(rows) != 4 {
+		t.Fatalf("expected 4 records, got %d", len(rows))
+	}
+
+	if maxInFlight != 1 {
+		t.Fatalf("expected requests to be sent one at a time, got %d in flight", maxInFlight)
+	}
+}
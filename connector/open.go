@@ -0,0 +1,365 @@
+package connector
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/paramsbuilder"
+	"github.com/amp-labs/connectors/common/substitutions"
+	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
+	"github.com/amp-labs/connectors/providers"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// OpenOption customizes how Open builds the connection.
+type OpenOption func(*openParams)
+
+type openParams struct {
+	client         *http.Client
+	debug          bool
+	onPostAuth     func(info *common.PostAuthInfo)
+	onTokenUpdated func(token *oauth2.Token)
+	refreshGroup   *common.TokenRefreshGroup
+	connectionID   string
+
+	// tokenSource is shared by the clients built by Open, the token is refreshed once.
+	// It is replaced only when the collected metadata changes the token URL.
+	tokenSource oauth2.TokenSource
+	tokenURL    string
+	// latestToken is the token last obtained by the token source.
+	latestToken *oauth2.Token
+	tokenMutex  sync.Mutex
+}
+
+// WithHTTPClient sets the client sending requests, the default http client is used otherwise.
+func WithHTTPClient(client *http.Client) OpenOption {
+	return func(params *openParams) {
+		params.client = client
+	}
+}
+
+// WithDebug enables debug mode of the authenticated client.
+func WithDebug() OpenOption {
+	return func(params *openParams) {
+		params.debug = true
+	}
+}
+
+// WithPostAuthInfo receives metadata collected after authentication.
+// Storing the collected values with the credential bundle lets the next Open skip the discovery.
+func WithPostAuthInfo(receive func(info *common.PostAuthInfo)) OpenOption {
+	return func(params *openParams) {
+		params.onPostAuth = receive
+	}
+}
+
+// WithTokenUpdated receives the OAuth2 token whenever it is refreshed.
+// Storing it with the credential bundle keeps rotating refresh tokens usable.
+func WithTokenUpdated(receive func(token *oauth2.Token)) OpenOption {
+	return func(params *openParams) {
+		params.onTokenUpdated = receive
+	}
+}
+
+// WithTokenRefreshGroup serializes token refreshes with other clients of the same connection.
+// Without it, clients opened from the same refresh token share the refreshes within the process.
+func WithTokenRefreshGroup(group *common.TokenRefreshGroup, connectionID string) OpenOption {
+	return func(params *openParams) {
+		params.refreshGroup = group
+		params.connectionID = connectionID
+	}
+}
+
+// Open builds the authenticated client and the connector from the credential bundle.
+// The bundle is validated against the catalog before any request is sent:
+// metadata inputs of the module and secrets of the auth type must be given.
+// When the provider needs metadata collected after authentication, which the bundle doesn't hold,
+// the connector discovers it and is rebuilt with the collected values.
+// The rebuilt connector reuses the OAuth2 token of the first one, it is never refreshed twice.
+func Open(ctx context.Context, creds Credentials, opts ...OpenOption) (connectors.Connector, error) {
+	if creds.Provider == "" {
+		return nil, ErrMissingProvider
+	}
+
+	params := &openParams{}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	info, err := providers.ReadInfo(creds.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	module := creds.Module
+	if module == "" {
+		module = info.DefaultModule
+	}
+
+	authType, err := creds.authType(info)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = creds.validateMetadata(info, module); err != nil {
+		return nil, err
+	}
+
+	if err = creds.Secrets.validate(info, authType); err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]string, len(creds.Metadata))
+	maps.Copy(metadata, creds.Metadata)
+
+	conn, err := params.connect(ctx, creds, authType, module, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if !needsPostAuthInfo(info, module, metadata) {
+		return conn, nil
+	}
+
+	collected, err := params.postAuthenticate(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(collected) == 0 {
+		return conn, nil
+	}
+
+	// Collected values don't override the given ones.
+	for name, value := range collected {
+		if metadata[name] == "" {
+			metadata[name] = value
+		}
+	}
+
+	// Catalog templates, the token URL included, may depend on the collected values.
+	return params.connect(ctx, creds, authType, module, metadata)
+}
+
+// connect builds the authenticated client and the connector using catalog substituted with the metadata.
+func (p *openParams) connect(
+	ctx context.Context, creds Credentials, authType providers.AuthType,
+	module common.ModuleID, metadata map[string]string,
+) (connectors.Connector, error) {
+	client, err := p.authenticate(ctx, creds, authType, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(creds.Provider, common.ConnectorParams{
+		Module:              module,
+		AuthenticatedClient: client,
+		Workspace:           metadata[catalogreplacer.VariableWorkspace],
+		Metadata:            metadata,
+	})
+}
+
+// authenticate builds the client, the token URL and other templates of the catalog are resolved with the metadata.
+func (p *openParams) authenticate(
+	ctx context.Context, creds Credentials, authType providers.AuthType, metadata map[string]string,
+) (common.AuthenticatedHTTPClient, error) { // nolint:ireturn
+	info, err := providers.ReadInfo(creds.Provider,
+		paramsbuilder.NewCatalogVariables(substitutions.Registry[string](metadata))...)
+	if err != nil {
+		return nil, err
+	}
+
+	params := p.newClientParams(info, authType, creds.Secrets)
+
+	if authCode := params.OAuth2AuthCodeCreds; authCode != nil {
+		tokenSource, err := p.authCodeTokenSource(ctx, authCode)
+		if err != nil {
+			return nil, err
+		}
+
+		authCode.Options = append(authCode.Options, common.WithTokenSource(tokenSource))
+	}
+
+	return info.NewClient(ctx, params)
+}
+
+// authCodeTokenSource returns the token source shared by clients of the connection.
+// It is created along with the first client. A client whose token URL differs, ex: resolved with the collected
+// data center, gets the source of its token URL, which starts from the latest token of the previous source.
+func (p *openParams) authCodeTokenSource(
+	ctx context.Context, authCode *providers.OAuth2AuthCodeParams,
+) (oauth2.TokenSource, error) { // nolint:ireturn
+	if p.tokenSource != nil && p.tokenURL == authCode.Config.Endpoint.TokenURL {
+		return p.tokenSource, nil
+	}
+
+	token := authCode.Token
+
+	p.tokenMutex.Lock()
+	if p.latestToken != nil {
+		token = p.latestToken
+	}
+	p.tokenMutex.Unlock()
+
+	options := []common.OAuthOption{
+		common.WithOAuthConfig(authCode.Config),
+		common.WithOAuthToken(token),
+		common.WithTokenUpdated(func(_, newToken *oauth2.Token) error {
+			p.tokenMutex.Lock()
+			p.latestToken = newToken
+			p.tokenMutex.Unlock()
+
+			if p.onTokenUpdated != nil {
+				p.onTokenUpdated(newToken)
+			}
+
+			return nil
+		}),
+	}
+
+	if p.client != nil {
+		options = append(options, common.WithOAuthClient(p.client))
+	}
+
+	if p.refreshGroup != nil {
+		options = append(options, common.WithTokenRefreshGroup(p.refreshGroup, p.connectionID))
+	}
+
+	tokenSource, err := common.NewOAuthTokenSource(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	p.tokenURL = authCode.Config.Endpoint.TokenURL
+	p.tokenSource = tokenSource
+
+	return tokenSource, nil
+}
+
+func (p *openParams) newClientParams(
+	info *providers.ProviderInfo, authType providers.AuthType, secrets Secrets,
+) *providers.NewClientParams {
+	params := &providers.NewClientParams{
+		Debug:  p.debug,
+		Client: p.client,
+	}
+
+	switch authType {
+	case providers.Oauth2:
+		if info.Oauth2Opts == nil {
+			// Client constructor reports the broken catalog entry.
+			return params
+		}
+
+		if info.Oauth2Opts.GrantType == providers.ClientCredentials {
+			params.OAuth2ClientCreds = &providers.OAuth2ClientCredentialsParams{
+				Config: secrets.clientCredentialsConfig(info.Oauth2Opts),
+			}
+
+			return params
+		}
+
+		params.OAuth2AuthCodeCreds = &providers.OAuth2AuthCodeParams{
+			Config: secrets.authCodeConfig(info.Oauth2Opts),
+			Token: &oauth2.Token{
+				AccessToken:  secrets.AccessToken,
+				RefreshToken: secrets.RefreshToken,
+				Expiry:       secrets.Expiry,
+			},
+		}
+	case providers.ApiKey:
+		params.ApiKeyCreds = &providers.ApiKeyParams{Key: secrets.ApiKey}
+	case providers.Basic:
+		params.BasicCreds = &providers.BasicParams{User: secrets.Username, Pass: secrets.Password}
+	case providers.Custom:
+		params.CustomCreds = &providers.CustomAuthParams{Values: secrets.Custom}
+	case providers.Oauth1:
+		params.OAuth1Creds = &providers.OAuth1Params{
+			ConsumerKey:    secrets.ConsumerKey,
+			ConsumerSecret: secrets.ConsumerSecret,
+			Token:          secrets.Token,
+			TokenSecret:    secrets.TokenSecret,
+			Realm:          secrets.Realm,
+		}
+	case providers.None, providers.Jwt:
+	}
+
+	return params
+}
+
+// authCodeConfig is used by authorization code and password grants, both refresh tokens the same way.
+func (s Secrets) authCodeConfig(opts *providers.Oauth2Opts) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     s.ClientId,
+		ClientSecret: s.ClientSecret,
+		Scopes:       s.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   opts.AuthURL,
+			TokenURL:  opts.TokenURL,
+			AuthStyle: oauth2.AuthStyleAutoDetect,
+		},
+	}
+}
+
+func (s Secrets) clientCredentialsConfig(opts *providers.Oauth2Opts) *clientcredentials.Config {
+	config := &clientcredentials.Config{
+		ClientID:     s.ClientId,
+		ClientSecret: s.ClientSecret,
+		TokenURL:     opts.TokenURL,
+	}
+
+	if opts.ExplicitScopesRequired {
+		config.Scopes = s.Scopes
+	}
+
+	if opts.Audience != nil {
+		config.EndpointParams = url.Values{"audience": opts.Audience}
+	}
+
+	return config
+}
+
+// postAuthenticate returns metadata collected by the connector after authentication.
+func (p *openParams) postAuthenticate(ctx context.Context, conn connectors.Connector) (map[string]string, error) {
+	authConn, ok := conn.(connectors.AuthMetadataConnector)
+	if !ok {
+		return nil, nil // nolint:nilnil
+	}
+
+	postAuthInfo, err := authConn.GetPostAuthInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.onPostAuth != nil {
+		p.onPostAuth(postAuthInfo)
+	}
+
+	if postAuthInfo == nil || postAuthInfo.CatalogVars == nil {
+		return nil, nil // nolint:nilnil
+	}
+
+	return *postAuthInfo.CatalogVars, nil
+}
+
+// needsPostAuthInfo tells if some of post authentication metadata of the module is missing.
+// Providers which need post authentication info without listing the collected metadata are always asked.
+func needsPostAuthInfo(info *providers.ProviderInfo, module common.ModuleID, metadata map[string]string) bool {
+	if info.Metadata == nil || len(info.Metadata.PostAuthentication) == 0 {
+		return info.PostAuthInfoNeeded
+	}
+
+	for _, item := range info.Metadata.PostAuthentication {
+		if appliesToModule(item.ModuleDependencies, module) && metadata[item.Name] == "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,332 @@
+package connector
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/atlassian"
+	"github.com/amp-labs/connectors/providers/zoho"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"golang.org/x/oauth2"
+)
+
+func atlassianCredentials(metadata map[string]string) Credentials {
+	return Credentials{
+		Provider: providers.Atlassian,
+		Secrets: Secrets{
+			ClientId:     "client",
+			AccessToken:  "access",
+			RefreshToken: "refresh",
+			Expiry:       time.Now().Add(time.Hour),
+		},
+		Metadata: metadata,
+	}
+}
+
+func TestOpenValidation(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		credentials Credentials
+		expectedErr error
+	}{
+		{
+			name:        "Provider is required",
+			credentials: Credentials{},
+			expectedErr: ErrMissingProvider,
+		},
+		{
+			name:        "Metadata input of the module is required",
+			credentials: atlassianCredentials(nil),
+			expectedErr: common.ErrMissingMetadata,
+		},
+		{
+			name: "Tokens are required by authorization code grant",
+			credentials: Credentials{
+				Provider: providers.Atlassian,
+				Secrets:  Secrets{ClientId: "client"},
+				Metadata: map[string]string{"workspace": "acme"},
+			},
+			expectedErr: ErrMissingSecret,
+		},
+		{
+			name: "Auth type must match the catalog",
+			credentials: Credentials{
+				Provider: providers.Atlassian,
+				AuthType: providers.ApiKey,
+				Secrets:  Secrets{ApiKey: "key"},
+				Metadata: map[string]string{"workspace": "acme"},
+			},
+			expectedErr: ErrUnsupportedAuthType,
+		},
+		{
+			name: "Custom inputs are required",
+			credentials: Credentials{
+				Provider: providers.Discourse,
+				Secrets:  Secrets{Custom: map[string]string{"apiKey": "key"}},
+				Metadata: map[string]string{"workspace": "forum.example.com"},
+			},
+			expectedErr: ErrMissingSecret,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Open(t.Context(), tt.credentials)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestOpenPostAuthentication(t *testing.T) {
+	t.Parallel()
+
+	var discoveries atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth/token/accessible-resources" || r.Header.Get("Authorization") != "Bearer access" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		discoveries.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":"other-cloud","name":"other"},{"id":"acme-cloud","name":"acme"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := mockutils.NewRedirectClient(server.URL)
+
+	var collected map[string]string
+
+	conn, err := Open(t.Context(), atlassianCredentials(map[string]string{"workspace": "acme"}),
+		WithHTTPClient(client),
+		WithPostAuthInfo(func(info *common.PostAuthInfo) {
+			collected = *info.CatalogVars
+		}),
+	)
+	if err != nil {
+		t.Fatalf("failed to open connection: %v", err)
+	}
+
+	if _, ok := conn.(*atlassian.Connector); !ok {
+		t.Fatalf("expected atlassian connector, got %T", conn)
+	}
+
+	if collected["cloudId"] != "acme-cloud" {
+		t.Fatalf("expected cloudId to be collected, got %v", collected)
+	}
+
+	// Metadata stored with the bundle skips the discovery.
+	_, err = Open(t.Context(), atlassianCredentials(map[string]string{"workspace": "acme", "cloudId": "acme-cloud"}),
+		WithHTTPClient(client),
+	)
+	if err != nil {
+		t.Fatalf("failed to open connection: %v", err)
+	}
+
+	if count := discoveries.Load(); count != 1 {
+		t.Fatalf("expected a single discovery, got %d", count)
+	}
+}
+
+func TestOpenRefreshesTokenOnce(t *testing.T) {
+	t.Parallel()
+
+	var refreshes atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/oauth/token":
+			// Refresh token is rotated, each one is accepted once.
+			if refreshes.Add(1) != 1 || r.FormValue("refresh_token") != "refresh-once" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"access_token":"refreshed","refresh_token":"rotated",` +
+				`"token_type":"Bearer","expires_in":3600}`))
+		case r.Header.Get("Authorization") != "Bearer refreshed":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			_, _ = w.Write([]byte(`[{"id":"acme-cloud","name":"acme"}]`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	creds := atlassianCredentials(map[string]string{"workspace": "acme"})
+	creds.Secrets.RefreshToken = "refresh-once"
+	creds.Secrets.Expiry = time.Now().Add(-time.Hour)
+
+	var updated []*oauth2.Token
+
+	_, err := Open(t.Context(), creds,
+		WithHTTPClient(mockutils.NewRedirectClient(server.URL)),
+		WithTokenUpdated(func(token *oauth2.Token) {
+			updated = append(updated, token)
+		}),
+		WithTokenRefreshGroup(common.NewTokenRefreshGroup(), "connection"),
+	)
+	if err != nil {
+		t.Fatalf("failed to open connection: %v", err)
+	}
+
+	if count := refreshes.Load(); count != 1 {
+		t.Fatalf("expected the token to be refreshed once, got %d", count)
+	}
+
+	if len(updated) != 1 || updated[0].RefreshToken != "rotated" {
+		t.Fatalf("expected the rotated token to be reported once, got %v", updated)
+	}
+}
+
+func TestOpenRefreshesAtCollectedTokenDomain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutex      sync.Mutex
+		tokenHosts []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/oauth/v2/token" {
+			mutex.Lock()
+			tokenHosts = append(tokenHosts, r.Host)
+			mutex.Unlock()
+			_, _ = w.Write([]byte(`{"access_token":"refreshed","token_type":"Bearer","expires_in":3600}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	// Location of the account is given by the OAuth callback.
+	conn, err := Open(t.Context(), Credentials{
+		Provider: providers.Zoho,
+		Secrets: Secrets{
+			ClientId:     "client",
+			AccessToken:  "access",
+			RefreshToken: "refresh-eu",
+			Expiry:       time.Now().Add(-time.Hour),
+		},
+		Metadata: map[string]string{"zoho_location": "eu"},
+	},
+		WithHTTPClient(mockutils.NewRedirectClient(server.URL)),
+		WithTokenRefreshGroup(common.NewTokenRefreshGroup(), "connection"),
+	)
+	if err != nil {
+		t.Fatalf("failed to open connection: %v", err)
+	}
+
+	zohoConn, ok := conn.(*zoho.Connector)
+	if !ok {
+		t.Fatalf("expected zoho connector, got %T", conn)
+	}
+
+	rsp, err := zohoConn.Client.Get(t.Context(), zohoConn.BaseURL+"/crm/v6/org")
+	if err != nil {
+		t.Fatalf("failed to send request: %v", err)
+	}
+
+	if rsp.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rsp.Code)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(tokenHosts) != 1 || tokenHosts[0] != "accounts.zoho.eu" {
+		t.Fatalf("expected the expired token to be refreshed at accounts.zoho.eu, got %v", tokenHosts)
+	}
+}
+
+func TestAuthenticateResolvesTokenURL(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutex      sync.Mutex
+		tokenHosts []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/oauth/v2/token" {
+			mutex.Lock()
+			tokenHosts = append(tokenHosts, r.Host)
+			mutex.Unlock()
+			_, _ = w.Write([]byte(`{"access_token":"refreshed","token_type":"Bearer","expires_in":3600}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	params := &openParams{
+		client:       mockutils.NewRedirectClient(server.URL),
+		refreshGroup: common.NewTokenRefreshGroup(),
+		connectionID: "connection",
+	}
+	creds := Credentials{
+		Provider: providers.Zoho,
+		Secrets: Secrets{
+			ClientId:     "client",
+			AccessToken:  "access",
+			RefreshToken: "refresh",
+			Expiry:       time.Now().Add(-time.Hour),
+		},
+	}
+
+	// Collected metadata of an account in the EU data center.
+	client, err := params.authenticate(t.Context(), creds, providers.Oauth2, map[string]string{
+		"zoho_api_domain":             "www.zohoapis.eu",
+		"zoho_desk_domain":            "desk.zoho.eu",
+		"zoho_servicedeskplus_domain": "sdpondemand.manageengine.eu",
+		"zoho_token_domain":           "accounts.zoho.eu",
+	})
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://www.zohoapis.eu/crm/v6/org", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+
+	rsp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("failed to send request: %v", err)
+	}
+
+	_ = rsp.Body.Close()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(tokenHosts) != 1 || tokenHosts[0] != "accounts.zoho.eu" {
+		t.Fatalf("expected the expired token to be refreshed at accounts.zoho.eu, got %v", tokenHosts)
+	}
+}
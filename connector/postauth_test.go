@@ -0,0 +1,250 @@
+package connector
+
+import (
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+)
+
+// postAuthExceptions lists providers whose post authentication metadata is not collected by the connector.
+var postAuthExceptions = map[providers.Provider]string{ // nolint:gochecknoglobals
+	providers.Hubspot: "owner id is collected by the server from the token info endpoint",
+}
+
+// postAuthResponses answers post authentication requests of every provider which declares the metadata.
+// Responses are keyed by the request path.
+var postAuthResponses = map[providers.Provider]map[string]string{ // nolint:gochecknoglobals
+	providers.Atlassian: {
+		"/oauth/token/accessible-resources": `[{"id":"ebc887b2-7e61-4059-ab35-71f15cc16e12","name":"acme"}]`,
+	},
+	providers.Calendly: {
+		"/users/me": `{"resource":{"uri":"https://api.calendly.com/users/A",
+			"current_organization":"https://api.calendly.com/organizations/B"}}`,
+	},
+	providers.Docusign: {
+		"/oauth/userinfo": `{"accounts":[{"is_default":true,"base_uri":"https://na3.docusign.net"}]}`,
+	},
+	providers.Netsuite: {
+		"/services/rest/query/v1/suiteql": `{"items":[{"timezone":"America/Los_Angeles"}]}`,
+	},
+	providers.Pipedrive: {
+		"/v1/users/me": `{"success":true,"data":{"id":1,"company_domain":"acme"}}`,
+	},
+	providers.SnapchatAds: {
+		"/v1/me": `{"me":{"organization_id":"0b3d8e3a-4a3d-4c1e-9f43-7b1d7c1d8e2c"}}`,
+	},
+	providers.Xero: {
+		"/connections": `[{"tenantId":"e1eede29-f875-4a5d-8470-17f6a29a88b1"}]`,
+	},
+	providers.ZendeskSupport: {
+		"/api/v2/users/me": `{"user":{"id":35436,"url":"https://acme.zendesk.com/api/v2/users/35436.json"}}`,
+	},
+	providers.Zoho: {}, // Data center is named by the metadata, no request is sent.
+}
+
+// templateVariable matches catalog variables, ex: {{.workspace}}.
+var templateVariable = regexp.MustCompile(`{{\s*\.(\w+)\s*}}`) // nolint:gochecknoglobals
+
+// TestPostAuthenticationConformance checks that every provider declaring post authentication metadata
+// has a connector collecting it, and the collected metadata matches the declaration of the catalog.
+// Catalog templates of the module must be covered by the declared metadata.
+func TestPostAuthenticationConformance(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := providers.ReadCatalog()
+	if err != nil {
+		t.Fatalf("failed to read catalog: %v", err)
+	}
+
+	for provider, info := range catalog.Catalog {
+		if !info.PostAuthInfoNeeded && len(postAuthItems(&info)) == 0 {
+			continue
+		}
+
+		if _, ok := postAuthExceptions[provider]; ok {
+			continue
+		}
+
+		if _, ok := connectorConstructors[provider]; !ok {
+			// Catalog entries without a connector are out of scope.
+			continue
+		}
+
+		t.Run(provider, func(t *testing.T) {
+			t.Parallel()
+
+			checkTemplateVariables(t, &info)
+
+			for _, module := range postAuthModules(&info) {
+				t.Run(string(module), func(t *testing.T) {
+					t.Parallel()
+
+					checkPostAuthInfo(t, provider, &info, module)
+				})
+			}
+		})
+	}
+}
+
+func postAuthItems(info *providers.ProviderInfo) []providers.MetadataItemPostAuthentication {
+	if info.Metadata == nil {
+		return nil
+	}
+
+	return info.Metadata.PostAuthentication
+}
+
+// postAuthModules returns the default module and every module some post authentication metadata applies to.
+func postAuthModules(info *providers.ProviderInfo) []common.ModuleID {
+	modules := []common.ModuleID{info.DefaultModule}
+
+	if info.Modules == nil {
+		return modules
+	}
+
+	for id := range *info.Modules {
+		module := common.ModuleID(id)
+		if module == info.DefaultModule {
+			continue
+		}
+
+		for _, item := range postAuthItems(info) {
+			if item.ModuleDependencies != nil && appliesToModule(item.ModuleDependencies, module) {
+				modules = append(modules, module)
+
+				break
+			}
+		}
+	}
+
+	return modules
+}
+
+// checkTemplateVariables verifies that variables of module base URLs and of the token URL
+// are either given by the user or collected after authentication.
+func checkTemplateVariables(t *testing.T, info *providers.ProviderInfo) {
+	t.Helper()
+
+	templates := map[common.ModuleID][]string{
+		info.DefaultModule: {info.ReadModuleInfo(info.DefaultModule).BaseURL},
+	}
+
+	if info.Oauth2Opts != nil {
+		templates[info.DefaultModule] = append(templates[info.DefaultModule], info.Oauth2Opts.TokenURL)
+	}
+
+	if info.Modules != nil {
+		for id, module := range *info.Modules {
+			templates[common.ModuleID(id)] = append(templates[common.ModuleID(id)], module.BaseURL)
+		}
+	}
+
+	for module, moduleTemplates := range templates {
+		declared := declaredMetadata(info, module)
+
+		for _, template := range moduleTemplates {
+			for _, match := range templateVariable.FindAllStringSubmatch(template, -1) {
+				if !declared[match[1]] {
+					t.Errorf("%s of module %q uses %s, which is not declared as metadata of the module",
+						template, module, match[1])
+				}
+			}
+		}
+	}
+}
+
+// declaredMetadata returns names of metadata given by the user or collected after authentication for the module.
+func declaredMetadata(info *providers.ProviderInfo, module common.ModuleID) map[string]bool {
+	declared := map[string]bool{"workspace": true}
+
+	if info.Metadata != nil {
+		for _, input := range info.Metadata.Input {
+			if appliesToModule(input.ModuleDependencies, module) {
+				declared[input.Name] = true
+			}
+		}
+	}
+
+	for _, item := range postAuthItems(info) {
+		if appliesToModule(item.ModuleDependencies, module) {
+			declared[item.Name] = true
+		}
+	}
+
+	return declared
+}
+
+// checkPostAuthInfo verifies that the connector collects exactly the metadata declared for the module.
+func checkPostAuthInfo(
+	t *testing.T, provider providers.Provider, info *providers.ProviderInfo, module common.ModuleID,
+) {
+	t.Helper()
+
+	responses, ok := postAuthResponses[provider]
+	if !ok {
+		t.Fatalf("%s declares post authentication metadata, responses of the provider are missing", provider)
+	}
+
+	cases := make([]mockserver.Case, 0, len(responses))
+	for path, body := range responses {
+		cases = append(cases, mockserver.Case{
+			If:   mockcond.Path(path),
+			Then: mockserver.ResponseString(http.StatusOK, body),
+		})
+	}
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: cases,
+	}.Server()
+	defer server.Close()
+
+	conn, err := New(provider, common.ConnectorParams{
+		Module:              module,
+		AuthenticatedClient: mockutils.NewRedirectClient(server.URL),
+		Workspace:           "acme",
+		Metadata:            map[string]string{"workspace": "acme"},
+	})
+	if err != nil {
+		t.Fatalf("failed to construct connector: %v", err)
+	}
+
+	authConn, ok := conn.(connectors.AuthMetadataConnector)
+	if !ok {
+		t.Fatalf("%s declares post authentication metadata, its connector doesn't collect it", provider)
+	}
+
+	postAuthInfo, err := authConn.GetPostAuthInfo(t.Context())
+	if err != nil {
+		t.Fatalf("failed to collect post authentication metadata: %v", err)
+	}
+
+	var expected []string
+
+	for _, item := range postAuthItems(info) {
+		if appliesToModule(item.ModuleDependencies, module) {
+			expected = append(expected, item.Name)
+		}
+	}
+
+	var collected []string
+	if postAuthInfo.CatalogVars != nil {
+		collected = slices.Collect(maps.Keys(*postAuthInfo.CatalogVars))
+	}
+
+	slices.Sort(expected)
+	slices.Sort(collected)
+
+	if !slices.Equal(expected, collected) {
+		t.Fatalf("collected metadata %v doesn't match the catalog declaration %v", collected, expected)
+	}
+}
@@ -55,6 +55,17 @@ type ReadConnector interface {
 	Read(ctx context.Context, params ReadParams) (*ReadResult, error)
 }
 
+// SearchConnector is an interface that extends the Connector interface with search capabilities.
+type SearchConnector interface {
+	Connector
+
+	// SearchRecords reads a page of records matching the filter, sorted by the sort keys.
+	// The query is translated into the provider query language, filters that cannot be
+	// expressed are rejected with common.ErrUnsupportedSearchFilter.
+	// Paging works the same way as in Read, NextPage is passed until Done is true.
+	SearchRecords(ctx context.Context, params SearchParams) (*ReadResult, error)
+}
+
 // WriteConnector is an interface that extends the Connector interface with write capabilities.
 type WriteConnector interface {
 	Connector
@@ -86,6 +97,34 @@ type BatchWriteConnector interface {
 	BatchWrite(ctx context.Context, params *common.BatchWriteParam) (*common.BatchWriteResult, error)
 }
 
+// BulkJobConnector runs asynchronous jobs writing large amounts of records.
+// A job is submitted once, then polled with GetBulkJob until its state is terminal,
+// after which the outcome of every submitted row can be streamed.
+//
+// Errors returned from the methods represent connector-level issues,
+// rows rejected by the provider are reported by GetBulkJobResults.
+type BulkJobConnector interface {
+	Connector
+
+	// SubmitBulkJob uploads rows and starts the job.
+	SubmitBulkJob(ctx context.Context, params common.BulkJobParams) (*common.BulkJob, error)
+
+	// GetBulkJob returns the current status of the job.
+	GetBulkJob(ctx context.Context, jobID string) (*common.BulkJob, error)
+
+	// ListBulkJobs returns jobs which are still retained by the provider.
+	ListBulkJobs(ctx context.Context) ([]common.BulkJob, error)
+
+	// AbortBulkJob stops the job, rows processed so far are not rolled back.
+	AbortBulkJob(ctx context.Context, jobID string) (*common.BulkJob, error)
+
+	// GetBulkJobResults passes successful and failed rows of the finished job to the handler.
+	// Rows are not guaranteed to arrive in the order of submission, use BulkJobResultRow.Index instead.
+	GetBulkJobResults(
+		ctx context.Context, params common.BulkJobResultsParams, handler common.BulkJobResultHandler,
+	) error
+}
+
 // ObjectMetadataConnector is an interface that extends the Connector interface with
 // the ability to list object metadata.
 type ObjectMetadataConnector interface {
@@ -328,9 +367,26 @@ type ConfigurationConnector interface {
 	DefaultPageSize() int
 }
 
+// IdempotencyConnector reports how idempotency keys of writes are honoured.
+// Connectors that don't implement this interface ignore the keys.
+type IdempotencyConnector interface {
+	Connector
+
+	IdempotencyMode() common.IdempotencyMode
+}
+
+// CredentialsHealthConnector probes its credentials without relying on the catalog.
+// It is a fallback for providers without AuthHealthCheck, see CheckCredentials.
+type CredentialsHealthConnector interface {
+	Connector
+
+	CheckCredentials(ctx context.Context) (*common.CredentialsHealth, error)
+}
+
 // We re-export the following types so that they can be used by consumers of this library.
 type (
 	ReadParams               = common.ReadParams
+	SearchParams             = common.SearchParams
 	WriteParams              = common.WriteParams
 	DeleteParams             = common.DeleteParams
 	ReadResult               = common.ReadResult
@@ -340,7 +396,14 @@ type (
 	BatchWriteType           = common.BatchWriteType
 	BatchWriteResult         = common.BatchWriteResult
 	BatchStatus              = common.BatchStatus
+	BulkJobParams            = common.BulkJobParams
+	BulkJob                  = common.BulkJob
+	BulkJobResultRow         = common.BulkJobResultRow
+	BulkJobResultsParams     = common.BulkJobResultsParams
+	AssociationInput         = common.AssociationInput
 	ListObjectMetadataResult = common.ListObjectMetadataResult
+	IdempotencyMode          = common.IdempotencyMode
+	CredentialsHealth        = common.CredentialsHealth
 
 	ErrorWithStatus = common.HTTPError //nolint:errname
 )
@@ -351,6 +414,8 @@ const (
 	BatchStatusPartial   = common.BatchStatusPartial
 	BatchWriteTypeCreate = common.BatchWriteTypeCreate
 	BatchWriteTypeUpdate = common.BatchWriteTypeUpdate
+	BatchWriteTypeUpsert = common.BatchWriteTypeUpsert
+	BatchWriteTypeDelete = common.BatchWriteTypeDelete
 )
 
 var Fields = datautils.NewStringSet // nolint:gochecknoglobals
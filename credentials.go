@@ -0,0 +1,26 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+)
+
+// CheckCredentials probes credentials used by the connector.
+// The catalog AuthHealthCheck of the provider info is preferred, it should be read with catalog variables.
+// Connectors implementing CredentialsHealthConnector are used otherwise.
+func CheckCredentials(
+	ctx context.Context, conn Connector, info *providers.ProviderInfo,
+) (*common.CredentialsHealth, error) {
+	if info != nil && info.AuthHealthCheck != nil {
+		return info.CheckCredentials(ctx, conn.HTTPClient().Client)
+	}
+
+	if checker, ok := conn.(CredentialsHealthConnector); ok {
+		return checker.CheckCredentials(ctx)
+	}
+
+	return nil, fmt.Errorf("%w: %s", common.ErrNoHealthCheck, conn.Provider())
+}
@@ -0,0 +1,216 @@
+// Package idempotency deduplicates writes on the client side for connectors
+// that cannot pass idempotency keys to the provider.
+//
+// Successful results are saved in a Store under the key scoped by the object name.
+// A repeated write with the same key returns the saved result without calling the provider.
+// Failed writes are not saved, so they can be retried.
+// Connectors reporting common.IdempotencyModeNative are called directly for single writes, the provider deduplicates.
+// Keys of batch items are never forwarded by connectors, batch writes are always deduplicated here.
+//
+// Deduplication is best effort: concurrent writes with the same key are both sent,
+// and a crash between the write and Save loses the record of the write.
+package idempotency
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var (
+	_ connectors.WriteConnector       = &WriteConnector{}
+	_ connectors.IdempotencyConnector = &WriteConnector{}
+	_ connectors.BatchWriteConnector  = &BatchWriteConnector{}
+	_ connectors.IdempotencyConnector = &BatchWriteConnector{}
+)
+
+// Mode returns how the connector honours idempotency keys.
+func Mode(conn connectors.Connector) common.IdempotencyMode {
+	reporter, ok := conn.(connectors.IdempotencyConnector)
+	if !ok {
+		return common.IdempotencyModeNone
+	}
+
+	return reporter.IdempotencyMode()
+}
+
+// WriteConnector decorates connectors.WriteConnector with client side deduplication.
+type WriteConnector struct {
+	connectors.WriteConnector
+
+	store Store
+}
+
+// NewWriteConnector wraps the connector, results are remembered in the store.
+func NewWriteConnector(conn connectors.WriteConnector, store Store) *WriteConnector {
+	return &WriteConnector{
+		WriteConnector: conn,
+		store:          store,
+	}
+}
+
+func (c *WriteConnector) IdempotencyMode() common.IdempotencyMode {
+	return resolveMode(c.WriteConnector)
+}
+
+func (c *WriteConnector) Write(ctx context.Context, params common.WriteParams) (*common.WriteResult, error) {
+	if params.IdempotencyKey == "" || Mode(c.WriteConnector) == common.IdempotencyModeNative {
+		return c.WriteConnector.Write(ctx, params)
+	}
+
+	key := scopedKey(params.ObjectName, params.IdempotencyKey)
+
+	result, found, err := c.store.Load(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if found {
+		return result, nil
+	}
+
+	result, err = c.WriteConnector.Write(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Success {
+		if err = c.store.Save(ctx, key, result); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// BatchWriteConnector decorates connectors.BatchWriteConnector with client side deduplication.
+// Records with a known key are excluded from the batch and their saved results are reported instead.
+type BatchWriteConnector struct {
+	connectors.BatchWriteConnector
+
+	store Store
+}
+
+// NewBatchWriteConnector wraps the connector, per record results are remembered in the store.
+func NewBatchWriteConnector(conn connectors.BatchWriteConnector, store Store) *BatchWriteConnector {
+	return &BatchWriteConnector{
+		BatchWriteConnector: conn,
+		store:               store,
+	}
+}
+
+// IdempotencyMode is always client side, no connector forwards keys of batch items to the provider.
+func (c *BatchWriteConnector) IdempotencyMode() common.IdempotencyMode {
+	return common.IdempotencyModeClientSide
+}
+
+func (c *BatchWriteConnector) BatchWrite(
+	ctx context.Context, params *common.BatchWriteParam,
+) (*common.BatchWriteResult, error) {
+	objectName := params.ObjectName.String()
+	results := make([]*common.WriteResult, len(params.Batch))
+	pending := make(common.BatchItems, 0, len(params.Batch))
+	pendingIndices := make([]int, 0, len(params.Batch))
+
+	for index, item := range params.Batch {
+		if item.IdempotencyKey != "" {
+			result, found, err := c.store.Load(ctx, scopedKey(objectName, item.IdempotencyKey))
+			if err != nil {
+				return nil, err
+			}
+
+			if found {
+				results[index] = result
+
+				continue
+			}
+		}
+
+		pending = append(pending, item)
+		pendingIndices = append(pendingIndices, index)
+	}
+
+	if len(pending) == len(params.Batch) {
+		output, err := c.BatchWriteConnector.BatchWrite(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		return output, c.saveResults(ctx, objectName, params.Batch, output)
+	}
+
+	var (
+		extra           []common.WriteResult
+		unmatchedErrors []any
+	)
+
+	if len(pending) != 0 {
+		remaining := *params
+		remaining.Batch = pending
+
+		output, err := c.BatchWriteConnector.BatchWrite(ctx, &remaining)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = c.saveResults(ctx, objectName, pending, output); err != nil {
+			return nil, err
+		}
+
+		unmatchedErrors = output.Errors
+
+		if len(output.Results) == len(pending) {
+			for position, index := range pendingIndices {
+				results[index] = &output.Results[position]
+			}
+		} else {
+			// Results cannot be correlated to records, they follow the replayed ones.
+			extra = output.Results
+		}
+	}
+
+	combined := make([]common.WriteResult, 0, len(params.Batch))
+
+	for _, result := range results {
+		if result != nil {
+			combined = append(combined, *result)
+		}
+	}
+
+	return common.NewBatchWriteResult(append(combined, extra...), -1, len(params.Batch), unmatchedErrors)
+}
+
+// saveResults remembers successful results, when they can be matched to the records.
+func (c *BatchWriteConnector) saveResults(
+	ctx context.Context, objectName string, items common.BatchItems, output *common.BatchWriteResult,
+) error {
+	if len(output.Results) != len(items) {
+		return nil
+	}
+
+	for index, item := range items {
+		result := output.Results[index]
+		if item.IdempotencyKey == "" || !result.Success {
+			continue
+		}
+
+		if err := c.store.Save(ctx, scopedKey(objectName, item.IdempotencyKey), &result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resolveMode(conn connectors.Connector) common.IdempotencyMode {
+	if mode := Mode(conn); mode == common.IdempotencyModeNative {
+		return mode
+	}
+
+	return common.IdempotencyModeClientSide
+}
+
+func scopedKey(objectName, key string) string {
+	return objectName + ":" + key
+}
@@ -0,0 +1,226 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/mock"
+)
+
+func TestWriteIsDeduplicated(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+
+	conn, err := mock.NewConnector(
+		mock.WithWrite(func(context.Context, common.WriteParams) (*common.WriteResult, error) {
+			calls++
+
+			return &common.WriteResult{Success: true, RecordId: "rec-1"}, nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("failed to create mock connector: %v", err)
+	}
+
+	deduplicated := NewWriteConnector(conn, NewMemoryStore(0))
+
+	if mode := Mode(deduplicated); mode != common.IdempotencyModeClientSide {
+		t.Fatalf("expected client side mode, got %v", mode)
+	}
+
+	params := common.WriteParams{
+		ObjectName:     "contacts",
+		RecordData:     map[string]any{"name": "Jane"},
+		IdempotencyKey: "key-1",
+	}
+
+	for range 3 {
+		result, err := deduplicated.Write(t.Context(), params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result.RecordId != "rec-1" {
+			t.Fatalf("expected replayed record id, got %v", result.RecordId)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected a single provider call, got %v", calls)
+	}
+
+	// Same key for another object is a different write.
+	params.ObjectName = "leads"
+	if _, err = deduplicated.Write(t.Context(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected key to be scoped by object, got %v calls", calls)
+	}
+}
+
+func TestFailedWriteIsRetried(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+
+	conn, err := mock.NewConnector(
+		mock.WithWrite(func(context.Context, common.WriteParams) (*common.WriteResult, error) {
+			calls++
+
+			return &common.WriteResult{Success: calls > 1}, nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("failed to create mock connector: %v", err)
+	}
+
+	deduplicated := NewWriteConnector(conn, NewMemoryStore(0))
+	params := common.WriteParams{
+		ObjectName:     "contacts",
+		RecordData:     map[string]any{},
+		IdempotencyKey: "key-1",
+	}
+
+	for range 3 {
+		if _, err = deduplicated.Write(t.Context(), params); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected failed write to be retried once, got %v calls", calls)
+	}
+}
+
+func TestBatchWriteSkipsKnownRecords(t *testing.T) {
+	t.Parallel()
+
+	conn := &batchConnector{}
+	deduplicated := NewBatchWriteConnector(conn, NewMemoryStore(time.Hour))
+
+	first := &common.BatchWriteParam{
+		ObjectName: "contacts",
+		Type:       common.BatchWriteTypeCreate,
+		Batch: common.BatchItems{
+			{Record: map[string]any{"name": "A"}, IdempotencyKey: "a"},
+			{Record: map[string]any{"name": "B"}},
+		},
+	}
+
+	if _, err := deduplicated.BatchWrite(t.Context(), first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second := &common.BatchWriteParam{
+		ObjectName: "contacts",
+		Type:       common.BatchWriteTypeCreate,
+		Batch: common.BatchItems{
+			{Record: map[string]any{"name": "C"}, IdempotencyKey: "c"},
+			{Record: map[string]any{"name": "A"}, IdempotencyKey: "a"},
+		},
+	}
+
+	output, err := deduplicated.BatchWrite(t.Context(), second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conn.batches) != 2 || len(conn.batches[1]) != 1 || conn.batches[1][0].IdempotencyKey != "c" {
+		t.Fatalf("expected only unknown record to be sent, got %v", conn.batches)
+	}
+
+	if output.Status != common.BatchStatusSuccess || output.SuccessCount != 2 {
+		t.Fatalf("unexpected batch status %v with %v successes", output.Status, output.SuccessCount)
+	}
+
+	if output.Results[0].RecordId != "C" || output.Results[1].RecordId != "A" {
+		t.Fatalf("results are not in the order of records: %v", output.Results)
+	}
+}
+
+func TestBatchWriteIsDeduplicatedForNativeConnector(t *testing.T) {
+	t.Parallel()
+
+	conn := &nativeBatchConnector{}
+	deduplicated := NewBatchWriteConnector(conn, NewMemoryStore(time.Hour))
+
+	if mode := Mode(deduplicated); mode != common.IdempotencyModeClientSide {
+		t.Fatalf("expected client side mode, got %v", mode)
+	}
+
+	params := &common.BatchWriteParam{
+		ObjectName: "contacts",
+		Type:       common.BatchWriteTypeCreate,
+		Batch: common.BatchItems{
+			{Record: map[string]any{"name": "A"}, IdempotencyKey: "a"},
+		},
+	}
+
+	for range 2 {
+		if _, err := deduplicated.BatchWrite(t.Context(), params); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(conn.batches) != 1 {
+		t.Fatalf("expected a single provider call, got %v", len(conn.batches))
+	}
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore(time.Minute)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	if err := store.Save(t.Context(), "key", &common.WriteResult{Success: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, found, _ := store.Load(t.Context(), "key"); !found {
+		t.Fatal("expected key to be found")
+	}
+
+	now = now.Add(2 * time.Minute)
+
+	if _, found, _ := store.Load(t.Context(), "key"); found {
+		t.Fatal("expected key to expire")
+	}
+}
+
+// batchConnector creates records using their name as identifier.
+type batchConnector struct {
+	connectors.Connector
+
+	batches []common.BatchItems
+}
+
+func (c *batchConnector) BatchWrite(
+	_ context.Context, params *common.BatchWriteParam,
+) (*common.BatchWriteResult, error) {
+	c.batches = append(c.batches, params.Batch)
+
+	results := make([]common.WriteResult, len(params.Batch))
+	for index, item := range params.Batch {
+		name, _ := item.Record["name"].(string)
+		results[index] = common.WriteResult{Success: true, RecordId: name}
+	}
+
+	return common.NewBatchWriteResult(results, len(results), len(results), nil)
+}
+
+// nativeBatchConnector forwards keys of single writes, which says nothing about batch items.
+type nativeBatchConnector struct {
+	batchConnector
+}
+
+func (c *nativeBatchConnector) IdempotencyMode() common.IdempotencyMode {
+	return common.IdempotencyModeNative
+}
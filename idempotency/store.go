@@ -0,0 +1,74 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Store remembers outcomes of writes made with an idempotency key.
+// Implementations must be safe for concurrent use.
+// A persistent store is required to deduplicate across process restarts.
+type Store interface {
+	// Load returns the result saved under the key, found is false if the key is unknown or expired.
+	Load(ctx context.Context, key string) (result *common.WriteResult, found bool, err error)
+	// Save remembers the result of a successful write.
+	Save(ctx context.Context, key string, result *common.WriteResult) error
+}
+
+// MemoryStore is a process local Store.
+type MemoryStore struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	entries map[string]memoryEntry
+	now     func() time.Time
+}
+
+type memoryEntry struct {
+	result  common.WriteResult
+	savedAt time.Time
+}
+
+// NewMemoryStore creates an in-memory store, keys are forgotten after the ttl.
+// Zero ttl keeps the keys for the lifetime of the store.
+func NewMemoryStore(ttl time.Duration) *MemoryStore {
+	return &MemoryStore{
+		ttl:     ttl,
+		entries: make(map[string]memoryEntry),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Load(_ context.Context, key string) (*common.WriteResult, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	if s.ttl != 0 && s.now().Sub(entry.savedAt) > s.ttl {
+		delete(s.entries, key)
+
+		return nil, false, nil
+	}
+
+	result := entry.result
+
+	return &result, true, nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, key string, result *common.WriteResult) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.entries[key] = memoryEntry{
+		result:  *result,
+		savedAt: s.now(),
+	}
+
+	return nil
+}
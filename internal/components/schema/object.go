@@ -7,6 +7,7 @@ import (
 	"slices"
 
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/internal/components"
 	"github.com/amp-labs/connectors/internal/components/operations"
 	"github.com/amp-labs/connectors/internal/simultaneously"
@@ -26,6 +27,7 @@ var (
 type ObjectSchemaProvider struct {
 	operation *operations.SingleObjectMetadataOperation
 	fetchType string
+	governor  *governor.Governor
 }
 
 func NewObjectSchemaProvider(
@@ -36,6 +38,7 @@ func NewObjectSchemaProvider(
 	return &ObjectSchemaProvider{
 		operation: operations.NewHTTPOperation(client, list),
 		fetchType: fetchType,
+		governor:  governor.Of(client),
 	}
 }
 
@@ -121,6 +124,8 @@ func (p *ObjectSchemaProvider) fetchParallel( // nolint:funcorder
 	// This will block until all callbacks are done. Note that since the
 	// channels are buffered, the above code won't block on sending to them
 	// even if we're not receiving yet.
+	ctx = governor.WithContext(ctx, p.governor)
+
 	if err := simultaneously.DoCtx(ctx, -1, callbacks...); err != nil {
 		close(metadataChannel)
 		close(errChannel)
@@ -2,6 +2,7 @@ package components
 
 import (
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/providers"
 )
 
@@ -64,3 +65,6 @@ func (t *Transport) SetErrorHandler(handler common.ErrorHandler) {
 
 func (t *Transport) JSONHTTPClient() *common.JSONHTTPClient { return t.json }
 func (t *Transport) HTTPClient() *common.HTTPClient         { return t.json.HTTPClient }
+
+// Governor returns the governor of the connection, nil when requests are not limited.
+func (t *Transport) Governor() *governor.Governor { return governor.Of(t.HTTPClient().Client) }
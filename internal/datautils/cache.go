@@ -28,3 +28,10 @@ func (c *Cache[K, V]) Set(key K, value V) {
 
 	c.registry[key] = value
 }
+
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.registry, key)
+}
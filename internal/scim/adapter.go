@@ -0,0 +1,87 @@
+// Package scim implements a reusable SCIM 2.0 client (RFC 7643, RFC 7644).
+//
+// Identity providers exposing a SCIM service, such as Slack, Okta, Azure AD or AWS Identity Store,
+// can be configured as modules whose base URL points to the SCIM root, ex: "https://example.com/scim/v2".
+// The connector of such provider delegates operations of the module to the Adapter.
+package scim
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/interpreter"
+	"github.com/amp-labs/connectors/internal/components"
+	"github.com/amp-labs/connectors/internal/components/deleter"
+	"github.com/amp-labs/connectors/internal/components/operations"
+	"github.com/amp-labs/connectors/internal/components/reader"
+	"github.com/amp-labs/connectors/internal/components/writer"
+	"github.com/amp-labs/connectors/providers"
+)
+
+// Adapter reads and writes SCIM resources.
+// Object names are resource endpoints, ex: "Users", "Groups".
+type Adapter struct {
+	*components.Connector
+
+	components.Reader
+	components.Writer
+	components.Deleter
+}
+
+// NewAdapter creates an adapter for the SCIM service of the provider module.
+func NewAdapter(provider providers.Provider, params common.ConnectorParams) (*Adapter, error) {
+	return components.Initialize(provider, params, constructor)
+}
+
+func constructor(base *components.Connector) (*Adapter, error) {
+	adapter := &Adapter{
+		Connector: base,
+	}
+
+	registry, err := components.NewEndpointRegistry(supportedOperations(adapter.ProviderContext.Module()))
+	if err != nil {
+		return nil, err
+	}
+
+	errorHandler := interpreter.ErrorHandler{
+		JSON: interpreter.NewFaultyResponder(errorFormats, nil),
+		Custom: map[interpreter.Mime]interpreter.FaultyResponseHandler{
+			Mime: interpreter.NewFaultyResponder(errorFormats, nil),
+		},
+	}.Handle
+
+	adapter.SetErrorHandler(errorHandler)
+
+	adapter.Reader = reader.NewHTTPReader(
+		adapter.HTTPClient().Client,
+		registry,
+		adapter.ProviderContext.Module(),
+		operations.ReadHandlers{
+			BuildRequest:  adapter.buildReadRequest,
+			ParseResponse: adapter.parseReadResponse,
+			ErrorHandler:  errorHandler,
+		},
+	)
+
+	adapter.Writer = writer.NewHTTPWriter(
+		adapter.HTTPClient().Client,
+		registry,
+		adapter.ProviderContext.Module(),
+		operations.WriteHandlers{
+			BuildRequest:  adapter.buildWriteRequest,
+			ParseResponse: adapter.parseWriteResponse,
+			ErrorHandler:  errorHandler,
+		},
+	)
+
+	adapter.Deleter = deleter.NewHTTPDeleter(
+		adapter.HTTPClient().Client,
+		registry,
+		adapter.ProviderContext.Module(),
+		operations.DeleteHandlers{
+			BuildRequest:  adapter.buildDeleteRequest,
+			ParseResponse: adapter.parseDeleteResponse,
+			ErrorHandler:  errorHandler,
+		},
+	)
+
+	return adapter, nil
+}
@@ -0,0 +1,289 @@
+package scim
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+)
+
+func constructTestAdapter(t *testing.T, baseURL string) *Adapter {
+	t.Helper()
+
+	adapter, err := NewAdapter(providers.Slack, common.ConnectorParams{
+		AuthenticatedClient: mockutils.NewClient(),
+		Module:              providers.ModuleSlackSCIM,
+	})
+	if err != nil {
+		t.Fatalf("failed to construct adapter: %v", err)
+	}
+
+	adapter.SetUnitTestBaseURL(baseURL)
+
+	return adapter
+}
+
+func TestReadIncrementally(t *testing.T) {
+	t.Parallel()
+
+	stub, baseURL := newSCIMStub(t)
+	adapter := constructTestAdapter(t, baseURL)
+
+	since := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	stub.addUser("old@example.com", since.Add(-time.Hour))
+	stub.addUser("first@example.com", since.Add(time.Hour))
+	stub.addUser("second@example.com", since.Add(2*time.Hour))
+	stub.addUser("third@example.com", since.Add(3*time.Hour))
+
+	params := common.ReadParams{
+		ObjectName: "Users",
+		Fields:     datautils.NewStringSet("userName"),
+		Since:      since,
+	}
+
+	var userNames []any
+
+	for {
+		result, err := adapter.Read(t.Context(), params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, row := range result.Data {
+			if row.Id == "" {
+				t.Fatalf("row is missing identifier: %v", row)
+			}
+
+			userNames = append(userNames, row.Fields["username"])
+		}
+
+		if result.Done {
+			break
+		}
+
+		params.NextPage = result.NextPage
+	}
+
+	expected := []any{"first@example.com", "second@example.com", "third@example.com"}
+	if len(userNames) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, userNames)
+	}
+
+	for index := range expected {
+		if userNames[index] != expected[index] {
+			t.Fatalf("expected %v, got %v", expected, userNames)
+		}
+	}
+
+	if stub.filters[0] != `meta.lastModified gt "2025-03-01T00:00:00Z"` {
+		t.Fatalf("unexpected filter: %v", stub.filters[0])
+	}
+}
+
+func TestMakeFilter(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		params   common.ReadParams
+		expected string
+	}{
+		{
+			name:     "No filter",
+			params:   common.ReadParams{ObjectName: "Users"},
+			expected: "",
+		},
+		{
+			name: "Time range with caller filter",
+			params: common.ReadParams{
+				ObjectName: "Groups",
+				Since:      since,
+				Until:      since.Add(time.Hour),
+				Filter:     `displayName sw "Eng"`,
+			},
+			expected: `meta.lastModified gt "2025-03-01T00:00:00Z" and ` +
+				`meta.lastModified le "2025-03-01T01:00:00Z" and (displayName sw "Eng")`,
+		},
+		{
+			name:     "Schemas are not filtered by time",
+			params:   common.ReadParams{ObjectName: "Schemas", Since: since},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if output := makeFilter(tt.params); output != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestWriteAndDelete(t *testing.T) { // nolint:funlen,cyclop
+	t.Parallel()
+
+	stub, baseURL := newSCIMStub(t)
+	adapter := constructTestAdapter(t, baseURL)
+
+	created, err := adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "Users",
+		RecordData: map[string]any{
+			"userName": "jane@example.com",
+			"title":    "Engineer",
+			schemaEnterpriseUser: map[string]any{
+				"employeeNumber": "701984",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+
+	if !created.Success || created.RecordId == "" {
+		t.Fatalf("unexpected create result: %v", created)
+	}
+
+	updated, err := adapter.Write(t.Context(), common.WriteParams{
+		ObjectName: "Users",
+		RecordId:   created.RecordId,
+		RecordData: map[string]any{
+			"id":    created.RecordId,
+			"title": nil,
+			"name":  map[string]any{"givenName": "Jane"},
+			schemaEnterpriseUser: map[string]any{
+				"employeeNumber": "701985",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected update error: %v", err)
+	}
+
+	if updated.RecordId != created.RecordId {
+		t.Fatalf("expected record %v, got %v", created.RecordId, updated.RecordId)
+	}
+
+	user := stub.users[created.RecordId]
+	if _, ok := user["title"]; ok {
+		t.Fatalf("title was expected to be removed: %v", user)
+	}
+
+	extension, _ := user[schemaEnterpriseUser].(map[string]any)
+	if extension["employeeNumber"] != "701985" || user["userName"] != "jane@example.com" {
+		t.Fatalf("unexpected user after update: %v", user)
+	}
+
+	result, err := adapter.Read(t.Context(), common.ReadParams{
+		ObjectName: "Users",
+		Fields:     datautils.NewStringSet("userName", schemaEnterpriseUser+":employeeNumber"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected read error: %v", err)
+	}
+
+	if result.Rows != 1 || result.Data[0].Fields[strings.ToLower(schemaEnterpriseUser)+":employeenumber"] != "701985" {
+		t.Fatalf("unexpected read result: %v", result.Data)
+	}
+
+	deleted, err := adapter.Delete(t.Context(), common.DeleteParams{
+		ObjectName: "Users",
+		RecordId:   created.RecordId,
+	})
+	if err != nil || !deleted.Success {
+		t.Fatalf("unexpected delete result: %v, %v", deleted, err)
+	}
+
+	_, err = adapter.Delete(t.Context(), common.DeleteParams{
+		ObjectName: "Users",
+		RecordId:   created.RecordId,
+	})
+	if !errors.Is(err, common.ErrNotFound) {
+		t.Fatalf("expected %v, got %v", common.ErrNotFound, err)
+	}
+}
+
+func TestMakePatchRequest(t *testing.T) {
+	t.Parallel()
+
+	output := makePatchRequest(map[string]any{
+		"schemas":     []string{schemaUser},
+		"displayName": "Jane",
+		"nickName":    nil,
+		schemaEnterpriseUser: map[string]any{
+			"department": "R&D",
+		},
+	})
+
+	expected := []patchOperation{
+		{Op: "replace", Path: "displayName", Value: "Jane"},
+		{Op: "remove", Path: "nickName"},
+		{Op: "replace", Path: schemaEnterpriseUser + ":department", Value: "R&D"},
+	}
+
+	if len(output.Operations) != len(expected) || output.Schemas[0] != schemaPatchOp {
+		t.Fatalf("expected %v, got %v", expected, output)
+	}
+
+	for index := range expected {
+		if output.Operations[index] != expected[index] {
+			t.Fatalf("expected %v, got %v", expected, output.Operations)
+		}
+	}
+}
+
+func TestListObjectMetadata(t *testing.T) {
+	t.Parallel()
+
+	_, baseURL := newSCIMStub(t)
+	adapter := constructTestAdapter(t, baseURL)
+
+	result, err := adapter.ListObjectMetadata(t.Context(), []string{"Users", "Devices"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !errors.Is(result.Errors["Devices"], ErrUnknownResourceType) {
+		t.Fatalf("expected unknown resource type error, got %v", result.Errors)
+	}
+
+	users, ok := result.Result["Users"]
+	if !ok || users.DisplayName != "User" {
+		t.Fatalf("unexpected users metadata: %v", result.Result)
+	}
+
+	tests := map[string]common.ValueType{
+		"userName":                               common.ValueTypeString,
+		"active":                                 common.ValueTypeBoolean,
+		"userType":                               common.ValueTypeSingleSelect,
+		"emails":                                 common.ValueTypeArray,
+		"profileUrl":                             common.ValueTypeURL,
+		schemaEnterpriseUser + ":employeeNumber": common.ValueTypeString,
+		schemaEnterpriseUser + ":manager":        common.ValueTypeJSON,
+	}
+
+	for name, valueType := range tests {
+		field, ok := users.Fields[name]
+		if !ok || field.ValueType != valueType {
+			t.Fatalf("field %v: expected %v, got %v", name, valueType, field)
+		}
+	}
+
+	if !*users.Fields["id"].ReadOnly || !*users.Fields["userName"].IsRequired || *users.Fields["userName"].IsCustom {
+		t.Fatalf("unexpected field properties: %v", users.Fields)
+	}
+
+	if users.Fields["emails"].ItemType != common.ValueTypeJSON || len(users.Fields["userType"].Values) != 2 {
+		t.Fatalf("unexpected field details: %v", users.Fields)
+	}
+}
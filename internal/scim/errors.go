@@ -0,0 +1,40 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/amp-labs/connectors/common/interpreter"
+)
+
+var (
+	ErrUnknownResourceType = errors.New("resource type is not served by the SCIM service")
+	ErrMissingResourceID   = errors.New("SCIM resource is missing id")
+)
+
+var errorFormats = interpreter.NewFormatSwitch( // nolint:gochecknoglobals
+	[]interpreter.FormatTemplate{
+		{
+			MustKeys: []string{"detail"},
+			Template: func() interpreter.ErrorDescriptor { return &ResponseError{} },
+		},
+	}...,
+)
+
+// ResponseError is the SCIM error message.
+// https://datatracker.ietf.org/doc/html/rfc7644#section-3.12
+type ResponseError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+func (r ResponseError) CombineErr(base error) error {
+	if r.ScimType == "" {
+		return fmt.Errorf("%w: %v", base, r.Detail)
+	}
+
+	return fmt.Errorf("%w: [%v] %v", base, r.ScimType, strings.TrimSpace(r.Detail))
+}
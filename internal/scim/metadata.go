@@ -0,0 +1,215 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/goutils"
+)
+
+// schemaPrefixStandard is shared by schemas defined by the SCIM specification,
+// attributes of other schemas are custom.
+const schemaPrefixStandard = "urn:ietf:params:scim:schemas:"
+
+type listResponse[T any] struct {
+	Resources []T `json:"Resources"` // nolint:tagliatelle
+}
+
+// resourceType links the endpoint to the core schema and schema extensions.
+// https://datatracker.ietf.org/doc/html/rfc7643#section-6
+type resourceType struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Endpoint         string            `json:"endpoint"`
+	Schema           string            `json:"schema"`
+	SchemaExtensions []schemaExtension `json:"schemaExtensions"`
+}
+
+type schemaExtension struct {
+	Schema   string `json:"schema"`
+	Required bool   `json:"required"`
+}
+
+// schemaResource describes attributes of the core schema or the schema extension.
+// https://datatracker.ietf.org/doc/html/rfc7643#section-7
+type schemaResource struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Attributes []attribute `json:"attributes"`
+}
+
+type attribute struct {
+	Name            string   `json:"name"`
+	Type            string   `json:"type"`
+	MultiValued     bool     `json:"multiValued"`
+	Required        bool     `json:"required"`
+	CanonicalValues []string `json:"canonicalValues"`
+	Mutability      string   `json:"mutability"`
+	ReferenceTypes  []string `json:"referenceTypes"`
+}
+
+// ListObjectMetadata describes resource endpoints using the schemas served by the SCIM service.
+// Attributes of the core schema keep their names, attributes of schema extensions are fully qualified,
+// ex: "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber".
+func (a *Adapter) ListObjectMetadata(
+	ctx context.Context, objectNames []string,
+) (*common.ListObjectMetadataResult, error) {
+	if len(objectNames) == 0 {
+		return nil, common.ErrMissingObjects
+	}
+
+	resourceTypes, err := listResources[resourceType](ctx, a, objectNameResourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := listResources[schemaResource](ctx, a, objectNameSchemas)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaRegistry := make(map[string]schemaResource, len(schemas))
+	for _, schema := range schemas {
+		schemaRegistry[schema.ID] = schema
+	}
+
+	result := common.NewListObjectMetadataResult()
+
+	for _, objectName := range objectNames {
+		resource, ok := findResourceType(resourceTypes, objectName)
+		if !ok {
+			result.AppendError(objectName, fmt.Errorf("%w: %s", ErrUnknownResourceType, objectName))
+
+			continue
+		}
+
+		result.Result[objectName] = *newObjectMetadata(resource, schemaRegistry)
+	}
+
+	return result, nil
+}
+
+func listResources[T any](ctx context.Context, adapter *Adapter, objectName string) ([]T, error) {
+	url, err := adapter.getURL(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := adapter.JSONHTTPClient().Get(ctx, url.String())
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", objectName, err)
+	}
+
+	response, err := common.UnmarshalJSON[listResponse[T]](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil {
+		return nil, fmt.Errorf("%w: %s", common.ErrEmptyJSONHTTPResponse, objectName)
+	}
+
+	return response.Resources, nil
+}
+
+// findResourceType matches the object name against the endpoint, ex: "Users" is served at "/Users".
+func findResourceType(resourceTypes []resourceType, objectName string) (resourceType, bool) {
+	for _, resource := range resourceTypes {
+		if strings.EqualFold(strings.TrimPrefix(resource.Endpoint, "/"), objectName) {
+			return resource, true
+		}
+	}
+
+	return resourceType{}, false
+}
+
+func newObjectMetadata(resource resourceType, schemaRegistry map[string]schemaResource) *common.ObjectMetadata {
+	metadata := common.NewObjectMetadata(resource.Name, common.FieldsMetadata{})
+
+	for _, attr := range schemaRegistry[resource.Schema].Attributes {
+		metadata.AddFieldMetadata(attr.Name, newFieldMetadata(resource.Schema, attr))
+	}
+
+	for _, extension := range resource.SchemaExtensions {
+		for _, attr := range schemaRegistry[extension.Schema].Attributes {
+			metadata.AddFieldMetadata(extension.Schema+":"+attr.Name, newFieldMetadata(extension.Schema, attr))
+		}
+	}
+
+	return metadata
+}
+
+func newFieldMetadata(schemaID string, attr attribute) common.FieldMetadata {
+	field := common.FieldMetadata{
+		DisplayName:  attr.Name,
+		ValueType:    attr.valueType(),
+		ProviderType: attr.Type,
+		ReadOnly:     goutils.Pointer(attr.Mutability == "readOnly"),
+		IsCustom:     goutils.Pointer(!strings.HasPrefix(schemaID, schemaPrefixStandard)),
+		IsRequired:   goutils.Pointer(attr.Required),
+	}
+
+	if attr.MultiValued {
+		field.ItemType = field.ValueType
+		field.ValueType = common.ValueTypeArray
+	}
+
+	if field.ValueType == common.ValueTypeReference {
+		field.ReferenceTo = attr.ReferenceTypes
+	}
+
+	if field.ValueType == common.ValueTypeSingleSelect {
+		field.Values = make([]common.FieldValue, len(attr.CanonicalValues))
+
+		for index, value := range attr.CanonicalValues {
+			field.Values[index] = common.FieldValue{
+				Value:        value,
+				DisplayValue: value,
+			}
+		}
+	}
+
+	return field
+}
+
+// valueType maps SCIM data types.
+// https://datatracker.ietf.org/doc/html/rfc7643#section-2.3
+func (a attribute) valueType() common.ValueType {
+	switch a.Type {
+	case "string":
+		if len(a.CanonicalValues) != 0 && !a.MultiValued {
+			return common.ValueTypeSingleSelect
+		}
+
+		return common.ValueTypeString
+	case "boolean":
+		return common.ValueTypeBoolean
+	case "decimal":
+		return common.ValueTypeFloat
+	case "integer":
+		return common.ValueTypeInt
+	case "dateTime":
+		return common.ValueTypeDateTime
+	case "binary":
+		return common.ValueTypeString
+	case "reference":
+		return a.referenceType()
+	case "complex":
+		return common.ValueTypeJSON
+	default:
+		return common.ValueTypeOther
+	}
+}
+
+// referenceType tells resource references apart from links to external resources.
+func (a attribute) referenceType() common.ValueType {
+	for _, target := range a.ReferenceTypes {
+		if target == "external" || target == "uri" {
+			return common.ValueTypeURL
+		}
+	}
+
+	return common.ValueTypeReference
+}
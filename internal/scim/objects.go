@@ -0,0 +1,67 @@
+package scim
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/components"
+)
+
+// Mime is the media type of SCIM messages.
+// https://datatracker.ietf.org/doc/html/rfc7644#section-8.1
+const Mime = "application/scim+json"
+
+// Resource endpoints defined by RFC 7644.
+const (
+	objectNameUsers         = "Users"
+	objectNameGroups        = "Groups"
+	objectNameSchemas       = "Schemas"
+	objectNameResourceTypes = "ResourceTypes"
+)
+
+// Schema URIs of messages and core resources.
+// https://datatracker.ietf.org/doc/html/rfc7643#section-8.7
+const (
+	schemaUser    = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup   = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaPatchOp = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// coreSchemas are set on created resources when the record data doesn't list schemas.
+var coreSchemas = map[string]string{ // nolint:gochecknoglobals
+	objectNameUsers:  schemaUser,
+	objectNameGroups: schemaGroup,
+}
+
+// incrementalObjects can be filtered by the modification time.
+// Schemas and resource types describe the service, they are not versioned resources.
+var incrementalObjects = map[string]bool{ // nolint:gochecknoglobals
+	objectNameUsers:  true,
+	objectNameGroups: true,
+}
+
+func supportedOperations(module common.ModuleID) components.EndpointRegistryInput {
+	readObjects := []string{objectNameUsers, objectNameGroups, objectNameSchemas, objectNameResourceTypes}
+	writeObjects := []string{objectNameUsers, objectNameGroups}
+
+	return components.EndpointRegistryInput{
+		module: {
+			{
+				Endpoint: fmt.Sprintf("{%s}", strings.Join(readObjects, ",")),
+				Support:  components.ReadSupport,
+			}, {
+				Endpoint: fmt.Sprintf("{%s}", strings.Join(writeObjects, ",")),
+				Support:  components.WriteSupport,
+			}, {
+				Endpoint: fmt.Sprintf("{%s}", strings.Join(writeObjects, ",")),
+				Support:  components.DeleteSupport,
+			},
+		},
+	}
+}
+
+func (a *Adapter) getURL(paths ...string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.ModuleInfo().BaseURL, paths...)
+}
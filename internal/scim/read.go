@@ -0,0 +1,168 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/jsonquery"
+	"github.com/spyzhov/ajson"
+)
+
+// pageSize is the requested number of resources per page, servers may return fewer.
+const pageSize = 100
+
+// buildReadRequest lists resources of the endpoint.
+// Pages are addressed by the 1-based index of the first resource, which is stored as the next page token.
+// https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.4
+func (a *Adapter) buildReadRequest(ctx context.Context, params common.ReadParams) (*http.Request, error) {
+	url, err := a.getURL(params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	startIndex := "1"
+	if params.NextPage != "" {
+		startIndex = params.NextPage.String()
+	}
+
+	url.WithQueryParam("startIndex", startIndex)
+	url.WithQueryParam("count", strconv.Itoa(pageSize))
+
+	if filter := makeFilter(params); filter != "" {
+		url.WithQueryParam("filter", filter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", Mime)
+
+	return req, nil
+}
+
+// makeFilter narrows resources to those modified within the time range.
+// The caller's filter expression is combined with the time range.
+// https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2
+func makeFilter(params common.ReadParams) string {
+	var expressions []string
+
+	if incrementalObjects[params.ObjectName] {
+		if !params.Since.IsZero() {
+			expressions = append(expressions, lastModifiedFilter("gt", params.Since))
+		}
+
+		if !params.Until.IsZero() {
+			expressions = append(expressions, lastModifiedFilter("le", params.Until))
+		}
+	}
+
+	if params.Filter != "" {
+		if len(expressions) == 0 {
+			return params.Filter
+		}
+
+		expressions = append(expressions, "("+params.Filter+")")
+	}
+
+	return strings.Join(expressions, " and ")
+}
+
+func lastModifiedFilter(operator string, timestamp time.Time) string {
+	return fmt.Sprintf(`meta.lastModified %v "%v"`, operator, timestamp.UTC().Format(time.RFC3339))
+}
+
+func (a *Adapter) parseReadResponse(
+	ctx context.Context,
+	params common.ReadParams,
+	request *http.Request,
+	resp *common.JSONHTTPResponse,
+) (*common.ReadResult, error) {
+	return common.ParseResult(resp,
+		// Resources may be omitted when nothing matches the filter.
+		common.ExtractOptionalRecordsFromPath("Resources"),
+		getNextStartIndex,
+		getMarshaledData,
+		params.Fields,
+	)
+}
+
+// getNextStartIndex returns the start index of the next page.
+// https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.3
+func getNextStartIndex(node *ajson.Node) (string, error) {
+	query := jsonquery.New(node)
+
+	totalResults, err := query.IntegerWithDefault("totalResults", 0)
+	if err != nil {
+		return "", err
+	}
+
+	startIndex, err := query.IntegerWithDefault("startIndex", 1)
+	if err != nil {
+		return "", err
+	}
+
+	itemsPerPage, err := query.IntegerWithDefault("itemsPerPage", 0)
+	if err != nil {
+		return "", err
+	}
+
+	nextIndex := startIndex + itemsPerPage
+	if itemsPerPage == 0 || nextIndex > totalResults {
+		return "", nil
+	}
+
+	return strconv.FormatInt(nextIndex, 10), nil
+}
+
+// getMarshaledData converts resources to rows.
+// Attributes of schema extensions are nested under the schema URI,
+// they can be requested by the fully qualified name,
+// ex: "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber".
+func getMarshaledData(records []map[string]any, fields []string) ([]common.ReadResultRow, error) {
+	rows := make([]common.ReadResultRow, len(records))
+
+	for index, record := range records {
+		identifier, _ := record["id"].(string)
+
+		rows[index] = common.ReadResultRow{
+			Fields: common.ExtractLowercaseFieldsFromRaw(fields, flattenExtensions(record)),
+			Raw:    record,
+			Id:     identifier,
+		}
+	}
+
+	return rows, nil
+}
+
+func flattenExtensions(record map[string]any) map[string]any {
+	flat := maps.Clone(record)
+
+	for key, value := range record {
+		if !isSchemaURI(key) {
+			continue
+		}
+
+		extension, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for name, attribute := range extension {
+			flat[key+":"+name] = attribute
+		}
+	}
+
+	return flat
+}
+
+func isSchemaURI(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), "urn:")
+}
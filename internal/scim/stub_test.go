@@ -0,0 +1,279 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	stubBasePath = "/scim/v2"
+	// stubPageSize is smaller than the requested page size, clients must follow pagination.
+	stubPageSize = 2
+
+	schemaEnterpriseUser = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+)
+
+var stubLastModifiedFilter = regexp.MustCompile(`^meta\.lastModified gt "([^"]+)"`)
+
+// scimStub is an in-process SCIM service storing users in memory.
+type scimStub struct {
+	mu      sync.Mutex
+	users   map[string]map[string]any
+	nextID  int
+	filters []string
+}
+
+func newSCIMStub(t *testing.T) (*scimStub, string) {
+	t.Helper()
+
+	stub := &scimStub{users: make(map[string]map[string]any)}
+
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	return stub, server.URL + stubBasePath
+}
+
+// addUser stores the user as if it was modified at the given time.
+func (s *scimStub) addUser(userName string, lastModified time.Time) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store(map[string]any{
+		"schemas":  []any{schemaUser},
+		"userName": userName,
+	}, lastModified)
+}
+
+func (s *scimStub) store(user map[string]any, lastModified time.Time) string {
+	s.nextID++
+	identifier := fmt.Sprintf("user-%02d", s.nextID)
+
+	user["id"] = identifier
+	user["meta"] = map[string]any{
+		"resourceType": "User",
+		"lastModified": lastModified.UTC().Format(time.RFC3339),
+	}
+	s.users[identifier] = user
+
+	return identifier
+}
+
+func (s *scimStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, stubBasePath+"/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case path == objectNameSchemas && r.Method == http.MethodGet:
+		writeSCIM(w, http.StatusOK, stubSchemas)
+	case path == objectNameResourceTypes && r.Method == http.MethodGet:
+		writeSCIM(w, http.StatusOK, stubResourceTypes)
+	case path == objectNameUsers && r.Method == http.MethodGet:
+		s.listUsers(w, r)
+	case path == objectNameUsers && r.Method == http.MethodPost:
+		s.createUser(w, r)
+	case len(parts) == 2 && parts[0] == objectNameUsers:
+		s.serveUser(w, r, parts[1])
+	default:
+		writeError(w, http.StatusNotFound, "Endpoint not found")
+	}
+}
+
+func (s *scimStub) listUsers(w http.ResponseWriter, r *http.Request) {
+	filter := r.URL.Query().Get("filter")
+	s.filters = append(s.filters, filter)
+
+	var since string
+	if match := stubLastModifiedFilter.FindStringSubmatch(filter); match != nil {
+		since = match[1]
+	}
+
+	resources := make([]any, 0)
+
+	for _, identifier := range slices.Sorted(maps.Keys(s.users)) {
+		user := s.users[identifier]
+
+		lastModified, _ := user["meta"].(map[string]any)["lastModified"].(string)
+		if since != "" && lastModified <= since {
+			continue
+		}
+
+		resources = append(resources, user)
+	}
+
+	startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	page := resources[min(startIndex-1, len(resources)):min(startIndex-1+stubPageSize, len(resources))]
+
+	writeSCIM(w, http.StatusOK, map[string]any{
+		"schemas":      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+		"totalResults": len(resources),
+		"startIndex":   startIndex,
+		"itemsPerPage": len(page),
+		"Resources":    page,
+	})
+}
+
+func (s *scimStub) createUser(w http.ResponseWriter, r *http.Request) {
+	var user map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	if _, ok := user["schemas"]; !ok {
+		writeError(w, http.StatusBadRequest, "schemas are required")
+
+		return
+	}
+
+	identifier := s.store(user, time.Now())
+	writeSCIM(w, http.StatusCreated, s.users[identifier])
+}
+
+func (s *scimStub) serveUser(w http.ResponseWriter, r *http.Request, identifier string) {
+	user, ok := s.users[identifier]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource "+identifier+" not found")
+
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeSCIM(w, http.StatusOK, user)
+	case http.MethodDelete:
+		delete(s.users, identifier)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPatch:
+		var patch patchRequest
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		for _, operation := range patch.Operations {
+			applyPatch(user, operation)
+		}
+
+		writeSCIM(w, http.StatusOK, user)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// applyPatch supports paths of top level attributes and attributes of the enterprise extension.
+func applyPatch(user map[string]any, operation patchOperation) {
+	target, name := user, operation.Path
+
+	if extensionAttribute, ok := strings.CutPrefix(operation.Path, schemaEnterpriseUser+":"); ok {
+		extension, _ := user[schemaEnterpriseUser].(map[string]any)
+		if extension == nil {
+			extension = make(map[string]any)
+			user[schemaEnterpriseUser] = extension
+		}
+
+		target, name = extension, extensionAttribute
+	}
+
+	if operation.Op == "remove" {
+		delete(target, name)
+
+		return
+	}
+
+	target[name] = operation.Value
+}
+
+func writeSCIM(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", Mime)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeSCIM(w, status, ResponseError{
+		Schemas: []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+		Status:  strconv.Itoa(status),
+		Detail:  detail,
+	})
+}
+
+var stubResourceTypes = map[string]any{ // nolint:gochecknoglobals
+	"Resources": []any{
+		map[string]any{
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   schemaUser,
+			"schemaExtensions": []any{
+				map[string]any{"schema": schemaEnterpriseUser, "required": false},
+			},
+		},
+		map[string]any{
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   schemaGroup,
+		},
+	},
+}
+
+var stubSchemas = map[string]any{ // nolint:gochecknoglobals
+	"Resources": []any{
+		map[string]any{
+			"id":   schemaUser,
+			"name": "User",
+			"attributes": []any{
+				map[string]any{"name": "id", "type": "string", "mutability": "readOnly"},
+				map[string]any{"name": "userName", "type": "string", "required": true, "mutability": "readWrite"},
+				map[string]any{"name": "active", "type": "boolean", "mutability": "readWrite"},
+				map[string]any{
+					"name": "userType", "type": "string", "mutability": "readWrite",
+					"canonicalValues": []any{"Employee", "Contractor"},
+				},
+				map[string]any{"name": "emails", "type": "complex", "multiValued": true, "mutability": "readWrite"},
+				map[string]any{
+					"name": "profileUrl", "type": "reference", "mutability": "readWrite",
+					"referenceTypes": []any{"external"},
+				},
+			},
+		},
+		map[string]any{
+			"id":   schemaEnterpriseUser,
+			"name": "EnterpriseUser",
+			"attributes": []any{
+				map[string]any{"name": "employeeNumber", "type": "string", "mutability": "readWrite"},
+				map[string]any{"name": "manager", "type": "complex", "mutability": "readWrite"},
+			},
+		},
+		map[string]any{
+			"id":   schemaGroup,
+			"name": "Group",
+			"attributes": []any{
+				map[string]any{"name": "displayName", "type": "string", "required": true, "mutability": "readWrite"},
+				map[string]any{
+					"name": "members", "type": "complex", "multiValued": true, "mutability": "readWrite",
+				},
+			},
+		},
+	},
+}
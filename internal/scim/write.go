@@ -0,0 +1,168 @@
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/jsonquery"
+)
+
+// Attributes assigned by the service provider, they are never sent on update.
+// https://datatracker.ietf.org/doc/html/rfc7643#section-3.1
+var readOnlyAttributes = []string{"schemas", "id", "meta"} // nolint:gochecknoglobals
+
+// patchRequest modifies attributes of a resource.
+// https://datatracker.ietf.org/doc/html/rfc7644#section-3.5.2
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"` // nolint:tagliatelle
+}
+
+type patchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// buildWriteRequest creates resources with POST and updates them with PATCH operations.
+// Update replaces every given attribute, null values remove the attribute.
+func (a *Adapter) buildWriteRequest(ctx context.Context, params common.WriteParams) (*http.Request, error) {
+	recordData, err := common.RecordDataToMap(params.RecordData)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.getURL(params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		method  = http.MethodPost
+		payload any
+	)
+
+	if params.IsUpdate() {
+		url.AddPath(params.RecordId)
+
+		method = http.MethodPatch
+		payload = makePatchRequest(recordData)
+	} else {
+		payload = makeCreateRequest(params.ObjectName, recordData)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", Mime)
+	req.Header.Set("Accept", Mime)
+
+	return req, nil
+}
+
+// makeCreateRequest sets the core schema of the resource unless schemas are listed by the caller.
+func makeCreateRequest(objectName string, recordData map[string]any) map[string]any {
+	if _, ok := recordData["schemas"]; ok {
+		return recordData
+	}
+
+	schema, ok := coreSchemas[objectName]
+	if !ok {
+		return recordData
+	}
+
+	payload := maps.Clone(recordData)
+	payload["schemas"] = []string{schema}
+
+	return payload
+}
+
+// makePatchRequest produces an operation per attribute.
+// Attributes of schema extensions are addressed by the fully qualified path.
+func makePatchRequest(recordData map[string]any) patchRequest {
+	operations := make([]patchOperation, 0, len(recordData))
+
+	for _, name := range slices.Sorted(maps.Keys(recordData)) {
+		if slices.Contains(readOnlyAttributes, name) {
+			continue
+		}
+
+		extension, ok := recordData[name].(map[string]any)
+		if ok && isSchemaURI(name) {
+			for _, attribute := range slices.Sorted(maps.Keys(extension)) {
+				operations = append(operations, newPatchOperation(name+":"+attribute, extension[attribute]))
+			}
+
+			continue
+		}
+
+		operations = append(operations, newPatchOperation(name, recordData[name]))
+	}
+
+	return patchRequest{
+		Schemas:    []string{schemaPatchOp},
+		Operations: operations,
+	}
+}
+
+func newPatchOperation(path string, value any) patchOperation {
+	if value == nil {
+		return patchOperation{Op: "remove", Path: path}
+	}
+
+	return patchOperation{Op: "replace", Path: path, Value: value}
+}
+
+// parseWriteResponse returns the stored resource.
+// Services may respond to PATCH with no content, then the identifier of the updated resource is known.
+func (a *Adapter) parseWriteResponse(
+	ctx context.Context,
+	params common.WriteParams,
+	request *http.Request,
+	response *common.JSONHTTPResponse,
+) (*common.WriteResult, error) {
+	body, ok := response.Body()
+	if !ok {
+		if params.RecordId == "" {
+			return nil, ErrMissingResourceID
+		}
+
+		return &common.WriteResult{
+			Success:  true,
+			RecordId: params.RecordId,
+		}, nil
+	}
+
+	recordID, err := jsonquery.New(body).StrWithDefault("id", params.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	if recordID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	data, err := jsonquery.Convertor.ObjectToMap(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.WriteResult{
+		Success:  true,
+		RecordId: recordID,
+		Errors:   nil,
+		Data:     data,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/amp-labs/connectors/common/contexts"
+	"github.com/amp-labs/connectors/common/governor"
 )
 
 // Job is a function that performs a unit of work and returns an error if it fails.
@@ -17,7 +18,8 @@ type Job func(ctx context.Context) error
 var ErrPanicRecovered = errors.New("panic recovered")
 
 // Do runs the given functions in parallel and returns the first error encountered.
-// See SimultaneouslyCtx for more information.
+// See DoCtx for more information. There is no context to carry a governor,
+// so only maxConcurrent limits the jobs, use DoCtx when requests must respect the governor.
 func Do(maxConcurrent int, f ...Job) error {
 	return DoCtx(context.Background(), maxConcurrent, f...)
 }
@@ -29,6 +31,7 @@ func Do(maxConcurrent int, f ...Job) error {
 //
 // The maxConcurrent parameter is used to limit the number of functions that run at the same time.
 // If maxConcurrent is less than 1, all functions will run at the same time.
+// A governor attached to the context lowers the limit to the number of requests it allows in flight.
 //
 // Panics that occur within the callback functions are automatically recovered and converted to errors.
 // This prevents a single panicking function from crashing the entire process.
@@ -38,6 +41,11 @@ func DoCtx(ctx context.Context, maxConcurrent int, callback ...Job) error {
 	var cancelOnce sync.Once
 	defer cancelOnce.Do(cancel)
 
+	if limit := governor.FromContext(ctx).Limits().MaxInFlight; limit > 0 &&
+		(maxConcurrent < 1 || maxConcurrent > limit) {
+		maxConcurrent = limit
+	}
+
 	if maxConcurrent < 1 {
 		maxConcurrent = len(callback)
 	}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -279,3 +280,33 @@ func TestDoCtx_ContextCancellationAfterPanic(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "panic recovered")
 }
+
+func TestDoCtx_GovernorLimitsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := governor.WithContext(t.Context(), governor.New(governor.Limits{MaxInFlight: 2}))
+
+	var running, peak atomic.Int32
+
+	jobs := make([]Job, 10)
+	for index := range jobs {
+		jobs[index] = func(ctx context.Context) error {
+			current := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				observed := peak.Load()
+				if current <= observed || peak.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+
+			return nil
+		}
+	}
+
+	require.NoError(t, DoCtx(ctx, -1, jobs...))
+	assert.Equal(t, int32(2), peak.Load())
+}
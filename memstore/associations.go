@@ -1,6 +1,7 @@
 package memstore
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -400,3 +401,212 @@ func (c *Connector) validateAssociations(objectName string, record map[string]an
 
 	return nil
 }
+
+// associationWrite is an association input resolved against the association schema of the object.
+type associationWrite struct {
+	field  string
+	schema *AssociationSchema
+	input  common.AssociationInput
+}
+
+// resolveAssociationWrites finds the association schema for each input.
+// The association field is given as AssociationType, otherwise it is the only association with TargetObject.
+func (c *Connector) resolveAssociationWrites(
+	objectName string,
+	inputs []common.AssociationInput,
+) ([]associationWrite, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	associations := c.storage.GetAssociations()[ObjectName(objectName)]
+	writes := make([]associationWrite, 0, len(inputs))
+
+	for _, input := range inputs {
+		field := input.AssociationType
+
+		if field == "" {
+			for candidate, assoc := range associations {
+				if assoc.TargetObject != input.TargetObject {
+					continue
+				}
+
+				if field != "" {
+					return nil, fmt.Errorf("%w: %s has many associations with %s, choose one with association type",
+						common.ErrInvalidAssociationInput, objectName, input.TargetObject)
+				}
+
+				field = candidate
+			}
+		}
+
+		assoc, ok := associations[field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s has no association %q with %q",
+				common.ErrUnsupportedAssociation, objectName, field, input.TargetObject)
+		}
+
+		if input.TargetObject != "" && input.TargetObject != assoc.TargetObject {
+			return nil, fmt.Errorf("%w: association %s targets %s, not %s",
+				common.ErrInvalidAssociationInput, field, assoc.TargetObject, input.TargetObject)
+		}
+
+		writes = append(writes, associationWrite{
+			field:  field,
+			schema: assoc,
+			input:  input,
+		})
+	}
+
+	return writes, nil
+}
+
+// targetIdentifier returns the ID of the target record as it is stored, preserving its type.
+func (c *Connector) targetIdentifier(write associationWrite) (any, error) {
+	target, err := c.storage.Get(write.schema.TargetObject, write.input.TargetId)
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: association %s references %s record %s which does not exist",
+				ErrInvalidForeignKey, write.field, write.schema.TargetObject, write.input.TargetId)
+		}
+
+		return nil, err
+	}
+
+	idField := c.storage.GetIdFields()[ObjectName(write.schema.TargetObject)]
+	if value, ok := target[idField]; ok && value != nil {
+		return value, nil
+	}
+
+	return write.input.TargetId, nil
+}
+
+// applyForeignKeyWrites sets foreign key fields of the record before it is validated and stored.
+// Dissociated fields are returned, so they can be removed after the record is merged with existing data.
+func (c *Connector) applyForeignKeyWrites(record map[string]any, writes []associationWrite) ([]string, error) {
+	var cleared []string
+
+	for _, write := range writes {
+		if write.schema.AssociationType != "foreignKey" {
+			continue
+		}
+
+		if write.input.IsDissociate() {
+			cleared = append(cleared, write.field)
+
+			continue
+		}
+
+		value, err := c.targetIdentifier(write)
+		if err != nil {
+			return nil, err
+		}
+
+		record[write.field] = value
+	}
+
+	return cleared, nil
+}
+
+// writeLinkedAssociations writes associations stored outside the record once it exists.
+// Reverse lookups update the foreign key of the target record,
+// junction associations create or delete records of the junction object.
+func (c *Connector) writeLinkedAssociations(
+	ctx context.Context,
+	sourceID any,
+	writes []associationWrite,
+) error {
+	for _, write := range writes {
+		var err error
+
+		switch write.schema.AssociationType {
+		case "reverseLookup":
+			err = c.writeReverseLookup(sourceID, write)
+		case "junction":
+			err = c.writeJunction(ctx, sourceID, write)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to write association %s with %s %s: %w",
+				write.field, write.schema.TargetObject, write.input.TargetId, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Connector) writeReverseLookup(sourceID any, write associationWrite) error {
+	if write.schema.ForeignKeyField == "" {
+		return fmt.Errorf("%w: reverse lookup requires ForeignKeyField", ErrInvalidAssociation)
+	}
+
+	target, err := c.storage.Get(write.schema.TargetObject, write.input.TargetId)
+	if err != nil {
+		return err
+	}
+
+	if write.input.IsDissociate() {
+		if fmt.Sprint(target[write.schema.ForeignKeyField]) != fmt.Sprint(sourceID) {
+			// Target is not linked to this record.
+			return nil
+		}
+
+		delete(target, write.schema.ForeignKeyField)
+	} else {
+		target[write.schema.ForeignKeyField] = sourceID
+	}
+
+	return c.storage.Store(write.schema.TargetObject, write.input.TargetId, target, "update")
+}
+
+func (c *Connector) writeJunction(ctx context.Context, sourceID any, write associationWrite) error {
+	assoc := write.schema
+	if assoc.JunctionObject == "" || assoc.JunctionFromField == "" || assoc.JunctionToField == "" {
+		return fmt.Errorf(
+			"%w: junction requires JunctionObject, JunctionFromField, and JunctionToField",
+			ErrInvalidAssociation,
+		)
+	}
+
+	junctionRecords, err := c.storage.GetAll(assoc.JunctionObject)
+	if err != nil {
+		return err
+	}
+
+	junctionIdField := c.storage.GetIdFields()[ObjectName(assoc.JunctionObject)]
+
+	for _, junctionRecord := range junctionRecords {
+		if fmt.Sprint(junctionRecord[assoc.JunctionFromField]) != fmt.Sprint(sourceID) ||
+			fmt.Sprint(junctionRecord[assoc.JunctionToField]) != write.input.TargetId {
+			continue
+		}
+
+		if !write.input.IsDissociate() {
+			// Already linked.
+			return nil
+		}
+
+		if err = c.storage.Delete(assoc.JunctionObject, fmt.Sprint(junctionRecord[junctionIdField])); err != nil {
+			return err
+		}
+	}
+
+	if write.input.IsDissociate() {
+		return nil
+	}
+
+	targetID, err := c.targetIdentifier(write)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Write(ctx, common.WriteParams{
+		ObjectName: assoc.JunctionObject,
+		RecordData: map[string]any{
+			assoc.JunctionFromField: sourceID,
+			assoc.JunctionToField:   targetID,
+		},
+	})
+
+	return err
+}
@@ -209,6 +209,24 @@ func TestForeignKeyAssociation_Expansion(t *testing.T) {
 	assert.Equal(t, "Technology", accountData["industry"])
 }
 
+func TestForeignKeyAssociation_Metadata(t *testing.T) {
+	t.Parallel()
+
+	conn := setupAssociationConnector(t)
+
+	result, err := conn.ListObjectMetadata(context.Background(), []string{"contact", "opportunity"})
+	require.NoError(t, err)
+
+	accountField := result.Result["contact"].Fields["account_id"]
+	assert.Equal(t, common.ValueType(common.ValueTypeReference), accountField.ValueType)
+	assert.Equal(t, []string{"account"}, accountField.ReferenceTo)
+
+	// Reverse lookups are not references held by the record.
+	contactsField := result.Result["opportunity"].Fields["contacts"]
+	assert.Equal(t, common.ValueType(common.ValueTypeArray), contactsField.ValueType)
+	assert.Nil(t, contactsField.ReferenceTo)
+}
+
 func TestForeignKeyAssociation_NullForeignKey(t *testing.T) {
 	t.Parallel()
 
@@ -791,3 +809,141 @@ func TestExpandAssociations_Pagination(t *testing.T) {
 		assert.Len(t, contact.Associations["account_id"], 1)
 	}
 }
+
+func TestWriteAssociations_ForeignKey(t *testing.T) {
+	t.Parallel()
+
+	conn := setupAssociationConnector(t)
+	ctx := context.Background()
+
+	_, err := conn.Write(ctx, common.WriteParams{
+		ObjectName: "account",
+		RecordData: map[string]any{"id": "acc-1", "name": "Acme Corp"},
+	})
+	require.NoError(t, err)
+
+	// Link is created together with the contact.
+	created, err := conn.Write(ctx, common.WriteParams{
+		ObjectName: "contact",
+		RecordData: map[string]any{"name": "John Doe", "email": "john@example.com"},
+		Associations: []common.AssociationInput{{
+			TargetObject: "account",
+			TargetId:     "acc-1",
+		}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, created.Errors)
+
+	stored, err := conn.storage.Get("contact", created.RecordId)
+	require.NoError(t, err)
+	assert.Equal(t, "acc-1", stored["account_id"])
+
+	// Link to a missing record is rejected.
+	_, err = conn.Write(ctx, common.WriteParams{
+		ObjectName: "contact",
+		RecordId:   created.RecordId,
+		RecordData: map[string]any{},
+		Associations: []common.AssociationInput{{
+			AssociationType: "account_id",
+			TargetId:        "acc-missing",
+		}},
+	})
+	require.ErrorIs(t, err, ErrInvalidForeignKey)
+
+	_, err = conn.Write(ctx, common.WriteParams{
+		ObjectName: "contact",
+		RecordId:   created.RecordId,
+		RecordData: map[string]any{},
+		Associations: []common.AssociationInput{{
+			TargetObject: "account",
+			TargetId:     "acc-1",
+			Operation:    common.AssociationOperationDissociate,
+		}},
+	})
+	require.NoError(t, err)
+
+	stored, err = conn.storage.Get("contact", created.RecordId)
+	require.NoError(t, err)
+	assert.NotContains(t, stored, "account_id")
+}
+
+func TestWriteAssociations_Junction(t *testing.T) {
+	t.Parallel()
+
+	conn := setupAssociationConnector(t)
+	ctx := context.Background()
+
+	for _, contactID := range []string{"cont-1", "cont-2"} {
+		_, err := conn.Write(ctx, common.WriteParams{
+			ObjectName: "contact",
+			RecordData: map[string]any{"id": contactID, "name": contactID, "email": contactID + "@example.com"},
+		})
+		require.NoError(t, err)
+	}
+
+	created, err := conn.Write(ctx, common.WriteParams{
+		ObjectName: "deal",
+		RecordData: map[string]any{"id": "deal-1", "name": "Enterprise Deal"},
+		Associations: []common.AssociationInput{
+			{TargetObject: "contact", TargetId: "cont-1"},
+			{TargetObject: "contact", TargetId: "cont-2"},
+			{TargetObject: "contact", TargetId: "cont-2"}, // repeated link is ignored
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, created.Errors)
+
+	junctions, err := conn.storage.GetAll("dealContact")
+	require.NoError(t, err)
+	assert.Len(t, junctions, 2)
+
+	_, err = conn.Write(ctx, common.WriteParams{
+		ObjectName: "deal",
+		RecordId:   "deal-1",
+		RecordData: map[string]any{},
+		Associations: []common.AssociationInput{{
+			AssociationType: "contacts",
+			TargetId:        "cont-1",
+			Operation:       common.AssociationOperationDissociate,
+		}},
+	})
+	require.NoError(t, err)
+
+	readResult, err := conn.Read(ctx, common.ReadParams{
+		ObjectName:        "deal",
+		Fields:            datautils.NewStringSet("id"),
+		AssociatedObjects: []string{"contacts"},
+	})
+	require.NoError(t, err)
+	require.Len(t, readResult.Data, 1)
+	require.Len(t, readResult.Data[0].Associations["contacts"], 1)
+	assert.Equal(t, "cont-2", readResult.Data[0].Associations["contacts"][0].ObjectId)
+}
+
+func TestWriteAssociations_Invalid(t *testing.T) {
+	t.Parallel()
+
+	conn := setupAssociationConnector(t)
+	ctx := context.Background()
+
+	_, err := conn.Write(ctx, common.WriteParams{
+		ObjectName: "contact",
+		RecordData: map[string]any{"name": "John Doe", "email": "john@example.com"},
+		Associations: []common.AssociationInput{{
+			TargetObject: "deal",
+			TargetId:     "deal-1",
+		}},
+	})
+	require.ErrorIs(t, err, common.ErrUnsupportedAssociation)
+
+	_, err = conn.Write(ctx, common.WriteParams{
+		ObjectName: "contact",
+		RecordData: map[string]any{"name": "John Doe", "email": "john@example.com"},
+		Associations: []common.AssociationInput{{
+			TargetObject: "account",
+			TargetId:     "acc-1",
+			Operation:    common.AssociationOperationDissociate,
+		}},
+	})
+	require.ErrorIs(t, err, common.ErrInvalidAssociationInput)
+}
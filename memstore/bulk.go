@@ -0,0 +1,329 @@
+package memstore
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/google/uuid"
+)
+
+// bulkJobRegistry keeps bulk jobs in memory.
+//
+// Submitted jobs stay pending until their status is requested with GetBulkJob,
+// at which point every row is applied through Write or Delete and the job completes.
+// This mimics the asynchronous lifecycle, letting callers exercise polling and aborts.
+type bulkJobRegistry struct {
+	mutex sync.Mutex
+	jobs  map[string]*bulkJob
+	order []string
+}
+
+type bulkJob struct {
+	job     common.BulkJob
+	params  common.BulkJobParams
+	records []common.Record
+	results []common.BulkJobResultRow
+}
+
+func newBulkJobRegistry() *bulkJobRegistry {
+	return &bulkJobRegistry{
+		jobs: make(map[string]*bulkJob),
+	}
+}
+
+// SubmitBulkJob stores the job as pending.
+// CSV values are strings, therefore schema fields of other types should be submitted as Records.
+func (c *Connector) SubmitBulkJob(_ context.Context, params common.BulkJobParams) (*common.BulkJob, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if _, exists := c.getSchema(params.ObjectName); !exists {
+		return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, params.ObjectName)
+	}
+
+	records, err := readBulkRecords(params)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &bulkJob{
+		job: common.BulkJob{
+			ID:         uuid.New().String(),
+			ObjectName: params.ObjectName,
+			Operation:  params.Operation,
+			State:      common.BulkJobStatePending,
+		},
+		params:  params,
+		records: records,
+	}
+
+	c.bulkJobs.mutex.Lock()
+	defer c.bulkJobs.mutex.Unlock()
+
+	c.bulkJobs.jobs[job.job.ID] = job
+	c.bulkJobs.order = append(c.bulkJobs.order, job.job.ID)
+
+	status := job.job
+
+	return &status, nil
+}
+
+// GetBulkJob runs a pending job to completion and returns its status.
+func (c *Connector) GetBulkJob(ctx context.Context, jobID string) (*common.BulkJob, error) {
+	c.bulkJobs.mutex.Lock()
+	defer c.bulkJobs.mutex.Unlock()
+
+	job, ok := c.bulkJobs.jobs[jobID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", common.ErrBulkJobNotFound, jobID)
+	}
+
+	if job.job.State == common.BulkJobStatePending {
+		c.runBulkJob(ctx, job)
+	}
+
+	status := job.job
+
+	return &status, nil
+}
+
+// ListBulkJobs returns every job in the order of submission.
+func (c *Connector) ListBulkJobs(_ context.Context) ([]common.BulkJob, error) {
+	c.bulkJobs.mutex.Lock()
+	defer c.bulkJobs.mutex.Unlock()
+
+	result := make([]common.BulkJob, len(c.bulkJobs.order))
+	for index, jobID := range c.bulkJobs.order {
+		result[index] = c.bulkJobs.jobs[jobID].job
+	}
+
+	return result, nil
+}
+
+// AbortBulkJob aborts a pending job, none of its rows are applied.
+func (c *Connector) AbortBulkJob(_ context.Context, jobID string) (*common.BulkJob, error) {
+	c.bulkJobs.mutex.Lock()
+	defer c.bulkJobs.mutex.Unlock()
+
+	job, ok := c.bulkJobs.jobs[jobID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", common.ErrBulkJobNotFound, jobID)
+	}
+
+	if job.job.State.IsTerminal() {
+		return nil, fmt.Errorf("%w: %s", common.ErrBulkJobFinished, jobID)
+	}
+
+	job.job.State = common.BulkJobStateAborted
+	job.records = nil
+
+	status := job.job
+
+	return &status, nil
+}
+
+// GetBulkJobResults passes rows of the finished job to the handler in the order of submission.
+func (c *Connector) GetBulkJobResults(
+	_ context.Context, params common.BulkJobResultsParams, handler common.BulkJobResultHandler,
+) error {
+	if err := params.ValidateParams(); err != nil {
+		return err
+	}
+
+	jobID := params.JobID
+
+	c.bulkJobs.mutex.Lock()
+
+	job, ok := c.bulkJobs.jobs[jobID]
+	if !ok {
+		c.bulkJobs.mutex.Unlock()
+
+		return fmt.Errorf("%w: %s", common.ErrBulkJobNotFound, jobID)
+	}
+
+	if !job.job.State.IsTerminal() {
+		c.bulkJobs.mutex.Unlock()
+
+		return fmt.Errorf("%w: %s", common.ErrBulkJobNotFinished, jobID)
+	}
+
+	results := slices.Clone(job.results)
+
+	c.bulkJobs.mutex.Unlock()
+
+	for _, row := range results {
+		if err := handler(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runBulkJob applies every row, failures of individual rows don't stop the job.
+func (c *Connector) runBulkJob(ctx context.Context, job *bulkJob) {
+	job.results = make([]common.BulkJobResultRow, len(job.records))
+
+	for index, record := range job.records {
+		row := common.BulkJobResultRow{
+			Index:  index,
+			Fields: maps.Clone(record),
+		}
+
+		recordID, err := c.applyBulkRow(ctx, job.params, record)
+		if err != nil {
+			row.Errors = []any{err.Error()}
+			job.job.RecordsFailed++
+		} else {
+			row.Success = true
+			row.RecordId = recordID
+		}
+
+		job.results[index] = row
+		job.job.RecordsProcessed++
+	}
+
+	job.job.State = common.BulkJobStateComplete
+	job.records = nil
+}
+
+func (c *Connector) applyBulkRow(
+	ctx context.Context, params common.BulkJobParams, record common.Record,
+) (string, error) {
+	idField := c.storage.GetIdFields()[ObjectName(params.ObjectName)]
+
+	switch params.Operation {
+	case common.BulkOperationInsert:
+		return c.writeBulkRow(ctx, params.ObjectName, "", record)
+	case common.BulkOperationUpdate:
+		recordID, ok := bulkRowValue(record, idField)
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrBulkRowMissingID, idField)
+		}
+
+		return c.writeBulkRow(ctx, params.ObjectName, recordID, record)
+	case common.BulkOperationUpsert:
+		recordID, err := c.findByExternalID(params.ObjectName, idField, params.ExternalIdField, record)
+		if err != nil {
+			return "", err
+		}
+
+		return c.writeBulkRow(ctx, params.ObjectName, recordID, record)
+	case common.BulkOperationDelete:
+		recordID, ok := bulkRowValue(record, idField)
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrBulkRowMissingID, idField)
+		}
+
+		_, err := c.Delete(ctx, common.DeleteParams{
+			ObjectName: params.ObjectName,
+			RecordId:   recordID,
+		})
+
+		return recordID, err
+	default:
+		return "", fmt.Errorf("%w: %q", common.ErrUnsupportedBulkOperation, params.Operation)
+	}
+}
+
+func (c *Connector) writeBulkRow(
+	ctx context.Context, objectName, recordID string, record common.Record,
+) (string, error) {
+	result, err := c.Write(ctx, common.WriteParams{
+		ObjectName: objectName,
+		RecordId:   recordID,
+		RecordData: map[string]any(record),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return result.RecordId, nil
+}
+
+// findByExternalID returns the ID of the record with the same external ID value.
+// Empty ID is returned when there is no such record, so that the row is inserted.
+func (c *Connector) findByExternalID(
+	objectName, idField, externalIdField string, record common.Record,
+) (string, error) {
+	externalID, ok := bulkRowValue(record, externalIdField)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrBulkRowMissingID, externalIdField)
+	}
+
+	existing, err := c.storage.GetAll(objectName)
+	if err != nil {
+		return "", err
+	}
+
+	for _, candidate := range existing {
+		if value, found := bulkRowValue(candidate, externalIdField); found && value == externalID {
+			recordID, _ := bulkRowValue(candidate, idField)
+
+			return recordID, nil
+		}
+	}
+
+	return "", nil
+}
+
+func bulkRowValue(record map[string]any, field string) (string, bool) {
+	if field == "" {
+		return "", false
+	}
+
+	value, ok := record[field]
+	if !ok || value == nil {
+		return "", false
+	}
+
+	text := fmt.Sprint(value)
+
+	return text, text != ""
+}
+
+// readBulkRecords collects rows, CSV lines are converted into records with string values.
+func readBulkRecords(params common.BulkJobParams) ([]common.Record, error) {
+	if params.Records != nil {
+		return slices.Collect(params.Records), nil
+	}
+
+	reader := csv.NewReader(params.CSVData)
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, common.ErrMissingCSVData
+		}
+
+		return nil, err
+	}
+
+	var records []common.Record
+
+	for {
+		line, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		record := make(common.Record, len(header))
+		for position, column := range header {
+			record[column] = line[position]
+		}
+
+		records = append(records, record)
+	}
+}
@@ -0,0 +1,183 @@
+package memstore
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectBulkJobResults(t *testing.T, conn *Connector, jobID string) []common.BulkJobResultRow {
+	t.Helper()
+
+	var rows []common.BulkJobResultRow
+
+	params := common.BulkJobResultsParams{JobID: jobID}
+
+	err := conn.GetBulkJobResults(context.Background(), params, func(row common.BulkJobResultRow) error {
+		rows = append(rows, row)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return rows
+}
+
+func TestBulkJob_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	conn, err := NewConnector(WithSchemas(map[string]*InputSchema{
+		"persons": testPersonSchema,
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	job, err := conn.SubmitBulkJob(ctx, common.BulkJobParams{
+		ObjectName: "persons",
+		Operation:  common.BulkOperationInsert,
+		Records: slices.Values([]common.Record{
+			{"name": "Alice", "email": "alice@example.com"},
+			{"name": "Invalid"}, // missing required email
+			{"name": "Bob", "email": "bob@example.com"},
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, common.BulkJobStatePending, job.State)
+
+	// Results are not available until the job is finished.
+	err = conn.GetBulkJobResults(ctx, common.BulkJobResultsParams{JobID: job.ID},
+		func(common.BulkJobResultRow) error { return nil })
+	require.ErrorIs(t, err, common.ErrBulkJobNotFinished)
+
+	job, err = conn.GetBulkJob(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, common.BulkJobStateComplete, job.State)
+	assert.Equal(t, 3, job.RecordsProcessed)
+	assert.Equal(t, 1, job.RecordsFailed)
+
+	rows := collectBulkJobResults(t, conn, job.ID)
+	require.Len(t, rows, 3)
+
+	for index, row := range rows {
+		assert.Equal(t, index, row.Index)
+	}
+
+	assert.True(t, rows[0].Success)
+	assert.NotEmpty(t, rows[0].RecordId)
+	assert.False(t, rows[1].Success)
+	assert.NotEmpty(t, rows[1].Errors)
+	assert.True(t, rows[2].Success)
+
+	stored, err := conn.storage.Get("persons", rows[2].RecordId)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob", stored["name"])
+
+	_, err = conn.AbortBulkJob(ctx, job.ID)
+	require.ErrorIs(t, err, common.ErrBulkJobFinished)
+}
+
+func TestBulkJob_UpsertAndDelete(t *testing.T) {
+	t.Parallel()
+
+	conn, err := NewConnector(WithSchemas(map[string]*InputSchema{
+		"persons": testPersonSchema,
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	existing, err := conn.Write(ctx, common.WriteParams{
+		ObjectName: "persons",
+		RecordData: map[string]any{"name": "Alice", "email": "alice@example.com"},
+	})
+	require.NoError(t, err)
+
+	upsert, err := conn.SubmitBulkJob(ctx, common.BulkJobParams{
+		ObjectName:      "persons",
+		Operation:       common.BulkOperationUpsert,
+		ExternalIdField: "email",
+		CSVData:         strings.NewReader("name,email\nAlice Smith,alice@example.com\nCarol,carol@example.com\n"),
+	})
+	require.NoError(t, err)
+
+	_, err = conn.GetBulkJob(ctx, upsert.ID)
+	require.NoError(t, err)
+
+	rows := collectBulkJobResults(t, conn, upsert.ID)
+	require.Len(t, rows, 2)
+	assert.True(t, rows[0].Success)
+	assert.Equal(t, existing.RecordId, rows[0].RecordId)
+	assert.True(t, rows[1].Success)
+	assert.NotEqual(t, existing.RecordId, rows[1].RecordId)
+
+	updated, err := conn.storage.Get("persons", existing.RecordId)
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Smith", updated["name"])
+
+	remove, err := conn.SubmitBulkJob(ctx, common.BulkJobParams{
+		ObjectName: "persons",
+		Operation:  common.BulkOperationDelete,
+		Records: slices.Values([]common.Record{
+			{"id": existing.RecordId},
+			{"name": "no id"},
+		}),
+	})
+	require.NoError(t, err)
+
+	_, err = conn.GetBulkJob(ctx, remove.ID)
+	require.NoError(t, err)
+
+	rows = collectBulkJobResults(t, conn, remove.ID)
+	require.Len(t, rows, 2)
+	assert.True(t, rows[0].Success)
+	assert.False(t, rows[1].Success)
+
+	_, err = conn.storage.Get("persons", existing.RecordId)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	jobs, err := conn.ListBulkJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, upsert.ID, jobs[0].ID)
+	assert.Equal(t, remove.ID, jobs[1].ID)
+}
+
+func TestBulkJob_Abort(t *testing.T) {
+	t.Parallel()
+
+	conn, err := NewConnector(WithSchemas(map[string]*InputSchema{
+		"persons": testPersonSchema,
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	job, err := conn.SubmitBulkJob(ctx, common.BulkJobParams{
+		ObjectName: "persons",
+		Operation:  common.BulkOperationInsert,
+		Records:    slices.Values([]common.Record{{"name": "Alice", "email": "alice@example.com"}}),
+	})
+	require.NoError(t, err)
+
+	job, err = conn.AbortBulkJob(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, common.BulkJobStateAborted, job.State)
+
+	job, err = conn.GetBulkJob(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, common.BulkJobStateAborted, job.State)
+	assert.Empty(t, collectBulkJobResults(t, conn, job.ID))
+
+	records, err := conn.storage.GetAll("persons")
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	_, err = conn.GetBulkJob(ctx, "unknown")
+	require.ErrorIs(t, err, common.ErrBulkJobNotFound)
+}
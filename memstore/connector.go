@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/amp-labs/connectors"
@@ -53,8 +54,13 @@ const (
 type Connector struct {
 	client  *common.JSONHTTPClient
 	params  *parameters
-	schemas SchemaRegistry
 	storage Storage
+
+	// schemas can be changed at runtime via UpsertMetadata.
+	schemas   SchemaRegistry
+	schemasMu sync.RWMutex
+
+	bulkJobs *bulkJobRegistry
 }
 
 // Compile-time interface checks.
@@ -68,6 +74,8 @@ var (
 	_ connectors.RegisterSubscribeConnector = (*Connector)(nil)
 	_ connectors.WebhookVerifierConnector   = (*Connector)(nil)
 	_ connectors.ConfigurationConnector     = (*Connector)(nil)
+	_ connectors.BulkJobConnector           = (*Connector)(nil)
+	_ connectors.UpsertMetadataConnector    = (*Connector)(nil)
 )
 
 // NewConnector creates a new memstore connector instance.
@@ -115,9 +123,10 @@ func NewConnector(opts ...Option) (*Connector, error) {
 		client: &common.JSONHTTPClient{
 			HTTPClient: params.Caller,
 		},
-		params:  params,
-		schemas: parsedSchemas,
-		storage: store,
+		params:   params,
+		schemas:  parsedSchemas,
+		storage:  store,
+		bulkJobs: newBulkJobRegistry(),
 	}, nil
 }
 
@@ -151,7 +160,7 @@ func (c *Connector) Read(_ context.Context, params common.ReadParams) (*common.R
 	}
 
 	// Check if object schema exists
-	if _, exists := c.schemas.Get(params.ObjectName); !exists {
+	if _, exists := c.getSchema(params.ObjectName); !exists {
 		return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, params.ObjectName)
 	}
 
@@ -275,14 +284,14 @@ func (c *Connector) Read(_ context.Context, params common.ReadParams) (*common.R
 // Write creates or updates a record.
 //
 //nolint:cyclop,funlen,nestif // Complexity from create/update branching and ID/timestamp generation logic
-func (c *Connector) Write(_ context.Context, params common.WriteParams) (*common.WriteResult, error) {
+func (c *Connector) Write(ctx context.Context, params common.WriteParams) (*common.WriteResult, error) {
 	// Validate parameters
 	if err := params.ValidateParams(); err != nil {
 		return nil, err
 	}
 
 	// Check if object schema exists
-	schema, exists := c.schemas.Get(params.ObjectName)
+	schema, exists := c.getSchema(params.ObjectName)
 	if !exists {
 		return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, params.ObjectName)
 	}
@@ -293,6 +302,17 @@ func (c *Connector) Write(_ context.Context, params common.WriteParams) (*common
 		return nil, fmt.Errorf("failed to convert record data: %w", err)
 	}
 
+	// Foreign keys are part of the record, other associations are written once the record is stored.
+	associationWrites, err := c.resolveAssociationWrites(params.ObjectName, params.Associations)
+	if err != nil {
+		return nil, err
+	}
+
+	clearedForeignKeys, err := c.applyForeignKeyWrites(recordMap, associationWrites)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		recordID    string
 		finalRecord map[string]any
@@ -355,6 +375,10 @@ func (c *Connector) Write(_ context.Context, params common.WriteParams) (*common
 		finalRecord = existing
 	}
 
+	for _, field := range clearedForeignKeys {
+		delete(finalRecord, field)
+	}
+
 	// Validate record against schema
 	if err := validateRecord(schema, finalRecord); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
@@ -370,11 +394,24 @@ func (c *Connector) Write(_ context.Context, params common.WriteParams) (*common
 		return nil, fmt.Errorf("failed to store record: %w", err)
 	}
 
-	return &common.WriteResult{
+	result := &common.WriteResult{
 		Success:  true,
 		RecordId: recordID,
 		Data:     finalRecord,
-	}, nil
+	}
+
+	sourceID := any(recordID)
+	if idField := c.storage.GetIdFields()[ObjectName(params.ObjectName)]; finalRecord[idField] != nil {
+		sourceID = finalRecord[idField]
+	}
+
+	// Failures don't undo the write, the record is reported as failed.
+	if err := c.writeLinkedAssociations(ctx, sourceID, associationWrites); err != nil {
+		result.Success = false
+		result.Errors = append(result.Errors, err)
+	}
+
+	return result, nil
 }
 
 // Delete removes a record.
@@ -385,7 +422,7 @@ func (c *Connector) Delete(_ context.Context, params connectors.DeleteParams) (*
 	}
 
 	// Check if object schema exists
-	if _, exists := c.schemas.Get(params.ObjectName); !exists {
+	if _, exists := c.getSchema(params.ObjectName); !exists {
 		return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, params.ObjectName)
 	}
 
@@ -412,7 +449,7 @@ func (c *Connector) ListObjectMetadata(
 	result := common.NewListObjectMetadataResult()
 
 	for _, objectName := range objectNames {
-		schema, exists := c.schemas.Get(objectName)
+		schema, exists := c.getSchema(objectName)
 		if !exists {
 			result.AppendError(objectName, fmt.Errorf("%w: %s", ErrSchemaNotFound, objectName))
 
@@ -448,7 +485,7 @@ func (c *Connector) DefaultPageSize() int {
 //
 //nolint:cyclop,funlen // Complexity from validation retry logic and field generation for all property types
 func (c *Connector) generateRandomRecordWithDepth(objectName string, depth int) (map[string]any, error) {
-	schema, exists := c.schemas.Get(objectName)
+	schema, exists := c.getSchema(objectName)
 	if !exists {
 		return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, objectName)
 	}
@@ -790,8 +790,8 @@ func TestListObjectMetadataWithStructSchemas(t *testing.T) {
 			t.Fatal("expected 'email' field in metadata")
 		}
 
-		if emailField.ValueType != common.ValueTypeString {
-			t.Errorf("expected email ValueType String, got %v", emailField.ValueType)
+		if emailField.ValueType != common.ValueTypeEmail {
+			t.Errorf("expected email ValueType Email, got %v", emailField.ValueType)
 		}
 
 		if emailField.IsRequired == nil || !*emailField.IsRequired {
@@ -827,8 +827,12 @@ func TestListObjectMetadataWithStructSchemas(t *testing.T) {
 			t.Fatal("expected 'tags' field in metadata")
 		}
 
-		if tagsField.ValueType != common.ValueTypeOther {
-			t.Errorf("expected tags ValueType Other (array), got %v", tagsField.ValueType)
+		if tagsField.ValueType != common.ValueTypeArray {
+			t.Errorf("expected tags ValueType Array, got %v", tagsField.ValueType)
+		}
+
+		if tagsField.ItemType != common.ValueTypeString {
+			t.Errorf("expected tags ItemType String, got %v", tagsField.ItemType)
 		}
 
 		// Check firstName field (with title)
@@ -894,8 +898,8 @@ func TestListObjectMetadataWithStructSchemas(t *testing.T) {
 			t.Fatal("expected 'website' field in metadata")
 		}
 
-		if websiteField.ValueType != common.ValueTypeString {
-			t.Errorf("expected website ValueType String, got %v", websiteField.ValueType)
+		if websiteField.ValueType != common.ValueTypeURL {
+			t.Errorf("expected website ValueType URL, got %v", websiteField.ValueType)
 		}
 	})
 }
@@ -178,4 +178,11 @@ var (
 	// ErrRecordIDEmpty is returned when processing a subscription event with no record ID.
 	// All subscription events must specify which record was affected.
 	ErrRecordIDEmpty = errors.New("record ID is empty")
+
+	// Bulk Job Errors
+	// These errors are reported per row in bulk job results.
+
+	// ErrBulkRowMissingID is returned for update and delete rows that don't carry the ID field,
+	// or for upsert rows that don't carry the external ID field.
+	ErrBulkRowMissingID = errors.New("bulk row has no identifier")
 )
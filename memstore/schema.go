@@ -504,7 +504,7 @@ func validateRecord(schema *jsonschema.Schema, record map[string]any) error {
 func schemaToObjectMetadata(
 	objectName string,
 	schema *jsonschema.Schema,
-	_ map[string]*AssociationSchema, // associations is unused but kept for future use
+	associations map[string]*AssociationSchema,
 ) *common.ObjectMetadata {
 	if schema == nil {
 		return nil
@@ -566,21 +566,28 @@ func schemaToObjectMetadata(
 				valueType = common.ValueTypeInt
 			case "boolean":
 				valueType = common.ValueTypeBoolean
-			case "array", "object":
-				// No specific type for arrays/objects, use Other
-				valueType = common.ValueTypeOther
+			case "array":
+				valueType = common.ValueTypeArray
+			case "object":
+				valueType = common.ValueTypeJSON
 			default:
 				valueType = common.ValueTypeString
 			}
 		}
 
-		// Detect format-based types for date/datetime fields
+		// Detect format-based types for date/datetime and contact fields
 		if format, ok := fieldMap["format"].(string); ok {
 			switch format {
 			case "date":
 				valueType = common.ValueTypeDate
 			case "date-time":
 				valueType = common.ValueTypeDateTime
+			case "email":
+				valueType = common.ValueTypeEmail
+			case "uri":
+				valueType = common.ValueTypeURL
+			case "phone":
+				valueType = common.ValueTypePhone
 			}
 		}
 
@@ -625,13 +632,7 @@ func schemaToObjectMetadata(
 		// Apply required fields map
 		isRequired := requiredFields[fieldName]
 
-		// Note: Association metadata is stored in the storage layer and will be used
-		// during Read operations for expansion. FieldMetadata doesn't currently have
-		// an Association field (that's in FieldDefinition), so we skip it here.
-		// The association data is still available via c.storage.GetAssociations()
-		// for use during read expansion and write validation.
-
-		fields[fieldName] = common.FieldMetadata{
+		field := common.FieldMetadata{
 			DisplayName:  fieldDisplayName,
 			ValueType:    valueType,
 			ProviderType: providerType,
@@ -639,8 +640,58 @@ func schemaToObjectMetadata(
 			IsRequired:   boolPtr(isRequired),
 			IsCustom:     boolPtr(false),
 			Values:       values,
+			MaxLength:    schemaIntKeyword(fieldMap, "maxLength"),
+		}
+
+		// Foreign keys hold the identifier of the target record.
+		// Reverse lookups and junctions are not stored on the record, so they are not fields.
+		if association, ok := associations[fieldName]; ok && association.AssociationType == "foreignKey" {
+			field.ValueType = common.ValueTypeReference
+			field.ReferenceTo = []string{association.TargetObject}
 		}
+
+		if valueType == common.ValueTypeArray {
+			if items, ok := fieldMap["items"].(map[string]any); ok {
+				field.ItemType = schemaItemType(items)
+			}
+		}
+
+		fields[fieldName] = field
 	}
 
 	return common.NewObjectMetadata(displayName, fields)
 }
+
+// schemaItemType maps the type of array items to ValueType.
+func schemaItemType(items map[string]any) common.ValueType {
+	if _, ok := items["enum"]; ok {
+		return common.ValueTypeSingleSelect
+	}
+
+	switch items["type"] {
+	case typeString:
+		return common.ValueTypeString
+	case typeNumber:
+		return common.ValueTypeFloat
+	case typeInteger:
+		return common.ValueTypeInt
+	case typeBoolean:
+		return common.ValueTypeBoolean
+	case typeObject:
+		return common.ValueTypeJSON
+	default:
+		return common.ValueTypeOther
+	}
+}
+
+// schemaIntKeyword returns a numeric JSON schema keyword such as maxLength.
+func schemaIntKeyword(fieldMap map[string]any, keyword string) *int {
+	value, ok := fieldMap[keyword].(float64)
+	if !ok {
+		return nil
+	}
+
+	result := int(value)
+
+	return &result
+}
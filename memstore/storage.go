@@ -170,6 +170,13 @@ type Storage interface {
 	// modifications.
 	GetUpdatedFields() map[ObjectName]string
 
+	// RegisterObject sets the ID and updated timestamp field names of an object,
+	// it is used when objects are defined at runtime, e.g. via UpsertMetadata.
+	//
+	// Empty field name removes the mapping, the object then has no updated timestamp field
+	// and records use the default "id" field.
+	RegisterObject(objectName, idField, updatedField string)
+
 	// GetAssociations returns a mapping of object names to their association metadata.
 	//
 	// Each object can have zero or more fields configured as associations to other objects.
@@ -659,6 +666,9 @@ func (s *storage) List(objectName string, since, until time.Time) ([]map[string]
 // GetIdFields returns a copy of the object name to ID field name mapping.
 // This ensures external callers cannot modify the internal mapping.
 func (s *storage) GetIdFields() map[ObjectName]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	out := make(map[ObjectName]string, len(s.idFields))
 
 	maps.Copy(out, s.idFields)
@@ -669,6 +679,9 @@ func (s *storage) GetIdFields() map[ObjectName]string {
 // GetUpdatedFields returns a copy of the object name to updated timestamp field name mapping.
 // This ensures external callers cannot modify the internal mapping.
 func (s *storage) GetUpdatedFields() map[ObjectName]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	out := make(map[ObjectName]string, len(s.updatedFields))
 
 	maps.Copy(out, s.updatedFields)
@@ -676,6 +689,25 @@ func (s *storage) GetUpdatedFields() map[ObjectName]string {
 	return out
 }
 
+// RegisterObject sets the ID and updated timestamp field names of the object.
+func (s *storage) RegisterObject(objectName, idField, updatedField string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	setOrDelete(s.idFields, ObjectName(objectName), idField)
+	setOrDelete(s.updatedFields, ObjectName(objectName), updatedField)
+}
+
+func setOrDelete(fields map[ObjectName]string, objectName ObjectName, fieldName string) {
+	if fieldName == "" {
+		delete(fields, objectName)
+
+		return
+	}
+
+	fields[objectName] = fieldName
+}
+
 // GetAssociations returns a deep copy of the object name to association metadata mapping.
 // This ensures external callers cannot modify the internal mapping.
 func (s *storage) GetAssociations() map[ObjectName]map[string]*AssociationSchema {
@@ -240,7 +240,7 @@ func (c *Connector) getSubscribeSummary(
 	updateFieldsDedup := make(map[string]struct{})
 
 	for _, objectName := range objects {
-		schema, exists := c.schemas.Get(string(objectName))
+		schema, exists := c.getSchema(string(objectName))
 		if !exists {
 			continue
 		}
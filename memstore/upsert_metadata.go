@@ -0,0 +1,428 @@
+package memstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/kaptinlin/jsonschema"
+)
+
+// Field name of the identifier given to objects created via UpsertMetadata.
+// Storage falls back to the same name when an object has no x-amp-id-field.
+const defaultIDField = "id"
+
+// UpsertMetadata changes object schemas of the registry at runtime.
+// Objects are created or retitled, fields are added, replaced or removed from schema properties.
+// Records already in storage are left as is, removed fields simply stop being validated.
+//
+// All schemas are compiled before any of them is registered, therefore a failing call has no effect.
+// A dry run stops right before registration.
+//
+//nolint:cyclop,funlen // Objects, fields and deletions are resolved in a single pass to stay atomic
+func (c *Connector) UpsertMetadata(
+	_ context.Context, params *common.UpsertMetadataParams,
+) (*common.UpsertMetadataResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	c.schemasMu.Lock()
+	defer c.schemasMu.Unlock()
+
+	result := &common.UpsertMetadataResult{
+		Success: true,
+		DryRun:  params.DryRun,
+		Fields:  make(map[string]map[string]common.FieldUpsertResult),
+	}
+
+	// Schemas are edited as maps and compiled once all changes are applied.
+	staged := make(map[string]map[string]any)
+
+	stage := func(objectName string) (map[string]any, error) {
+		if schemaMap, ok := staged[objectName]; ok {
+			return schemaMap, nil
+		}
+
+		schema, exists := c.schemas.Get(objectName)
+		if !exists {
+			return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, objectName)
+		}
+
+		schemaMap, err := schemaAsMap(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		staged[objectName] = schemaMap
+
+		return schemaMap, nil
+	}
+
+	if len(params.Objects) != 0 {
+		result.Objects = make(map[string]common.ObjectUpsertResult, len(params.Objects))
+	}
+
+	for _, objectName := range slices.Sorted(maps.Keys(params.Objects)) {
+		definition := params.Objects[objectName]
+		action := common.UpsertMetadataActionUpdate
+
+		if _, exists := c.schemas.Get(objectName); !exists {
+			action = common.UpsertMetadataActionCreate
+			staged[objectName] = newObjectSchemaMap()
+		}
+
+		schemaMap, err := stage(objectName)
+		if err != nil {
+			return nil, err
+		}
+
+		warnings, err := applyObjectDefinition(schemaMap, definition)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Objects[objectName] = common.ObjectUpsertResult{
+			ObjectName: objectName,
+			Action:     action,
+			Warnings:   warnings,
+		}
+	}
+
+	for _, objectName := range slices.Sorted(maps.Keys(params.Fields)) {
+		schemaMap, err := stage(objectName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, definition := range params.Fields[objectName] {
+			fieldResult, err := applyFieldDefinition(schemaMap, definition)
+			if err != nil {
+				return nil, err
+			}
+
+			setFieldResult(result, objectName, fieldResult)
+		}
+	}
+
+	for _, objectName := range slices.Sorted(maps.Keys(params.DeleteFields)) {
+		schemaMap, err := stage(objectName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fieldName := range params.DeleteFields[objectName] {
+			setFieldResult(result, objectName, removeField(schemaMap, fieldName))
+		}
+	}
+
+	compiled, err := compileSchemaMaps(staged)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.DryRun {
+		return result, nil
+	}
+
+	for objectName, schema := range compiled {
+		c.schemas.Set(objectName, schema)
+	}
+
+	// Storage learns special fields of new objects, changed objects are registered again.
+	for objectName, schemaMap := range staged {
+		idField, updatedField, err := specialFieldsOf(schemaMap)
+		if err != nil {
+			return nil, err
+		}
+
+		c.storage.RegisterObject(objectName, idField, updatedField)
+	}
+
+	return result, nil
+}
+
+// getSchema is a thread-safe lookup of the schema registry, which can change via UpsertMetadata.
+func (c *Connector) getSchema(objectName string) (*jsonschema.Schema, bool) {
+	c.schemasMu.RLock()
+	defer c.schemasMu.RUnlock()
+
+	return c.schemas.Get(objectName)
+}
+
+func schemaAsMap(schema *jsonschema.Schema) (map[string]any, error) {
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	var schemaMap map[string]any
+	if err := json.Unmarshal(schemaJSON, &schemaMap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema: %w", err)
+	}
+
+	return schemaMap, nil
+}
+
+func compileSchemaMaps(schemaMaps map[string]map[string]any) (SchemaRegistry, error) {
+	rawSchemas := make(map[string][]byte, len(schemaMaps))
+
+	for objectName, schemaMap := range schemaMaps {
+		rawSchema, err := json.Marshal(schemaMap)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidSchema, objectName, err)
+		}
+
+		rawSchemas[objectName] = rawSchema
+	}
+
+	return ParseSchemas(rawSchemas)
+}
+
+// specialFieldsOf reads the ID and updated timestamp field names the same way as the schemas given on creation.
+func specialFieldsOf(schemaMap map[string]any) (idField, updatedField string, err error) {
+	rawSchema, err := json.Marshal(schemaMap)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	idField, updatedField, _ = extractSpecialFieldsFromRaw(rawSchema)
+
+	return idField, updatedField, nil
+}
+
+func newObjectSchemaMap() map[string]any {
+	return map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type":    typeObject,
+		"properties": map[string]any{
+			defaultIDField: map[string]any{"type": typeString, "x-amp-id-field": true},
+		},
+	}
+}
+
+// applyObjectDefinition sets labels of the object and defines the primary display field.
+func applyObjectDefinition(schemaMap map[string]any, definition common.ObjectDefinition) ([]string, error) {
+	schemaMap["title"] = definition.DisplayName
+
+	if definition.Description != "" {
+		schemaMap["description"] = definition.Description
+	}
+
+	if definition.PrimaryDisplayField.FieldName == "" {
+		return nil, nil
+	}
+
+	primaryField := definition.PrimaryDisplayField
+	if primaryField.ValueType == "" {
+		primaryField.ValueType = common.FieldTypeString
+	}
+
+	// Records are titled by the primary field, so it can't be empty.
+	primaryField.Required = true
+
+	fieldResult, err := applyFieldDefinition(schemaMap, primaryField)
+	if err != nil {
+		return nil, err
+	}
+
+	return fieldResult.Warnings, nil
+}
+
+// applyFieldDefinition replaces the property of the schema with the one described by the definition.
+func applyFieldDefinition(
+	schemaMap map[string]any, definition common.FieldDefinition,
+) (common.FieldUpsertResult, error) {
+	property, warnings, err := newFieldProperty(definition)
+	if err != nil {
+		return common.FieldUpsertResult{}, err
+	}
+
+	properties := schemaProperties(schemaMap)
+
+	action := common.UpsertMetadataActionCreate
+	if _, exists := properties[definition.FieldName]; exists {
+		action = common.UpsertMetadataActionUpdate
+	}
+
+	properties[definition.FieldName] = property
+	setRequired(schemaMap, definition.FieldName, definition.Required)
+
+	return common.FieldUpsertResult{
+		FieldName: definition.FieldName,
+		Action:    action,
+		Metadata:  property,
+		Warnings:  warnings,
+	}, nil
+}
+
+// removeField drops the property from the schema. Unknown fields are reported with the action none.
+func removeField(schemaMap map[string]any, fieldName string) common.FieldUpsertResult {
+	properties := schemaProperties(schemaMap)
+
+	action := common.UpsertMetadataActionNone
+	if _, exists := properties[fieldName]; exists {
+		action = common.UpsertMetadataActionDelete
+	}
+
+	delete(properties, fieldName)
+	setRequired(schemaMap, fieldName, false)
+
+	return common.FieldUpsertResult{
+		FieldName: fieldName,
+		Action:    action,
+	}
+}
+
+//nolint:cyclop // Mapping of every field type to a JSON schema
+func newFieldProperty(definition common.FieldDefinition) (map[string]any, []string, error) {
+	property := make(map[string]any)
+
+	switch definition.ValueType {
+	case common.FieldTypeString:
+		property["type"] = typeString
+	case common.FieldTypeBoolean:
+		property["type"] = typeBoolean
+	case common.FieldTypeDate:
+		property["type"] = typeString
+		property["format"] = "date"
+	case common.FieldTypeDateTime:
+		property["type"] = typeString
+		property["format"] = "date-time"
+	case common.FieldTypeSingleSelect:
+		property["type"] = typeString
+	case common.FieldTypeMultiSelect:
+		property["type"] = typeArray
+		property["items"] = map[string]any{"type": typeString}
+	case common.FieldTypeInt:
+		property["type"] = typeInteger
+	case common.FieldTypeFloat:
+		property["type"] = typeNumber
+	default:
+		return nil, nil, fmt.Errorf("%w, fieldName: %v", common.ErrFieldTypeUnknown, definition.FieldName)
+	}
+
+	if definition.DisplayName != "" {
+		property["title"] = definition.DisplayName
+	}
+
+	if definition.Description != "" {
+		property["description"] = definition.Description
+	}
+
+	applyStringOptions(property, definition)
+	applyNumericOptions(property, definition.NumericOptions)
+
+	var warnings []string
+
+	if definition.Unique {
+		warnings = append(warnings, "unique values are not enforced")
+	}
+
+	if definition.Indexed {
+		warnings = append(warnings, "fields are not indexed")
+	}
+
+	if definition.Association != nil {
+		warnings = append(warnings, "associations can only be defined by the initial schema")
+	}
+
+	return property, warnings, nil
+}
+
+func applyStringOptions(property map[string]any, definition common.FieldDefinition) {
+	options := definition.StringOptions
+	if options == nil {
+		return
+	}
+
+	if len(options.Values) != 0 {
+		values := make([]any, len(options.Values))
+		for index, value := range options.Values {
+			values[index] = value
+		}
+
+		// Multi select holds a list of allowed values.
+		if definition.ValueType == common.FieldTypeMultiSelect {
+			property["items"] = map[string]any{"type": typeString, "enum": values}
+		} else {
+			property["enum"] = values
+		}
+	}
+
+	if property["type"] != typeString {
+		return
+	}
+
+	if options.Length != nil {
+		property["maxLength"] = *options.Length
+	}
+
+	if options.Pattern != "" {
+		property["pattern"] = options.Pattern
+	}
+
+	if options.DefaultValue != nil {
+		property["default"] = *options.DefaultValue
+	}
+}
+
+func applyNumericOptions(property map[string]any, options *common.NumericFieldOptions) {
+	if options == nil || (property["type"] != typeInteger && property["type"] != typeNumber) {
+		return
+	}
+
+	if options.Min != nil {
+		property["minimum"] = *options.Min
+	}
+
+	if options.Max != nil {
+		property["maximum"] = *options.Max
+	}
+
+	if options.DefaultValue != nil {
+		property["default"] = *options.DefaultValue
+	}
+}
+
+func schemaProperties(schemaMap map[string]any) map[string]any {
+	properties, ok := schemaMap["properties"].(map[string]any)
+	if !ok {
+		properties = make(map[string]any)
+		schemaMap["properties"] = properties
+	}
+
+	return properties
+}
+
+// setRequired adds or removes the field from the list of required fields.
+func setRequired(schemaMap map[string]any, fieldName string, required bool) {
+	requiredList, _ := schemaMap["required"].([]any)
+
+	requiredList = slices.DeleteFunc(requiredList, func(value any) bool {
+		return value == fieldName
+	})
+
+	if required {
+		requiredList = append(requiredList, fieldName)
+	}
+
+	if len(requiredList) == 0 {
+		delete(schemaMap, "required")
+
+		return
+	}
+
+	schemaMap["required"] = requiredList
+}
+
+func setFieldResult(result *common.UpsertMetadataResult, objectName string, fieldResult common.FieldUpsertResult) {
+	if result.Fields[objectName] == nil {
+		result.Fields[objectName] = make(map[string]common.FieldUpsertResult)
+	}
+
+	result.Fields[objectName][fieldResult.FieldName] = fieldResult
+}
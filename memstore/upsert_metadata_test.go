@@ -0,0 +1,183 @@
+package memstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertMetadata_CreateObject(t *testing.T) {
+	t.Parallel()
+
+	conn, err := NewConnector(WithSchemas(map[string]*InputSchema{
+		"persons": testPersonSchema,
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	result, err := conn.UpsertMetadata(ctx, &common.UpsertMetadataParams{
+		Objects: map[string]common.ObjectDefinition{
+			"vehicles": {
+				DisplayName: "Vehicle",
+				PrimaryDisplayField: common.FieldDefinition{
+					FieldName:   "plate",
+					DisplayName: "Plate Number",
+				},
+			},
+		},
+		Fields: map[string][]common.FieldDefinition{
+			"vehicles": {{
+				FieldName:     "color",
+				DisplayName:   "Color",
+				ValueType:     common.FieldTypeSingleSelect,
+				StringOptions: &common.StringFieldOptions{Values: []string{"red", "blue"}},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, common.UpsertMetadataActionCreate, result.Objects["vehicles"].Action)
+	assert.Equal(t, common.UpsertMetadataActionCreate, result.Fields["vehicles"]["color"].Action)
+
+	metadata, err := conn.ListObjectMetadata(ctx, []string{"vehicles"})
+	require.NoError(t, err)
+
+	vehicles := metadata.Result["vehicles"]
+	assert.Equal(t, "Vehicle", vehicles.DisplayName)
+	assert.Equal(t, "Plate Number", vehicles.Fields["plate"].DisplayName)
+	assert.Equal(t, common.ValueType(common.ValueTypeSingleSelect), vehicles.Fields["color"].ValueType)
+	assert.Contains(t, vehicles.Fields, "id")
+	assert.Equal(t, "id", conn.storage.GetIdFields()["vehicles"])
+
+	// Records of the new object are validated against the schema.
+	_, err = conn.Write(ctx, common.WriteParams{
+		ObjectName: "vehicles",
+		RecordData: map[string]any{"color": "red"},
+	})
+	require.ErrorIs(t, err, ErrValidationFailed)
+
+	written, err := conn.Write(ctx, common.WriteParams{
+		ObjectName: "vehicles",
+		RecordData: map[string]any{"plate": "AB-123", "color": "blue"},
+	})
+	require.NoError(t, err)
+
+	read, err := conn.Read(ctx, common.ReadParams{
+		ObjectName: "vehicles",
+		Fields:     connectors.Fields("plate"),
+	})
+	require.NoError(t, err)
+	require.Len(t, read.Data, 1)
+	assert.Equal(t, written.RecordId, read.Data[0].Raw["id"])
+}
+
+func TestUpsertMetadata_UpdateAndDeleteFields(t *testing.T) {
+	t.Parallel()
+
+	conn, err := NewConnector(WithSchemas(map[string]*InputSchema{
+		"persons": testPersonSchema,
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	length := 10
+
+	result, err := conn.UpsertMetadata(ctx, &common.UpsertMetadataParams{
+		Fields: map[string][]common.FieldDefinition{
+			"persons": {{
+				FieldName:     "name",
+				DisplayName:   "Full Name",
+				ValueType:     common.FieldTypeString,
+				Required:      true,
+				StringOptions: &common.StringFieldOptions{Length: &length},
+				Unique:        true,
+			}},
+		},
+		DeleteFields: map[string][]string{
+			"persons": {"email", "unknown"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]common.UpsertMetadataAction{
+		"name":    common.UpsertMetadataActionUpdate,
+		"email":   common.UpsertMetadataActionDelete,
+		"unknown": common.UpsertMetadataActionNone,
+	}, fieldActions(result.Fields["persons"]))
+	assert.Equal(t, []string{"unique values are not enforced"}, result.Fields["persons"]["name"].Warnings)
+
+	metadata, err := conn.ListObjectMetadata(ctx, []string{"persons"})
+	require.NoError(t, err)
+
+	persons := metadata.Result["persons"]
+	assert.NotContains(t, persons.Fields, "email")
+	assert.Equal(t, "Full Name", persons.Fields["name"].DisplayName)
+	assert.Equal(t, &length, persons.Fields["name"].MaxLength)
+
+	// Deleted field is no longer required.
+	_, err = conn.Write(ctx, common.WriteParams{
+		ObjectName: "persons",
+		RecordData: map[string]any{"name": "Alice"},
+	})
+	require.NoError(t, err)
+}
+
+func TestUpsertMetadata_DryRun(t *testing.T) {
+	t.Parallel()
+
+	conn, err := NewConnector(WithSchemas(map[string]*InputSchema{
+		"persons": testPersonSchema,
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	result, err := conn.UpsertMetadata(ctx, &common.UpsertMetadataParams{
+		Objects: map[string]common.ObjectDefinition{
+			"vehicles": {DisplayName: "Vehicle"},
+		},
+		DeleteFields: map[string][]string{
+			"persons": {"email"},
+		},
+		DryRun: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, common.UpsertMetadataActionCreate, result.Objects["vehicles"].Action)
+	assert.Equal(t, common.UpsertMetadataActionDelete, result.Fields["persons"]["email"].Action)
+
+	// Nothing was changed.
+	metadata, err := conn.ListObjectMetadata(ctx, []string{"persons", "vehicles"})
+	require.NoError(t, err)
+	assert.Contains(t, metadata.Result["persons"].Fields, "email")
+	require.ErrorIs(t, metadata.Errors["vehicles"], ErrSchemaNotFound)
+}
+
+func TestUpsertMetadata_UnknownObject(t *testing.T) {
+	t.Parallel()
+
+	conn, err := NewConnector(WithSchemas(map[string]*InputSchema{
+		"persons": testPersonSchema,
+	}))
+	require.NoError(t, err)
+
+	_, err = conn.UpsertMetadata(context.Background(), &common.UpsertMetadataParams{
+		Fields: map[string][]common.FieldDefinition{
+			"vehicles": {{FieldName: "plate", ValueType: common.FieldTypeString}},
+		},
+	})
+	require.ErrorIs(t, err, ErrSchemaNotFound)
+}
+
+func fieldActions(fields map[string]common.FieldUpsertResult) map[string]common.UpsertMetadataAction {
+	actions := make(map[string]common.UpsertMetadataAction, len(fields))
+	for name, field := range fields {
+		actions[name] = field.Action
+	}
+
+	return actions
+}
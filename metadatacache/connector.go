@@ -0,0 +1,225 @@
+// Package metadatacache caches object metadata, so that repeated ListObjectMetadata calls
+// don't hit expensive provider endpoints such as Salesforce describe or GraphQL introspection.
+//
+// Snapshots are kept in a pluggable Store, in memory by default, and are considered fresh for the TTL.
+// When a stale snapshot is refreshed the previous and current schemas are compared,
+// letting callers react to schema drift, e.g. warn that a mapped field disappeared.
+// Objects no longer returned by the provider are reported as removed and evicted from the store.
+package metadatacache
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+// DefaultTTL is how long metadata is served from the cache unless configured otherwise.
+const DefaultTTL = 15 * time.Minute
+
+var _ connectors.ObjectMetadataConnector = &Connector{}
+
+// DriftHandler is notified when refreshed metadata differs from the previous snapshot.
+type DriftHandler func(ctx context.Context, objectName string, diff *common.ObjectMetadataDiff)
+
+// Connector decorates connectors.ObjectMetadataConnector with metadata caching.
+// Errors are never cached.
+type Connector struct {
+	connectors.ObjectMetadataConnector
+
+	store     Store
+	ttl       time.Duration
+	namespace string
+	onDrift   DriftHandler
+	now       func() time.Time
+}
+
+type Option func(*Connector)
+
+// WithStore replaces the in-memory store.
+func WithStore(store Store) Option {
+	return func(c *Connector) {
+		c.store = store
+	}
+}
+
+// WithTTL sets how long snapshots are fresh. Zero TTL serves snapshots until invalidated.
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Connector) {
+		c.ttl = ttl
+	}
+}
+
+// WithNamespace scopes the keys, it should identify the connection when the store is shared,
+// as the schema differs between customer instances. Defaults to the provider name.
+func WithNamespace(namespace string) Option {
+	return func(c *Connector) {
+		c.namespace = namespace
+	}
+}
+
+// WithDriftHandler registers a callback invoked with changes found when a snapshot is refreshed.
+func WithDriftHandler(handler DriftHandler) Option {
+	return func(c *Connector) {
+		c.onDrift = handler
+	}
+}
+
+// NewConnector wraps the connector, metadata is cached in memory for DefaultTTL unless configured otherwise.
+func NewConnector(conn connectors.ObjectMetadataConnector, opts ...Option) *Connector {
+	connector := &Connector{
+		ObjectMetadataConnector: conn,
+		store:                   NewMemoryStore(),
+		ttl:                     DefaultTTL,
+		namespace:               conn.Provider(),
+		now:                     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(connector)
+	}
+
+	return connector
+}
+
+// ListObjectMetadata serves fresh snapshots from the store and fetches only the remaining objects.
+func (c *Connector) ListObjectMetadata(
+	ctx context.Context, objectNames []string,
+) (*common.ListObjectMetadataResult, error) {
+	result := common.NewListObjectMetadataResult()
+	previous := make(map[string]*Entry)
+	missing := make([]string, 0, len(objectNames))
+
+	for _, objectName := range objectNames {
+		entry, found, err := c.store.Load(ctx, c.key(objectName))
+		if err != nil {
+			return nil, err
+		}
+
+		if found && c.isFresh(entry) {
+			result.Result[objectName] = entry.Metadata
+
+			continue
+		}
+
+		if found {
+			previous[objectName] = entry
+		}
+
+		missing = append(missing, objectName)
+	}
+
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	fetched, err := c.ObjectMetadataConnector.ListObjectMetadata(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchedAt := c.now()
+
+	for _, objectName := range slices.Sorted(maps.Keys(fetched.Result)) {
+		metadata := fetched.Result[objectName]
+		result.Result[objectName] = metadata
+
+		if err = c.store.Save(ctx, c.key(objectName), &Entry{
+			Metadata:  metadata,
+			FetchedAt: fetchedAt,
+		}); err != nil {
+			return nil, err
+		}
+
+		if entry, ok := previous[objectName]; ok && c.onDrift != nil {
+			if diff := common.DiffObjectMetadata(&entry.Metadata, &metadata); !diff.IsEmpty() {
+				c.onDrift(ctx, objectName, diff)
+			}
+		}
+	}
+
+	// Stale objects missing from the fetch are gone, ex: a custom object was deleted.
+	for _, objectName := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := fetched.Result[objectName]; ok {
+			continue
+		}
+
+		if err = c.store.Delete(ctx, c.key(objectName)); err != nil {
+			return nil, err
+		}
+
+		if c.onDrift != nil {
+			c.onDrift(ctx, objectName, common.DiffObjectMetadata(&previous[objectName].Metadata, nil))
+		}
+	}
+
+	maps.Copy(result.Errors, fetched.Errors)
+
+	return result, nil
+}
+
+// Invalidate forgets cached objects, next ListObjectMetadata fetches them from the provider.
+// Drift is not reported for invalidated objects.
+func (c *Connector) Invalidate(ctx context.Context, objectNames ...string) error {
+	for _, objectName := range objectNames {
+		if err := c.store.Delete(ctx, c.key(objectName)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Connector) isFresh(entry *Entry) bool {
+	return c.ttl == 0 || c.now().Sub(entry.FetchedAt) < c.ttl
+}
+
+func (c *Connector) key(objectName string) string {
+	return fmt.Sprintf("%s:%s", c.namespace, objectName)
+}
+
+// cloneObjectMetadata copies maps and slices, so that callers can't alter cached snapshots.
+func cloneObjectMetadata(metadata common.ObjectMetadata) common.ObjectMetadata {
+	clone := common.ObjectMetadata{
+		DisplayName: metadata.DisplayName,
+		FieldsMap:   maps.Clone(metadata.FieldsMap),
+	}
+
+	if metadata.Fields != nil {
+		clone.Fields = make(common.FieldsMetadata, len(metadata.Fields))
+
+		for name, field := range metadata.Fields {
+			clone.Fields[name] = cloneFieldMetadata(field)
+		}
+	}
+
+	return clone
+}
+
+// cloneFieldMetadata copies slices and the values behind pointers.
+func cloneFieldMetadata(field common.FieldMetadata) common.FieldMetadata {
+	field.ReadOnly = clonePointer(field.ReadOnly)
+	field.IsCustom = clonePointer(field.IsCustom)
+	field.IsRequired = clonePointer(field.IsRequired)
+	field.Values = slices.Clone(field.Values)
+	field.ReferenceTo = slices.Clone(field.ReferenceTo)
+	field.MaxLength = clonePointer(field.MaxLength)
+	field.Precision = clonePointer(field.Precision)
+	field.Scale = clonePointer(field.Scale)
+
+	return field
+}
+
+func clonePointer[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+
+	clone := *value
+
+	return &clone
+}
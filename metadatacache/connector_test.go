@@ -0,0 +1,212 @@
+package metadatacache
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/mock"
+	"gotest.tools/v3/assert"
+)
+
+var errObjectUnknown = errors.New("object unknown")
+
+func TestListObjectMetadataIsCached(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	var requested [][]string
+
+	version := 1
+
+	conn, err := mock.NewConnector(
+		mock.WithListObjectMetadata(func(_ context.Context, objectNames []string) (*common.ListObjectMetadataResult, error) {
+			requested = append(requested, slices.Clone(objectNames))
+
+			result := common.NewListObjectMetadataResult()
+
+			for _, objectName := range objectNames {
+				if objectName == "unknown" {
+					result.AppendError(objectName, errObjectUnknown)
+
+					continue
+				}
+
+				result.Result[objectName] = *contactMetadata(version)
+			}
+
+			return result, nil
+		}),
+	)
+	assert.NilError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var drift []*common.ObjectMetadataDiff
+
+	cached := NewConnector(conn,
+		WithTTL(time.Hour),
+		WithDriftHandler(func(_ context.Context, objectName string, diff *common.ObjectMetadataDiff) {
+			assert.Equal(t, objectName, "contacts")
+
+			drift = append(drift, diff)
+		}),
+	)
+	cached.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	result, err := cached.ListObjectMetadata(ctx, []string{"contacts", "unknown"})
+	assert.NilError(t, err)
+	assert.ErrorIs(t, result.Errors["unknown"], errObjectUnknown)
+
+	// Cached snapshot can't be altered through the result.
+	result.Result["contacts"].Fields["owner"].ReferenceTo[0] = "changed"
+	*result.Result["contacts"].Fields["email"].MaxLength = 1
+	result.Result["contacts"].Fields["email"] = common.FieldMetadata{DisplayName: "changed"}
+
+	result, err = cached.ListObjectMetadata(ctx, []string{"contacts", "unknown"})
+	assert.NilError(t, err)
+	assert.Equal(t, result.Result["contacts"].Fields["email"].DisplayName, "Email")
+	assert.Equal(t, *result.Result["contacts"].Fields["email"].MaxLength, 80)
+	assert.DeepEqual(t, result.Result["contacts"].Fields["owner"].ReferenceTo, []string{"users"})
+	assert.DeepEqual(t, requested, [][]string{{"contacts", "unknown"}, {"unknown"}})
+
+	// Snapshot expires and the schema has changed since.
+	now = now.Add(2 * time.Hour)
+	version = 2
+
+	result, err = cached.ListObjectMetadata(ctx, []string{"contacts"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(requested), 3)
+	assert.Equal(t, result.Result["contacts"].Fields["age"].ValueType, common.ValueType(common.ValueTypeFloat))
+	assert.DeepEqual(t, drift, []*common.ObjectMetadataDiff{{
+		AddedFields:   []string{"phone"},
+		RemovedFields: []string{"name"},
+		ChangedFields: []common.FieldMetadataChange{{
+			FieldName:            "age",
+			PreviousValueType:    common.ValueTypeInt,
+			CurrentValueType:     common.ValueTypeFloat,
+			PreviousProviderType: "number",
+			CurrentProviderType:  "number",
+		}, {
+			FieldName:         "email",
+			PreviousMaxLength: intPointer(80),
+			CurrentMaxLength:  intPointer(255),
+		}, {
+			FieldName:         "owner",
+			AddedReferences:   []string{"queues"},
+			RemovedReferences: nil,
+		}, {
+			FieldName:     "stage",
+			AddedValues:   []string{"customer"},
+			RemovedValues: []string{"subscriber"},
+		}},
+	}})
+
+	assert.NilError(t, cached.Invalidate(ctx, "contacts"))
+
+	_, err = cached.ListObjectMetadata(ctx, []string{"contacts"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(requested), 4)
+	assert.Equal(t, len(drift), 1)
+}
+
+func TestRemovedObjectIsEvicted(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+
+	conn, err := mock.NewConnector(
+		mock.WithListObjectMetadata(func(_ context.Context, objectNames []string) (*common.ListObjectMetadataResult, error) {
+			requests++
+
+			result := common.NewListObjectMetadataResult()
+
+			if requests == 1 {
+				result.Result["contacts"] = *contactMetadata(1)
+			} else {
+				result.AppendError("contacts", errObjectUnknown)
+			}
+
+			return result, nil
+		}),
+	)
+	assert.NilError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var drift []*common.ObjectMetadataDiff
+
+	cached := NewConnector(conn,
+		WithTTL(time.Hour),
+		WithDriftHandler(func(_ context.Context, _ string, diff *common.ObjectMetadataDiff) {
+			drift = append(drift, diff)
+		}),
+	)
+	cached.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	_, err = cached.ListObjectMetadata(ctx, []string{"contacts"})
+	assert.NilError(t, err)
+
+	now = now.Add(2 * time.Hour)
+
+	result, err := cached.ListObjectMetadata(ctx, []string{"contacts"})
+	assert.NilError(t, err)
+	assert.ErrorIs(t, result.Errors["contacts"], errObjectUnknown)
+	assert.DeepEqual(t, drift, []*common.ObjectMetadataDiff{{
+		ObjectRemoved: true,
+		RemovedFields: []string{"age", "email", "name", "owner", "stage"},
+	}})
+
+	// Removed object is no longer cached, removal is reported once.
+	_, err = cached.ListObjectMetadata(ctx, []string{"contacts"})
+	assert.NilError(t, err)
+	assert.Equal(t, requests, 3)
+	assert.Equal(t, len(drift), 1)
+}
+
+func contactMetadata(version int) *common.ObjectMetadata {
+	fields := common.FieldsMetadata{
+		"email": {DisplayName: "Email", ValueType: common.ValueTypeString, ProviderType: "string", MaxLength: intPointer(80)},
+		"age":   {DisplayName: "Age", ValueType: common.ValueTypeInt, ProviderType: "number"},
+		"name":  {DisplayName: "Name", ValueType: common.ValueTypeString, ProviderType: "string"},
+		"owner": {
+			DisplayName: "Owner",
+			ValueType:   common.ValueTypeReference,
+			ReferenceTo: []string{"users"},
+		},
+		"stage": {
+			DisplayName:  "Stage",
+			ValueType:    common.ValueTypeSingleSelect,
+			ProviderType: "enumeration",
+			Values:       []common.FieldValue{{Value: "lead"}, {Value: "subscriber"}},
+		},
+	}
+
+	if version > 1 {
+		delete(fields, "name")
+		fields["email"] = common.FieldMetadata{DisplayName: "Email", ValueType: common.ValueTypeString,
+			ProviderType: "string", MaxLength: intPointer(255)}
+		fields["owner"] = common.FieldMetadata{DisplayName: "Owner", ValueType: common.ValueTypeReference,
+			ReferenceTo: []string{"users", "queues"}}
+		fields["phone"] = common.FieldMetadata{DisplayName: "Phone", ValueType: common.ValueTypeString}
+		fields["age"] = common.FieldMetadata{DisplayName: "Age", ValueType: common.ValueTypeFloat, ProviderType: "number"}
+		fields["stage"] = common.FieldMetadata{
+			DisplayName:  "Lifecycle stage",
+			ValueType:    common.ValueTypeSingleSelect,
+			ProviderType: "enumeration",
+			Values:       []common.FieldValue{{Value: "lead"}, {Value: "customer"}},
+		}
+	}
+
+	return common.NewObjectMetadata("Contacts", fields)
+}
+
+func intPointer(value int) *int {
+	return &value
+}
@@ -0,0 +1,65 @@
+package metadatacache
+
+import (
+	"context"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// Entry is the object metadata snapshot together with the time it was fetched from the provider.
+type Entry struct {
+	Metadata  common.ObjectMetadata
+	FetchedAt time.Time
+}
+
+// Store keeps metadata snapshots. Entries are not expired by the store,
+// the Connector decides whether an entry is fresh, stale entries are used to detect schema drift.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the entry saved under the key, found is false if the key is unknown.
+	Load(ctx context.Context, key string) (entry *Entry, found bool, err error)
+	// Save replaces the entry under the key.
+	Save(ctx context.Context, key string, entry *Entry) error
+	// Delete forgets the key, unknown keys are ignored.
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryStore is a process local Store.
+type MemoryStore struct {
+	entries *datautils.Cache[string, Entry]
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: datautils.NewCache[string, Entry](),
+	}
+}
+
+func (s *MemoryStore) Load(_ context.Context, key string) (*Entry, bool, error) {
+	entry, ok := s.entries.Get(key)
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry.Metadata = cloneObjectMetadata(entry.Metadata)
+
+	return &entry, true, nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, key string, entry *Entry) error {
+	s.entries.Set(key, Entry{
+		Metadata:  cloneObjectMetadata(entry.Metadata),
+		FetchedAt: entry.FetchedAt,
+	})
+
+	return nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.entries.Delete(key)
+
+	return nil
+}
@@ -0,0 +1,145 @@
+// Package normalization coerces values of ReadResultRow.Fields to consistent types,
+// so that downstream pipelines don't depend on how each provider encodes values.
+//
+// The type of every field comes from ObjectMetadata.Fields[...].ValueType:
+//   - datetime values become RFC3339 strings in UTC, epoch seconds and milliseconds are accepted,
+//   - date values become "2006-01-02" strings,
+//   - numeric strings of int, float and currency fields become int64 and float64,
+//   - boolean strings and 0/1 numbers become bool,
+//   - multiSelect strings such as "a;b;c" become arrays.
+//
+// Values that cannot be coerced and fields without metadata are passed through unchanged.
+// ReadResultRow.Raw is never modified, it keeps the provider response as is.
+package normalization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+// DefaultMultiSelectSeparator joins multiSelect values, it is used by Salesforce and HubSpot.
+const DefaultMultiSelectSeparator = ";"
+
+var _ connectors.ReadConnector = &Connector{}
+
+var ErrMetadataUnavailable = errors.New("object metadata is unavailable for normalization")
+
+// Connector decorates connectors.ReadConnector with value normalization.
+type Connector struct {
+	connectors.ReadConnector
+
+	metadata  connectors.ObjectMetadataConnector
+	separator string
+	location  *time.Location
+}
+
+type Option func(*Connector)
+
+// WithMultiSelectSeparator changes the delimiter of multiSelect values.
+func WithMultiSelectSeparator(separator string) Option {
+	return func(c *Connector) {
+		c.separator = separator
+	}
+}
+
+// WithLocation sets the time zone of date-times that don't specify one, such as "2006-01-02 15:04:05".
+// Defaults to UTC.
+func WithLocation(location *time.Location) Option {
+	return func(c *Connector) {
+		c.location = location
+	}
+}
+
+// NewConnector wraps the connector, field types are described by the metadata connector.
+// Metadata is requested for every Read, wrap it with metadatacache.Connector to avoid
+// describing the object for each page.
+func NewConnector(
+	conn connectors.ReadConnector, metadata connectors.ObjectMetadataConnector, opts ...Option,
+) *Connector {
+	connector := &Connector{
+		ReadConnector: conn,
+		metadata:      metadata,
+		separator:     DefaultMultiSelectSeparator,
+		location:      time.UTC,
+	}
+
+	for _, opt := range opts {
+		opt(connector)
+	}
+
+	return connector
+}
+
+// Read reads a page and normalizes fields of every row. Rows are modified in place.
+func (c *Connector) Read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+	result, err := c.ReadConnector.Read(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Data) == 0 {
+		return result, nil
+	}
+
+	fields, err := c.fieldsMetadata(ctx, params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range result.Data {
+		c.normalizeRow(&result.Data[index], fields)
+	}
+
+	return result, nil
+}
+
+// fieldsMetadata returns fields of the object keyed by lowercase name, matching ReadResultRow.Fields.
+func (c *Connector) fieldsMetadata(ctx context.Context, objectName string) (common.FieldsMetadata, error) {
+	metadata, err := c.metadata.ListObjectMetadata(ctx, []string{objectName})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = metadata.Errors[objectName]; err != nil {
+		return nil, errors.Join(ErrMetadataUnavailable, err)
+	}
+
+	object, ok := metadata.Result[objectName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMetadataUnavailable, objectName)
+	}
+
+	fields := make(common.FieldsMetadata, len(object.Fields))
+	for name, field := range object.Fields {
+		fields[strings.ToLower(name)] = field
+	}
+
+	return fields, nil
+}
+
+func (c *Connector) normalizeRow(row *common.ReadResultRow, fields common.FieldsMetadata) {
+	if len(row.Fields) == 0 {
+		return
+	}
+
+	// Fields may share the map with Raw, the copy keeps provider values intact.
+	normalized := maps.Clone(row.Fields)
+
+	for name, value := range row.Fields {
+		field, ok := fields[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+
+		normalized[name] = c.normalizeValue(value, field.ValueType)
+	}
+
+	row.Fields = normalized
+}
@@ -0,0 +1,175 @@
+package normalization
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/mock"
+	"gotest.tools/v3/assert"
+)
+
+var errDescribeFailed = errors.New("describe failed")
+
+func TestReadIsNormalized(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	raw := map[string]any{
+		"CreatedAt":   float64(1704164645000),
+		"updated_at":  "2024-01-02 03:04:05",
+		"ClosedAt":    "2024-01-02T03:04:05.000+0100",
+		"birthday":    "2024-01-02T00:00:00Z",
+		"employees":   "42",
+		"revenue":     "1234.5",
+		"active":      "true",
+		"archived":    float64(0),
+		"interests":   "golf; tennis;;chess",
+		"nickname":    "12",
+		"unmodeled":   "true",
+		"invalidDate": "someday",
+		"missing":     nil,
+	}
+
+	conn, err := mock.NewConnector(
+		mock.WithRead(func(context.Context, common.ReadParams) (*common.ReadResult, error) {
+			return &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					// Providers without field selection reuse the raw map.
+					Fields: raw,
+					Raw:    raw,
+				}},
+				Done: true,
+			}, nil
+		}),
+		mock.WithListObjectMetadata(func(_ context.Context, objectNames []string) (*common.ListObjectMetadataResult, error) {
+			assert.DeepEqual(t, objectNames, []string{"contacts"})
+
+			result := common.NewListObjectMetadataResult()
+			result.Result["contacts"] = *common.NewObjectMetadata("Contacts", common.FieldsMetadata{
+				"createdat":   {ValueType: common.ValueTypeDateTime},
+				"UPDATED_AT":  {ValueType: common.ValueTypeDateTime},
+				"closedat":    {ValueType: common.ValueTypeDateTime},
+				"birthday":    {ValueType: common.ValueTypeDate},
+				"employees":   {ValueType: common.ValueTypeInt},
+				"revenue":     {ValueType: common.ValueTypeCurrency},
+				"active":      {ValueType: common.ValueTypeBoolean},
+				"archived":    {ValueType: common.ValueTypeBoolean},
+				"interests":   {ValueType: common.ValueTypeMultiSelect},
+				"nickname":    {ValueType: common.ValueTypeString},
+				"invaliddate": {ValueType: common.ValueTypeDateTime},
+				"missing":     {ValueType: common.ValueTypeInt},
+			})
+
+			return result, nil
+		}),
+	)
+	assert.NilError(t, err)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NilError(t, err)
+
+	normalized := NewConnector(conn, conn, WithLocation(berlin))
+
+	result, err := normalized.Read(t.Context(), common.ReadParams{ObjectName: "contacts", Fields: connectors.Fields("id")})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result.Data[0].Fields, map[string]any{
+		"CreatedAt":   "2024-01-02T03:04:05Z",
+		"updated_at":  "2024-01-02T02:04:05Z",
+		"ClosedAt":    "2024-01-02T02:04:05Z",
+		"birthday":    "2024-01-02",
+		"employees":   int64(42),
+		"revenue":     1234.5,
+		"active":      true,
+		"archived":    false,
+		"interests":   []any{"golf", "tennis", "chess"},
+		"nickname":    "12",
+		"unmodeled":   "true",
+		"invalidDate": "someday",
+		"missing":     nil,
+	})
+
+	// Provider values are kept.
+	assert.Equal(t, result.Data[0].Raw["employees"], "42")
+	assert.Equal(t, result.Data[0].Raw["CreatedAt"], float64(1704164645000))
+}
+
+func TestReadFailsWithoutMetadata(t *testing.T) {
+	t.Parallel()
+
+	conn, err := mock.NewConnector(
+		mock.WithRead(func(context.Context, common.ReadParams) (*common.ReadResult, error) {
+			return &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{Fields: map[string]any{"id": "1"}}},
+			}, nil
+		}),
+		mock.WithListObjectMetadata(func(_ context.Context, objectNames []string) (*common.ListObjectMetadataResult, error) {
+			result := common.NewListObjectMetadataResult()
+			result.AppendError(objectNames[0], errDescribeFailed)
+
+			return result, nil
+		}),
+	)
+	assert.NilError(t, err)
+
+	_, err = NewConnector(conn, conn).Read(t.Context(), common.ReadParams{
+		ObjectName: "contacts",
+		Fields:     connectors.Fields("id"),
+	})
+	assert.ErrorIs(t, err, ErrMetadataUnavailable)
+	assert.ErrorIs(t, err, errDescribeFailed)
+}
+
+func TestNormalizeValue(t *testing.T) {
+	t.Parallel()
+
+	conn := NewConnector(nil, nil, WithMultiSelectSeparator(","))
+
+	tests := []struct {
+		name      string
+		value     any
+		valueType common.ValueType
+		expected  any
+	}{
+		{name: "Epoch seconds", value: "1704164645", valueType: common.ValueTypeDateTime, expected: "2024-01-02T03:04:05Z"},
+		{name: "Integral float", value: float64(7), valueType: common.ValueTypeInt, expected: int64(7)},
+		{name: "Decimal string integer", value: "7.0", valueType: common.ValueTypeInt, expected: int64(7)},
+		{name: "Fraction is not an integer", value: "7.5", valueType: common.ValueTypeInt, expected: "7.5"},
+		{name: "Boolean out of range", value: float64(2), valueType: common.ValueTypeBoolean, expected: float64(2)},
+		{name: "Custom separator", value: "a,b", valueType: common.ValueTypeMultiSelect, expected: []any{"a", "b"}},
+		{name: "Empty multiSelect", value: "", valueType: common.ValueTypeMultiSelect, expected: []any{}},
+		{name: "Array is kept", value: []any{"a"}, valueType: common.ValueTypeMultiSelect, expected: []any{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.DeepEqual(t, conn.normalizeValue(tt.value, tt.valueType), tt.expected)
+		})
+	}
+}
+
+// Epochs are converted in UTC regardless of the time zone of the process.
+// nolint:paralleltest // The test changes the local time zone of the process.
+func TestNormalizeEpochInLocalTimeZone(t *testing.T) {
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	assert.NilError(t, err)
+
+	local := time.Local
+	time.Local = losAngeles
+
+	t.Cleanup(func() {
+		time.Local = local
+	})
+
+	conn := NewConnector(nil, nil)
+
+	// Midnight of January 2nd in UTC is still January 1st in Los Angeles.
+	assert.Equal(t, conn.normalizeValue(float64(1704153600), common.ValueTypeDate), "2024-01-02")
+	assert.Equal(t, conn.normalizeValue(float64(1704153600000), common.ValueTypeDate), "2024-01-02")
+}
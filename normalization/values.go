@@ -0,0 +1,185 @@
+package normalization
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+const dateLayout = time.DateOnly
+
+// epochMillisThreshold separates epoch seconds from milliseconds.
+// In seconds, it is the year 5138, in milliseconds, it is March 1973.
+const epochMillisThreshold = 1e11
+
+// Layouts of date-times without a time zone, they are interpreted in the configured location.
+var localLayouts = []string{ // nolint:gochecknoglobals
+	time.DateTime,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+// Layouts of date-times with a time zone.
+var zonedLayouts = []string{ // nolint:gochecknoglobals
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700", // Salesforce: 2024-01-02T15:04:05.000+0000
+	"2006-01-02 15:04:05Z07:00",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+func (c *Connector) normalizeValue(value any, valueType common.ValueType) any {
+	if value == nil {
+		return nil
+	}
+
+	var (
+		result any
+		ok     bool
+	)
+
+	switch valueType.BaseType() {
+	case common.ValueTypeDateTime:
+		var moment time.Time
+		if moment, ok = c.parseTime(value); ok {
+			result = moment.UTC().Format(time.RFC3339)
+		}
+	case common.ValueTypeDate:
+		var moment time.Time
+		if moment, ok = c.parseTime(value); ok {
+			result = moment.Format(dateLayout)
+		}
+	case common.ValueTypeInt:
+		result, ok = toInt(value)
+	case common.ValueTypeFloat:
+		result, ok = toFloat(value)
+	case common.ValueTypeBoolean:
+		result, ok = toBool(value)
+	case common.ValueTypeMultiSelect:
+		result, ok = c.toList(value)
+	}
+
+	if !ok {
+		return value
+	}
+
+	return result
+}
+
+func (c *Connector) parseTime(value any) (time.Time, bool) {
+	if text, ok := value.(string); ok {
+		text = strings.TrimSpace(text)
+
+		for _, layout := range zonedLayouts {
+			if moment, err := time.Parse(layout, text); err == nil {
+				return moment, true
+			}
+		}
+
+		for _, layout := range localLayouts {
+			if moment, err := time.ParseInLocation(layout, text, c.location); err == nil {
+				return moment, true
+			}
+		}
+	}
+
+	epoch, ok := toFloat(value)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	if math.Abs(epoch) < epochMillisThreshold {
+		return time.Unix(int64(epoch), 0).UTC(), true
+	}
+
+	return time.UnixMilli(int64(epoch)).UTC(), true
+}
+
+func toInt(value any) (int64, bool) {
+	switch number := value.(type) {
+	case int:
+		return int64(number), true
+	case int64:
+		return number, true
+	case float64:
+		if number != math.Trunc(number) {
+			return 0, false
+		}
+
+		return int64(number), true
+	case json.Number:
+		return toInt(number.String())
+	case string:
+		text := strings.TrimSpace(number)
+
+		if result, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return result, true
+		}
+
+		// Integers are often formatted as decimals, e.g. "42.0".
+		if result, err := strconv.ParseFloat(text, 64); err == nil {
+			return toInt(result)
+		}
+	}
+
+	return 0, false
+}
+
+func toFloat(value any) (float64, bool) {
+	switch number := value.(type) {
+	case float64:
+		return number, true
+	case int:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case json.Number:
+		return toFloat(number.String())
+	case string:
+		result, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+
+		return result, err == nil
+	}
+
+	return 0, false
+}
+
+func toBool(value any) (bool, bool) {
+	switch flag := value.(type) {
+	case bool:
+		return flag, true
+	case string:
+		result, err := strconv.ParseBool(strings.TrimSpace(flag))
+
+		return result, err == nil
+	}
+
+	number, ok := toFloat(value)
+	if !ok || (number != 0 && number != 1) {
+		return false, false
+	}
+
+	return number == 1, true
+}
+
+func (c *Connector) toList(value any) ([]any, bool) {
+	text, ok := value.(string)
+	if !ok {
+		return nil, false
+	}
+
+	result := make([]any, 0)
+
+	for item := range strings.SplitSeq(text, c.separator) {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return result, true
+}
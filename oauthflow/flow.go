@@ -0,0 +1,272 @@
+// Package oauthflow runs the OAuth2 authorization code grant for any provider in the catalog.
+//
+// The flow is driven by the provider's Oauth2Opts: the authorization URL carries AuthURLParams,
+// scopes are translated with ScopeMappings, PKCE is used for the authorizationCodePKCE grant,
+// and TokenMetadataFields are extracted from the token response once the code is exchanged.
+//
+// A typical usage spans two requests:
+//
+//	flow, err := oauthflow.New(oauthflow.Params{...})
+//	auth, err := flow.Authorize()
+//	// persist auth.State and auth.CodeVerifier, redirect the user to auth.URL
+//	...
+//	result, err := flow.ExchangeCallback(ctx, auth, callbackRequest.URL.Query())
+package oauthflow
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/amp-labs/connectors/common/paramsbuilder"
+	"github.com/amp-labs/connectors/common/substitutions"
+	"github.com/amp-labs/connectors/internal/goutils"
+	"github.com/amp-labs/connectors/providers"
+	"golang.org/x/oauth2"
+)
+
+const stateBytes = 32
+
+var (
+	ErrNotOAuth2             = errors.New("provider doesn't use OAuth2")
+	ErrUnsupportedGrantType  = errors.New("grant type doesn't use authorization code")
+	ErrMissingClientId       = errors.New("missing client id")
+	ErrMissingRedirectURL    = errors.New("missing redirect url")
+	ErrStateMismatch         = errors.New("state doesn't match the authorization request")
+	ErrMissingCode           = errors.New("missing authorization code")
+	ErrAuthorizationDenied   = errors.New("authorization was denied")
+	ErrInvalidMetadataFields = errors.New("invalid token metadata fields")
+)
+
+// Params configures the authorization code flow.
+type Params struct {
+	// Provider is read from the catalog, unless ProviderInfo is given.
+	Provider providers.Provider
+	// ProviderInfo overrides the catalog entry, e.g. for custom catalogs.
+	ProviderInfo *providers.ProviderInfo
+	// Metadata holds catalog variables, e.g. "workspace", substituted into URLs and scope mappings.
+	Metadata map[string]string
+
+	ClientId     string // required
+	ClientSecret string
+	RedirectURL  string // required
+	// Scopes are translated with Oauth2Opts.ScopeMappings, unknown scopes are passed through.
+	Scopes []string
+	// PKCE forces code challenge for providers with plain authorizationCode grant.
+	PKCE bool
+}
+
+// Flow holds the OAuth2 configuration resolved for a provider.
+type Flow struct {
+	info   *providers.ProviderInfo
+	config *oauth2.Config
+	pkce   bool
+}
+
+// Authorization describes a started authorization.
+// State and CodeVerifier must be kept until the callback is received.
+type Authorization struct {
+	// URL is where the user is redirected to grant the access.
+	URL string
+	// State is an opaque value echoed back by the provider in the callback.
+	State string
+	// CodeVerifier is the PKCE secret, empty when PKCE is not used.
+	CodeVerifier string
+}
+
+// Result is the outcome of the code exchange.
+type Result struct {
+	Token    *oauth2.Token
+	Metadata *TokenMetadata
+}
+
+// New resolves the provider info, substituting catalog variables, and prepares the OAuth2 config.
+func New(params Params) (*Flow, error) {
+	if params.ClientId == "" {
+		return nil, ErrMissingClientId
+	}
+
+	if params.RedirectURL == "" {
+		return nil, ErrMissingRedirectURL
+	}
+
+	info, err := resolveProviderInfo(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.AuthType != providers.Oauth2 || info.Oauth2Opts == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotOAuth2, info.Name)
+	}
+
+	opts := info.Oauth2Opts
+
+	switch opts.GrantType {
+	case providers.AuthorizationCode, providers.AuthorizationCodePKCE:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedGrantType, opts.GrantType)
+	}
+
+	return &Flow{
+		info: info,
+		config: &oauth2.Config{
+			ClientID:     params.ClientId,
+			ClientSecret: params.ClientSecret,
+			RedirectURL:  params.RedirectURL,
+			Scopes:       MapScopes(opts.ScopeMappings, params.Scopes),
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   opts.AuthURL,
+				TokenURL:  opts.TokenURL,
+				AuthStyle: oauth2.AuthStyleAutoDetect,
+			},
+		},
+		pkce: params.PKCE || opts.GrantType == providers.AuthorizationCodePKCE,
+	}, nil
+}
+
+// ProviderInfo returns the provider info with catalog variables substituted.
+func (f *Flow) ProviderInfo() *providers.ProviderInfo {
+	return f.info
+}
+
+// Config returns the OAuth2 config, it can be used to refresh tokens.
+func (f *Flow) Config() *oauth2.Config {
+	return f.config
+}
+
+// Authorize creates a random state, and the PKCE verifier if needed, and builds the authorization URL.
+func (f *Flow) Authorize() (*Authorization, error) {
+	state, err := randomState()
+	if err != nil {
+		return nil, err
+	}
+
+	return f.AuthorizeWithState(state), nil
+}
+
+// AuthorizeWithState builds the authorization URL for the given state.
+func (f *Flow) AuthorizeWithState(state string) *Authorization {
+	options := make([]oauth2.AuthCodeOption, 0, len(f.info.Oauth2Opts.AuthURLParams)+1)
+
+	for key, value := range f.info.Oauth2Opts.AuthURLParams {
+		options = append(options, oauth2.SetAuthURLParam(key, value))
+	}
+
+	auth := &Authorization{
+		State: state,
+	}
+
+	if f.pkce {
+		// Reference: https://www.rfc-editor.org/rfc/rfc7636#section-4.3
+		auth.CodeVerifier = oauth2.GenerateVerifier()
+		options = append(options, oauth2.S256ChallengeOption(auth.CodeVerifier))
+	}
+
+	auth.URL = f.config.AuthCodeURL(state, options...)
+
+	return auth
+}
+
+// ExchangeCallback validates the query of the redirect back from the provider and exchanges the code.
+func (f *Flow) ExchangeCallback(ctx context.Context, auth *Authorization, query url.Values) (*Result, error) {
+	if reason := query.Get("error"); reason != "" {
+		if description := query.Get("error_description"); description != "" {
+			reason += ": " + description
+		}
+
+		return nil, fmt.Errorf("%w: %s", ErrAuthorizationDenied, reason)
+	}
+
+	if query.Get("state") != auth.State {
+		return nil, ErrStateMismatch
+	}
+
+	return f.Exchange(ctx, auth, query.Get("code"))
+}
+
+// Exchange trades the code for the token and extracts the token metadata.
+// The HTTP client can be supplied via context under oauth2.HTTPClient key.
+func (f *Flow) Exchange(ctx context.Context, auth *Authorization, code string) (*Result, error) {
+	if code == "" {
+		return nil, ErrMissingCode
+	}
+
+	var options []oauth2.AuthCodeOption
+
+	if auth != nil && auth.CodeVerifier != "" {
+		// Reference: https://www.rfc-editor.org/rfc/rfc7636#section-4.5
+		options = append(options, oauth2.VerifierOption(auth.CodeVerifier))
+	}
+
+	token, err := f.config.Exchange(ctx, code, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := ExtractTokenMetadata(token, f.info.Oauth2Opts.TokenMetadataFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Token:    token,
+		Metadata: metadata,
+	}, nil
+}
+
+// MapScopes translates scopes using the provider's scope mappings.
+// Scopes without mapping are passed through unchanged.
+func MapScopes(mappings map[string]string, scopes []string) []string {
+	result := make([]string, 0, len(scopes))
+
+	for _, scope := range scopes {
+		if scope == "" {
+			continue
+		}
+
+		if mapped, ok := mappings[scope]; ok {
+			scope = mapped
+		}
+
+		result = append(result, scope)
+	}
+
+	return result
+}
+
+// resolveProviderInfo works on a copy, so that the substitution doesn't alter the catalog.
+func resolveProviderInfo(params Params) (*providers.ProviderInfo, error) {
+	source := params.ProviderInfo
+	if source == nil {
+		info, err := providers.ReadInfo(params.Provider)
+		if err != nil {
+			return nil, err
+		}
+
+		source = info
+	}
+
+	info, err := goutils.Clone[providers.ProviderInfo](*source)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := paramsbuilder.NewCatalogVariables(substitutions.Registry[string](params.Metadata))
+	if err = info.SubstituteWith(vars); err != nil {
+		return nil, fmt.Errorf("failed to substitute catalog variables: %w", err)
+	}
+
+	return &info, nil
+}
+
+func randomState() (string, error) {
+	data := make([]byte, stateBytes)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,192 @@
+package oauthflow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/amp-labs/connectors/providers"
+	"gotest.tools/v3/assert"
+)
+
+func newAuthorizationServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if err := request.ParseForm(); err != nil {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		verifier := request.PostForm.Get("code_verifier")
+		if request.URL.Path != "/acme/token" || request.PostForm.Get("code") != "the-code" || verifier == "" {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		// Echo the challenge computed from the verifier, test checks it against the authorization URL.
+		challenge := sha256.Sum256([]byte(verifier))
+
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{
+			"access_token": "access",
+			"refresh_token": "refresh",
+			"token_type": "Bearer",
+			"expires_in": 3600,
+			"scope": "contacts.read offline",
+			"challenge": "` + base64.RawURLEncoding.EncodeToString(challenge[:]) + `",
+			"data": {"user": {"id": 42}},
+			"instance_url": "https://acme.example.com",
+			"api_domain": "https://api-eu.example.com/v2"
+		}`))
+	}))
+}
+
+func testProviderInfo(serverURL string) *providers.ProviderInfo {
+	return &providers.ProviderInfo{
+		Name:     "acme",
+		AuthType: providers.Oauth2,
+		BaseURL:  "https://{{.workspace}}.example.com",
+		Oauth2Opts: &providers.Oauth2Opts{
+			GrantType:     providers.AuthorizationCodePKCE,
+			AuthURL:       serverURL + "/{{.workspace}}/authorize",
+			TokenURL:      serverURL + "/{{.workspace}}/token",
+			AuthURLParams: map[string]string{"access_type": "offline"},
+			ScopeMappings: map[string]string{
+				"read": "https://{{.workspace}}.example.com/contacts.read",
+			},
+			TokenMetadataFields: providers.TokenMetadataFields{
+				ConsumerRefField:  "data.user.id",
+				WorkspaceRefField: "instance_url",
+				ScopesField:       "scope",
+				OtherFields: &providers.TokenMetadataFieldsOtherFields{{
+					Name:        "region",
+					DisplayName: "Region",
+					Path:        "api_domain",
+					Capture:     `api-(?P<result>[a-z]+)\.`,
+				}, {
+					Name:        "challenge",
+					DisplayName: "Challenge",
+					Path:        "challenge",
+				}},
+			},
+		},
+	}
+}
+
+func TestFlow(t *testing.T) {
+	t.Parallel()
+
+	server := newAuthorizationServer(t)
+	defer server.Close()
+
+	info := testProviderInfo(server.URL)
+
+	flow, err := New(Params{
+		ProviderInfo: info,
+		Metadata:     map[string]string{"workspace": "acme"},
+		ClientId:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://app.example.com/callback",
+		Scopes:       []string{"read", "offline"},
+	})
+	assert.NilError(t, err)
+
+	// Catalog entry passed in stays intact.
+	assert.Equal(t, info.Oauth2Opts.TokenURL, server.URL+"/{{.workspace}}/token")
+
+	auth, err := flow.Authorize()
+	assert.NilError(t, err)
+	assert.Assert(t, auth.State != "")
+	assert.Assert(t, auth.CodeVerifier != "")
+
+	authURL, err := url.Parse(auth.URL)
+	assert.NilError(t, err)
+	assert.Equal(t, authURL.Path, "/acme/authorize")
+
+	query := authURL.Query()
+	assert.Equal(t, query.Get("state"), auth.State)
+	assert.Equal(t, query.Get("client_id"), "client")
+	assert.Equal(t, query.Get("access_type"), "offline")
+	assert.Equal(t, query.Get("scope"), "https://acme.example.com/contacts.read offline")
+	assert.Equal(t, query.Get("code_challenge_method"), "S256")
+
+	ctx := context.Background()
+
+	_, err = flow.ExchangeCallback(ctx, auth, url.Values{"state": {"forged"}, "code": {"the-code"}})
+	assert.ErrorIs(t, err, ErrStateMismatch)
+
+	_, err = flow.ExchangeCallback(ctx, auth, url.Values{"error": {"access_denied"}})
+	assert.ErrorIs(t, err, ErrAuthorizationDenied)
+
+	result, err := flow.ExchangeCallback(ctx, auth, url.Values{"state": {auth.State}, "code": {"the-code"}})
+	assert.NilError(t, err)
+	assert.Equal(t, result.Token.AccessToken, "access")
+	assert.Equal(t, result.Token.RefreshToken, "refresh")
+	assert.DeepEqual(t, result.Metadata, &TokenMetadata{
+		WorkspaceRef: "https://acme.example.com",
+		ConsumerRef:  "42",
+		Scopes:       []string{"contacts.read", "offline"},
+		Fields: map[string]string{
+			"region":    "eu",
+			"challenge": query.Get("code_challenge"),
+		},
+	})
+}
+
+func TestNewValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		params Params
+		err    error
+	}{
+		{
+			name:   "Missing client id",
+			params: Params{Provider: providers.Salesforce, RedirectURL: "https://app.example.com"},
+			err:    ErrMissingClientId,
+		},
+		{
+			name:   "Unknown provider",
+			params: Params{Provider: "unknown", ClientId: "client", RedirectURL: "https://app.example.com"},
+			err:    providers.ErrProviderNotFound,
+		},
+		{
+			name:   "Not OAuth2",
+			params: Params{Provider: providers.Apollo, ClientId: "client", RedirectURL: "https://app.example.com"},
+			err:    ErrNotOAuth2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(tt.params)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	// Catalog variables must be supplied.
+	_, err := New(Params{Provider: providers.Salesforce, ClientId: "client", RedirectURL: "https://app.example.com"})
+	assert.Assert(t, err != nil)
+	assert.Assert(t, !errors.Is(err, ErrNotOAuth2))
+
+	flow, err := New(Params{
+		Provider:    providers.Salesforce,
+		Metadata:    map[string]string{"workspace": "acme"},
+		ClientId:    "client",
+		RedirectURL: "https://app.example.com",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, flow.Config().Endpoint.TokenURL, "https://acme.my.salesforce.com/services/oauth2/token")
+	assert.Equal(t, flow.AuthorizeWithState("xyz").CodeVerifier, "")
+}
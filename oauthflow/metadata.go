@@ -0,0 +1,117 @@
+package oauthflow
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/providers"
+	"golang.org/x/oauth2"
+)
+
+const captureGroup = "result"
+
+// TokenMetadata holds values described by Oauth2Opts.TokenMetadataFields.
+// Fields missing from the token response are left empty.
+type TokenMetadata struct {
+	// WorkspaceRef identifies the workspace, e.g. Salesforce instance URL.
+	WorkspaceRef string
+	// ConsumerRef identifies the user who granted the access.
+	ConsumerRef string
+	// Scopes are the granted scopes, when the provider reports them.
+	Scopes []string
+	// Fields are OtherFields keyed by their name.
+	Fields map[string]string
+}
+
+// ExtractTokenMetadata reads metadata fields from the token response.
+// Paths use dot notation for nested objects, e.g. "data.id".
+// Other fields having Capture regex keep only the named group "result" of the match.
+func ExtractTokenMetadata(token *oauth2.Token, fields providers.TokenMetadataFields) (*TokenMetadata, error) {
+	metadata := &TokenMetadata{
+		WorkspaceRef: tokenField(token, fields.WorkspaceRefField),
+		ConsumerRef:  tokenField(token, fields.ConsumerRefField),
+		Fields:       make(map[string]string),
+	}
+
+	if scopes := tokenField(token, fields.ScopesField); scopes != "" {
+		metadata.Scopes = strings.FieldsFunc(scopes, func(r rune) bool {
+			return r == ' ' || r == ','
+		})
+	}
+
+	if fields.OtherFields == nil {
+		return metadata, nil
+	}
+
+	for _, field := range *fields.OtherFields {
+		value := tokenField(token, field.Path)
+
+		if field.Capture != "" && value != "" {
+			captured, err := capture(field.Capture, value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: field %s: %w", ErrInvalidMetadataFields, field.Name, err)
+			}
+
+			value = captured
+		}
+
+		metadata.Fields[field.Name] = value
+	}
+
+	return metadata, nil
+}
+
+func capture(expression, value string) (string, error) {
+	regex, err := regexp.Compile(expression)
+	if err != nil {
+		return "", err
+	}
+
+	index := regex.SubexpIndex(captureGroup)
+	if index < 0 {
+		return "", fmt.Errorf("capture group %q is not defined", captureGroup)
+	}
+
+	match := regex.FindStringSubmatch(value)
+	if match == nil {
+		return "", nil
+	}
+
+	return match[index], nil
+}
+
+// tokenField walks the token response following the dot separated path.
+func tokenField(token *oauth2.Token, path string) string {
+	if path == "" {
+		return ""
+	}
+
+	keys := strings.Split(path, ".")
+	value := token.Extra(keys[0])
+
+	for _, key := range keys[1:] {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+
+		value = object[key]
+	}
+
+	return stringify(value)
+}
+
+func stringify(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
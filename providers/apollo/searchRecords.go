@@ -0,0 +1,90 @@
+package apollo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+)
+
+var _ connectors.SearchConnector = &Connector{}
+
+// SearchRecords implements connectors.SearchConnector for objects searched via POST.
+// Apollo has no query language, every search endpoint accepts a fixed set of named filters,
+// ex: "q_keywords" or "contact_stage_ids[]". Therefore, only conditions joined by AND are supported,
+// equality is sent as a named filter and IN as a list filter.
+// Apollo can sort by a single field.
+//
+// ref: https://docs.apollo.io/reference/search-for-contacts
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(readingSearchObjectsPOST, constructSupportedObjectName(params.ObjectName)) {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
+	url, err := c.getAPIURL(params.ObjectName, readOp)
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam(perPage, pageSize)
+
+	if params.PageSize != 0 {
+		url.WithQueryParam(perPage, strconv.Itoa(params.PageSize))
+	}
+
+	if len(params.NextPage) > 0 {
+		url.WithQueryParam(pageQuery, params.NextPage.String())
+	}
+
+	if params.Filter != nil {
+		if err = applySearchFilter(url, *params.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	switch len(params.SortBy) {
+	case 0:
+	case 1:
+		url.WithQueryParam("sort_by_field", params.SortBy[0].FieldName)
+		url.WithQueryParam("sort_ascending", strconv.FormatBool(!params.SortBy[0].Descending))
+	default:
+		return nil, fmt.Errorf("%w: only one sort key is allowed", common.ErrUnsupportedSearchSort)
+	}
+
+	return c.Search(ctx, common.ReadParams{
+		ObjectName: params.ObjectName,
+		Fields:     params.Fields,
+	}, url)
+}
+
+func applySearchFilter(url *urlbuilder.URL, filter common.SearchFilter) error {
+	if !filter.IsFlat() || (filter.JoinLogic() != common.SearchLogicAnd && len(filter.Conditions) > 1) {
+		return fmt.Errorf("%w: only conditions joined by AND are supported", common.ErrUnsupportedSearchFilter)
+	}
+
+	for _, condition := range filter.Conditions {
+		switch condition.Operator { // nolint:exhaustive
+		case common.SearchOperatorEQ:
+			url.WithQueryParam(condition.FieldName, condition.StringValue())
+		case common.SearchOperatorIn:
+			values, err := condition.Values()
+			if err != nil {
+				return err
+			}
+
+			url.WithQueryParamList(condition.FieldName+"[]", values)
+		default:
+			return fmt.Errorf("%w: operator %q", common.ErrUnsupportedSearchFilter, condition.Operator)
+		}
+	}
+
+	return nil
+}
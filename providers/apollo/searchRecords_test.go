@@ -0,0 +1,68 @@
+package apollo
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"gotest.tools/v3/assert"
+)
+
+func TestSearchRecords(t *testing.T) {
+	t.Parallel()
+
+	responseContacts := testutils.DataFromFile(t, "contacts.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodPOST(),
+			mockcond.Path("/v1/contacts/search"),
+			mockcond.QueryParam("q_keywords", "Collins"),
+			mockcond.QueryParam("contact_stage_ids[]", "6095a710bd01d100a506d4ae", "6095a710bd01d100a506d4af"),
+			mockcond.QueryParam("sort_by_field", "contact_last_activity_date"),
+			mockcond.QueryParam("sort_ascending", "false"),
+			mockcond.QueryParam("per_page", "1"),
+		},
+		Then: mockserver.Response(http.StatusOK, responseContacts),
+	}.Server()
+	defer server.Close()
+
+	conn, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	result, err := conn.SearchRecords(t.Context(), common.SearchParams{
+		ObjectName: "contacts",
+		Fields:     connectors.Fields("name"),
+		Filter: &common.SearchFilter{
+			Conditions: []common.SearchCondition{{
+				FieldName: "q_keywords", Operator: common.SearchOperatorEQ, Value: "Collins",
+			}, {
+				FieldName: "contact_stage_ids",
+				Operator:  common.SearchOperatorIn,
+				Value:     []string{"6095a710bd01d100a506d4ae", "6095a710bd01d100a506d4af"},
+			}},
+		},
+		SortBy:   []common.SearchSort{{FieldName: "contact_last_activity_date", Descending: true}},
+		PageSize: 1,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, result.Rows, int64(1))
+	assert.Equal(t, result.NextPage, common.NextPageToken("2"))
+	assert.DeepEqual(t, result.Data[0].Fields, map[string]any{"name": "Dick Collins"})
+
+	_, err = conn.SearchRecords(t.Context(), common.SearchParams{
+		ObjectName: "contacts",
+		Fields:     connectors.Fields("name"),
+		Filter: &common.SearchFilter{
+			Conditions: []common.SearchCondition{{
+				FieldName: "name", Operator: common.SearchOperatorContains, Value: "Dick",
+			}},
+		},
+	})
+	assert.ErrorIs(t, err, common.ErrUnsupportedSearchFilter)
+}
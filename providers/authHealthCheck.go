@@ -0,0 +1,56 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
+)
+
+// CheckCredentials performs the AuthHealthCheck described in the catalog using the authenticated client.
+// Catalog variables are substituted into the health check URL, unless the provider info was already
+// read with them. The returned error is reserved for problems with the check itself,
+// the state of the credentials is described by common.CredentialsHealth.
+func (i *ProviderInfo) CheckCredentials(
+	ctx context.Context, client common.AuthenticatedHTTPClient, vars ...catalogreplacer.CatalogVariable,
+) (*common.CredentialsHealth, error) {
+	if i.AuthHealthCheck == nil || i.AuthHealthCheck.Url == "" {
+		return nil, fmt.Errorf("%w: %s", common.ErrNoHealthCheck, i.Name)
+	}
+
+	check := *i.AuthHealthCheck
+	if err := catalogreplacer.NewCatalogSubstitutionRegistry(vars).Apply(&check); err != nil {
+		return nil, err
+	}
+
+	method := check.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, check.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.Do(req)
+	if err != nil {
+		return common.NewCredentialsHealth(nil, nil, err, check.SuccessStatusCodes), nil
+	}
+
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		// Connection broke while reading, the provider is treated as unreachable.
+		err = &url.Error{Op: method, URL: check.Url, Err: err}
+
+		return common.NewCredentialsHealth(nil, nil, err, check.SuccessStatusCodes), nil
+	}
+
+	return common.NewCredentialsHealth(rsp, body, nil, check.SuccessStatusCodes), nil
+}
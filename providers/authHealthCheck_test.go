@@ -0,0 +1,93 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
+)
+
+func TestCheckCredentials(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/acme/userinfo":
+			writer.WriteHeader(http.StatusOK)
+		case "/expired/userinfo":
+			writer.WriteHeader(http.StatusUnauthorized)
+		case "/scope/userinfo":
+			writer.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope"`)
+			writer.WriteHeader(http.StatusForbidden)
+		case "/revoked/userinfo":
+			writer.WriteHeader(http.StatusBadRequest)
+			_, _ = writer.Write([]byte(`{"error":"invalid_grant"}`))
+		default:
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	info := &ProviderInfo{
+		Name: "test",
+		AuthHealthCheck: &AuthHealthCheck{
+			Url:                server.URL + "/{{.workspace}}/userinfo",
+			SuccessStatusCodes: []int{http.StatusOK},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		workspace string
+		status    common.CredentialsStatus
+		err       error
+	}{
+		{name: "Healthy", workspace: "acme", status: common.CredentialsHealthy},
+		{name: "Expired", workspace: "expired", status: common.CredentialsExpired, err: common.ErrAccessToken},
+		{name: "Missing scope", workspace: "scope", status: common.CredentialsMissingScope, err: common.ErrMissingScope},
+		{name: "Revoked", workspace: "revoked", status: common.CredentialsRevoked, err: common.ErrInvalidGrant},
+		{name: "Unavailable", workspace: "down", status: common.CredentialsUnreachable, err: common.ErrProviderUnreachable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, err := info.CheckCredentials(context.Background(), server.Client(),
+				catalogreplacer.CustomCatalogVariable{Plan: catalogreplacer.SubstitutionPlan{
+					From: catalogreplacer.VariableWorkspace,
+					To:   tt.workspace,
+				}})
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", tt.name, err)
+			}
+
+			if health.Status != tt.status {
+				t.Fatalf("%s: expected status %s, got %s (%v)", tt.name, tt.status, health.Status, health.Err)
+			}
+
+			if tt.err != nil && !errors.Is(health.Err, tt.err) {
+				t.Fatalf("%s: expected error %v, got %v", tt.name, tt.err, health.Err)
+			}
+		})
+	}
+
+	// Catalog entry is not altered by substitution.
+	if info.AuthHealthCheck.Url != server.URL+"/{{.workspace}}/userinfo" {
+		t.Fatalf("catalog health check was modified: %s", info.AuthHealthCheck.Url)
+	}
+
+	unreachable := &ProviderInfo{Name: "test", AuthHealthCheck: &AuthHealthCheck{Url: "http://127.0.0.1:1/userinfo"}}
+
+	health, err := unreachable.CheckCredentials(context.Background(), http.DefaultClient)
+	if err != nil || health.Status != common.CredentialsUnreachable {
+		t.Fatalf("expected unreachable provider, got %v, %v", health, err)
+	}
+
+	_, err = (&ProviderInfo{Name: "test"}).CheckCredentials(context.Background(), http.DefaultClient)
+	if !errors.Is(err, common.ErrNoHealthCheck) {
+		t.Fatalf("expected missing health check, got %v", err)
+	}
+}
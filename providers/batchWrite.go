@@ -0,0 +1,42 @@
+package providers
+
+import "github.com/amp-labs/connectors/common"
+
+// Config returns the catalog configuration of the batch write type.
+// Unknown types are reported as unsupported.
+func (s *BatchWriteSupport) Config(writeType common.BatchWriteType) BatchWriteSupportConfig {
+	if s == nil {
+		return BatchWriteSupportConfig{}
+	}
+
+	switch writeType {
+	case common.BatchWriteTypeCreate:
+		return s.Create
+	case common.BatchWriteTypeUpdate:
+		return s.Update
+	case common.BatchWriteTypeUpsert:
+		return s.Upsert
+	case common.BatchWriteTypeDelete:
+		return s.Delete
+	default:
+		return BatchWriteSupportConfig{}
+	}
+}
+
+// RecordLimit returns how many records a single batch of the object can hold.
+// An object specific limit takes precedence over the default one. Zero means there is no known limit.
+func (s *BatchWriteSupport) RecordLimit(writeType common.BatchWriteType, objectName string) int {
+	config := s.Config(writeType)
+
+	if config.ObjectRecordLimits != nil {
+		if limit, ok := (*config.ObjectRecordLimits)[objectName]; ok {
+			return limit
+		}
+	}
+
+	if config.DefaultRecordLimit != nil {
+		return *config.DefaultRecordLimit
+	}
+
+	return 0
+}
@@ -48,10 +48,10 @@ func init() {
 		Metadata: &ProviderMetadata{
 			PostAuthentication: []MetadataItemPostAuthentication{
 				{
-					Name: "UserURI",
+					Name: "userURI",
 				},
 				{
-					Name: "OrganizationURI",
+					Name: "organizationURI",
 				},
 			},
 		},
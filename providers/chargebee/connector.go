@@ -70,3 +70,7 @@ func constructor(base *components.Connector) (*Connector, error) {
 
 	return connector, nil
 }
+
+func (c *Connector) IdempotencyMode() common.IdempotencyMode {
+	return common.IdempotencyModeNative
+}
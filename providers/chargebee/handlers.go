@@ -17,6 +17,9 @@ import (
 const (
 	apiVersion = "v2"
 	pageSize   = 100
+	// Chargebee replays the original response for requests repeating the key.
+	// https://apidocs.chargebee.com/docs/api/idempotency
+	idempotencyKeyHeader = "chargebee-idempotency-key"
 )
 
 func (c *Connector) buildSingleObjectMetadataRequest(ctx context.Context, objectName string) (*http.Request, error) {
@@ -187,6 +190,10 @@ func (c *Connector) buildWriteRequest(ctx context.Context, params common.WritePa
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
+	for _, header := range common.IdempotencyHeader(idempotencyKeyHeader, params.IdempotencyKey) {
+		header.ApplyToRequest(req)
+	}
+
 	return req, nil
 }
 
@@ -78,6 +78,30 @@ func TestWrite(t *testing.T) { //nolint:funlen,gocognit,cyclop
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Idempotency key is forwarded",
+			Input: common.WriteParams{
+				ObjectName:     "customers",
+				RecordData:     map[string]any{"first_name": "John"},
+				IdempotencyKey: "4a8e2b6c-retry-safe",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/api/v2/customers"),
+					mockcond.MethodPOST(),
+					mockcond.Header(http.Header{"Chargebee-Idempotency-Key": []string{"4a8e2b6c-retry-safe"}}),
+				},
+				Then: mockserver.Response(http.StatusOK, responseCustomerCreate),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "__test__KyVnHhSBWlC1T2cj",
+				Data:     map[string]any{"first_name": "John"},
+			},
+			ExpectedErrs: nil,
+		},
 		{
 			Name: "Update customer successfully",
 			Input: common.WriteParams{
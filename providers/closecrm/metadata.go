@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/internal/simultaneously"
 )
 
@@ -68,6 +69,8 @@ func (c *Connector) ListObjectMetadata(ctx context.Context,
 	}
 
 	// This will block until all callbacks are done.
+	ctx = governor.WithContext(ctx, c.Client.HTTPClient.Governor())
+
 	if err := simultaneously.DoCtx(ctx, -1, callbacks...); err != nil {
 		return nil, err
 	}
@@ -28,8 +28,11 @@ const searchEndpoint = "data/search"
 // The NextPage Token generated takes 30 seconds to expire.
 //
 // doc: https://developer.close.com/resources/advanced-filtering
+//
+// Records updated since the given date are searched. Filters given by the caller narrow the search further,
+// their sorting, page size and fields take precedence.
 func (c *Connector) Search(ctx context.Context, config SearchParams) (*common.ReadResult, error) {
-	searchFilter, err := buildUpdatedDateFilter(config)
+	searchFilter, err := buildFilter(config)
 	if err != nil {
 		return nil, err
 	}
@@ -53,38 +56,50 @@ func (c *Connector) Search(ctx context.Context, config SearchParams) (*common.Re
 	)
 }
 
-func buildUpdatedDateFilter(params SearchParams) (Filter, error) {
+// buildFilter combines the object type, the updated date and the caller's filters with the cursor.
+func buildFilter(params SearchParams) (Filter, error) {
 	limit, err := strconv.Atoi(defaultPageSize)
 	if err != nil {
 		return Filter{}, err
 	}
 
-	flt := Filter{
-		Query: Query{
-			Type: "and",
-			Queries: []map[string]any{
-				{
-					ObjectTypeQueryKey: params.ObjectName,
-					TypeQueryKey:       "object_type",
-				},
-				{
-					TypeQueryKey: "field_condition",
-					FieldQueryKey: map[string]any{
-						TypeQueryKey:          "regular_field",
-						ObjectTypeQueryKey:    params.ObjectName,
-						FieldNameTypeQueryKey: "date_updated",
-					},
-					ConditionQueryKey: map[string]any{
-						OnOrAfterQueryKey: map[string]any{
-							TypeQueryKey:  "fixed_local_date",
-							ValueQueryKey: params.Since.Format(time.DateOnly),
-							WhichQueryKey: "start",
-						},
-						TypeQueryKey: "moment_range",
-					},
+	queries := []map[string]any{{
+		ObjectTypeQueryKey: params.ObjectName,
+		TypeQueryKey:       "object_type",
+	}}
+
+	if !params.Since.IsZero() {
+		queries = append(queries, map[string]any{
+			TypeQueryKey: "field_condition",
+			FieldQueryKey: map[string]any{
+				TypeQueryKey:          "regular_field",
+				ObjectTypeQueryKey:    params.ObjectName,
+				FieldNameTypeQueryKey: "date_updated",
+			},
+			ConditionQueryKey: map[string]any{
+				OnOrAfterQueryKey: map[string]any{
+					TypeQueryKey:  "fixed_local_date",
+					ValueQueryKey: params.Since.Format(time.DateOnly),
+					WhichQueryKey: "start",
 				},
+				TypeQueryKey: "moment_range",
 			},
+		})
+	}
+
+	if len(params.Filters.Query.Queries) != 0 {
+		queries = append(queries, map[string]any{
+			TypeQueryKey: params.Filters.Query.Type,
+			"queries":    params.Filters.Query.Queries,
+		})
+	}
+
+	flt := Filter{
+		Query: Query{
+			Type:    "and",
+			Queries: queries,
 		},
+		Sort: params.Filters.Sort,
 		Fields: map[string][]string{
 			params.ObjectName: params.Fields,
 		},
@@ -92,6 +107,14 @@ func buildUpdatedDateFilter(params SearchParams) (Filter, error) {
 		Limit:  limit,
 	}
 
+	if params.Filters.Limit != 0 {
+		flt.Limit = params.Filters.Limit
+	}
+
+	if len(params.Filters.Fields) != 0 {
+		flt.Fields = params.Filters.Fields
+	}
+
 	if len(params.NextPage) > 0 {
 		flt.Cursor = params.NextPage.String()
 	}
@@ -0,0 +1,228 @@
+package closecrm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.SearchConnector = &Connector{}
+
+// SearchRecords implements connectors.SearchConnector using the advanced filtering query language.
+// Only lead, contact and opportunity objects are searchable.
+// Numeric values are compared with "number_range", time values with "moment_range",
+// the rest of comparisons are textual.
+//
+// doc: https://developer.close.com/resources/advanced-filtering
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if !supportsFiltering(params.ObjectName) {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
+	filter, err := buildSearchFilter(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Search(ctx, SearchParams{
+		ObjectName: params.ObjectName,
+		Fields:     params.Fields.List(),
+		NextPage:   params.NextPage,
+		Filters:    filter,
+	})
+}
+
+// buildSearchFilter converts the caller's filter and sorting, Search adds the object type and the cursor.
+func buildSearchFilter(params common.SearchParams) (Filter, error) {
+	filter := Filter{
+		Limit: params.PageSize,
+	}
+
+	if params.Filter != nil {
+		queries, err := makeQueries(params.ObjectName, *params.Filter)
+		if err != nil {
+			return Filter{}, err
+		}
+
+		filter.Query = Query{
+			Type:    string(params.Filter.JoinLogic()),
+			Queries: queries,
+		}
+	}
+
+	for _, key := range params.SortBy {
+		direction := "asc"
+		if key.Descending {
+			direction = "desc"
+		}
+
+		filter.Sort = append(filter.Sort, map[string]any{
+			"direction":   direction,
+			FieldQueryKey: makeField(params.ObjectName, key.FieldName),
+		})
+	}
+
+	return filter, nil
+}
+
+func makeQuery(objectName string, filter common.SearchFilter) (map[string]any, error) {
+	queries, err := makeQueries(objectName, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		TypeQueryKey: string(filter.JoinLogic()),
+		"queries":    queries,
+	}, nil
+}
+
+// makeQueries converts conditions and nested groups of the filter.
+func makeQueries(objectName string, filter common.SearchFilter) ([]map[string]any, error) {
+	queries := make([]map[string]any, 0, len(filter.Conditions)+len(filter.Groups))
+
+	for _, condition := range filter.Conditions {
+		query, err := makeFieldCondition(objectName, condition)
+		if err != nil {
+			return nil, err
+		}
+
+		queries = append(queries, query)
+	}
+
+	for _, group := range filter.Groups {
+		query, err := makeQuery(objectName, group)
+		if err != nil {
+			return nil, err
+		}
+
+		queries = append(queries, query)
+	}
+
+	return queries, nil
+}
+
+// makeFieldCondition creates a positive condition, negative operators wrap it with the "not" query.
+func makeFieldCondition(objectName string, condition common.SearchCondition) (map[string]any, error) {
+	var (
+		positive common.SearchOperator
+		negate   bool
+	)
+
+	switch condition.Operator { // nolint:exhaustive
+	case common.SearchOperatorNEQ:
+		positive, negate = common.SearchOperatorEQ, true
+	case common.SearchOperatorNotIn:
+		positive, negate = common.SearchOperatorIn, true
+	case common.SearchOperatorNotContains:
+		positive, negate = common.SearchOperatorContains, true
+	case common.SearchOperatorNotExists:
+		positive, negate = common.SearchOperatorExists, true
+	default:
+		positive = condition.Operator
+	}
+
+	fieldCondition, err := makeCondition(positive, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	query := map[string]any{
+		TypeQueryKey:      "field_condition",
+		FieldQueryKey:     makeField(objectName, condition.FieldName),
+		ConditionQueryKey: fieldCondition,
+	}
+
+	if negate {
+		return map[string]any{
+			TypeQueryKey: "not",
+			"query":      query,
+		}, nil
+	}
+
+	return query, nil
+}
+
+func makeCondition(operator common.SearchOperator, condition common.SearchCondition) (map[string]any, error) {
+	switch operator { // nolint:exhaustive
+	case common.SearchOperatorExists:
+		return map[string]any{TypeQueryKey: "exists"}, nil
+	case common.SearchOperatorIn:
+		values, err := condition.Values()
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{TypeQueryKey: "term", "values": values}, nil
+	case common.SearchOperatorContains:
+		return map[string]any{TypeQueryKey: "text", "mode": "phrase", ValueQueryKey: condition.StringValue()}, nil
+	case common.SearchOperatorEQ:
+		if isNumber(condition.Value) {
+			return map[string]any{TypeQueryKey: "number_range", "gte": condition.Value, "lte": condition.Value}, nil
+		}
+
+		return map[string]any{TypeQueryKey: "text", "mode": "exact_value", ValueQueryKey: condition.StringValue()}, nil
+	case common.SearchOperatorGT, common.SearchOperatorGTE, common.SearchOperatorLT, common.SearchOperatorLTE:
+		return makeRangeCondition(operator, condition)
+	default:
+		return nil, fmt.Errorf("%w: operator %q", common.ErrUnsupportedSearchFilter, condition.Operator)
+	}
+}
+
+// makeRangeCondition compares numbers or moments.
+// Moment range only has inclusive lower bound and exclusive upper bound.
+func makeRangeCondition(operator common.SearchOperator, condition common.SearchCondition) (map[string]any, error) {
+	if moment, ok := condition.Value.(time.Time); ok {
+		bounds := map[common.SearchOperator]string{
+			common.SearchOperatorGTE: OnOrAfterQueryKey,
+			common.SearchOperatorLT:  "before",
+		}
+
+		bound, supported := bounds[operator]
+		if !supported {
+			return nil, fmt.Errorf("%w: operator %q on time values", common.ErrUnsupportedSearchFilter, operator)
+		}
+
+		return map[string]any{
+			TypeQueryKey: "moment_range",
+			bound: map[string]any{
+				TypeQueryKey:  "fixed_utc",
+				ValueQueryKey: moment.UTC().Format(time.RFC3339),
+			},
+		}, nil
+	}
+
+	if !isNumber(condition.Value) {
+		return nil, fmt.Errorf("%w: field %s must be compared with a number or time",
+			common.ErrUnsupportedSearchFilter, condition.FieldName)
+	}
+
+	return map[string]any{
+		TypeQueryKey:     "number_range",
+		string(operator): condition.Value,
+	}, nil
+}
+
+func makeField(objectName, fieldName string) map[string]any {
+	return map[string]any{
+		TypeQueryKey:          "regular_field",
+		ObjectTypeQueryKey:    objectName,
+		FieldNameTypeQueryKey: fieldName,
+	}
+}
+
+func isNumber(value any) bool {
+	switch value.(type) {
+	case int, int32, int64, float32, float64:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,142 @@
+package closecrm
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"gotest.tools/v3/assert"
+)
+
+func TestSearchRecords(t *testing.T) {
+	t.Parallel()
+
+	requestLeads := testutils.DataFromFile(t, "search/leads-req-payload.json")
+	responseLeads := testutils.DataFromFile(t, "search/leads.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodPOST(),
+			mockcond.Path("/api/v1/data/search/"),
+			mockcond.BodyBytes(requestLeads),
+		},
+		Then: mockserver.Response(http.StatusOK, responseLeads),
+	}.Server()
+	defer server.Close()
+
+	conn, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	result, err := conn.SearchRecords(t.Context(), common.SearchParams{
+		ObjectName: "lead",
+		Fields:     connectors.Fields("display_name"),
+		Filter: &common.SearchFilter{
+			Logic: common.SearchLogicOr,
+			Conditions: []common.SearchCondition{{
+				FieldName: "display_name", Operator: common.SearchOperatorContains, Value: "Dunder",
+			}, {
+				FieldName: "status_label", Operator: common.SearchOperatorNotIn, Value: []string{"Bad Fit", "Canceled"},
+			}},
+		},
+		SortBy:   []common.SearchSort{{FieldName: "date_updated", Descending: true}},
+		PageSize: 25,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, result.Rows, int64(2))
+	assert.Equal(t, result.NextPage, common.NextPageToken("eyJza2lwIjoyNX0.aUZqbQ.VhJ7q2hT3X1w8Yb0nL5kE9mQwRz"))
+	assert.DeepEqual(t, result.Data[1].Fields, map[string]any{"display_name": "Vance Refrigeration"})
+}
+
+func TestSearchRecordsUnsupportedFilter(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Dummy()
+	defer server.Close()
+
+	conn, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	_, err = conn.SearchRecords(t.Context(), common.SearchParams{
+		ObjectName: "lead",
+		Fields:     connectors.Fields("display_name"),
+		Filter: &common.SearchFilter{
+			Conditions: []common.SearchCondition{{
+				FieldName: "date_updated", Operator: common.SearchOperatorGT, Value: time.Now(),
+			}},
+		},
+	})
+	assert.ErrorIs(t, err, common.ErrUnsupportedSearchFilter)
+
+	_, err = conn.SearchRecords(t.Context(), common.SearchParams{
+		ObjectName: "activity",
+		Fields:     connectors.Fields("id"),
+	})
+	assert.ErrorIs(t, err, common.ErrOperationNotSupportedForObject)
+}
+
+func TestSearchCombinesFiltersWithCursorAndSince(t *testing.T) {
+	t.Parallel()
+
+	responseLeads := testutils.DataFromFile(t, "search/leads.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.Body(`{
+			"query": {
+				"type": "and",
+				"queries": [
+					{"object_type": "lead", "type": "object_type"},
+					{
+						"type": "field_condition",
+						"field": {"type": "regular_field", "object_type": "lead", "field_name": "date_updated"},
+						"condition": {
+							"type": "moment_range",
+							"on_or_after": {"type": "fixed_local_date", "value": "2025-03-01", "which": "start"}
+						}
+					},
+					{
+						"type": "and",
+						"queries": [{
+							"type": "field_condition",
+							"field": {"type": "regular_field", "object_type": "lead", "field_name": "display_name"},
+							"condition": {"type": "text", "mode": "phrase", "value": "Dunder"}
+						}]
+					}
+				]
+			},
+			"cursor": "eyJza2lwIjoyNX0",
+			"_limit": 100,
+			"_fields": {"lead": ["display_name"]}
+		}`),
+		Then: mockserver.Response(http.StatusOK, responseLeads),
+	}.Server()
+	defer server.Close()
+
+	conn, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	result, err := conn.Search(t.Context(), SearchParams{
+		ObjectName: "lead",
+		Fields:     []string{"display_name"},
+		Since:      time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		NextPage:   "eyJza2lwIjoyNX0",
+		Filters: Filter{
+			Query: Query{
+				Type: "and",
+				Queries: []map[string]any{{
+					TypeQueryKey:      "field_condition",
+					FieldQueryKey:     makeField("lead", "display_name"),
+					ConditionQueryKey: map[string]any{TypeQueryKey: "text", "mode": "phrase", ValueQueryKey: "Dunder"},
+				}},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, result.Rows, int64(2))
+}
@@ -23,6 +23,7 @@ type SearchParams struct {
 
 type Filter struct {
 	Query  Query               `json:"query"`
+	Sort   []map[string]any    `json:"sort,omitempty"`
 	Cursor any                 `json:"cursor"`
 	Limit  int                 `json:"_limit"`  //nolint:tagliatelle
 	Fields map[string][]string `json:"_fields"` //nolint:tagliatelle
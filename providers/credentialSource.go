@@ -0,0 +1,113 @@
+package providers
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// invalidateOnUnauthorized drops cached credentials once the provider rejects them,
+// the next request loads the latest credentials from the source.
+// The caller's handler, if any, is invoked afterwards.
+func invalidateOnUnauthorized(source common.CredentialSource, next UnauthorizedHandler) UnauthorizedHandler {
+	return func(client common.AuthenticatedHTTPClient, event *UnauthorizedEvent) (*http.Response, error) {
+		common.InvalidateCredentials(source)
+
+		if next != nil {
+			return next(client, event)
+		}
+
+		return event.Response, nil
+	}
+}
+
+func basicHeadersFromSource(source common.CredentialSource) common.DynamicHeadersGenerator {
+	return func(req *http.Request) ([]common.Header, error) {
+		credentials, err := source.Credentials(req.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		user, err := credentials.Get(common.CredentialUsername)
+		if err != nil {
+			return nil, err
+		}
+
+		// Password may be empty, some providers authenticate with the key passed as the username.
+		auth := user + ":" + credentials[common.CredentialPassword]
+
+		return []common.Header{{
+			Key:   "Authorization",
+			Value: "Basic " + base64.StdEncoding.EncodeToString([]byte(auth)),
+		}}, nil
+	}
+}
+
+func (i *ProviderInfo) apiKeyFromSource(req *http.Request, source common.CredentialSource) (string, error) {
+	credentials, err := source.Credentials(req.Context())
+	if err != nil {
+		return "", err
+	}
+
+	return credentials.Get(common.CredentialApiKey)
+}
+
+func (i *ProviderInfo) apiKeyHeadersFromSource(source common.CredentialSource) common.DynamicHeadersGenerator {
+	return func(req *http.Request) ([]common.Header, error) {
+		apiKey, err := i.apiKeyFromSource(req, source)
+		if err != nil {
+			return nil, err
+		}
+
+		return []common.Header{{
+			Key:   i.ApiKeyOpts.Header.Name,
+			Value: i.ApiKeyOpts.Header.ValuePrefix + apiKey,
+		}}, nil
+	}
+}
+
+func (i *ProviderInfo) apiKeyQueryParamsFromSource(source common.CredentialSource) common.DynamicQueryParamsGenerator {
+	return func(req *http.Request) (common.QueryParams, error) {
+		apiKey, err := i.apiKeyFromSource(req, source)
+		if err != nil {
+			return nil, err
+		}
+
+		return common.QueryParams{{
+			Key:   i.ApiKeyOpts.Query.Name,
+			Value: apiKey,
+		}}, nil
+	}
+}
+
+// customAuthFromSource evaluates templates of custom headers and query params with the latest credentials.
+// Credentials are loaded once per request and shared by headers and query params.
+func (i *ProviderInfo) customAuthFromSource(source common.CredentialSource) common.DynamicAuthGenerator {
+	return func(req *http.Request) (common.Headers, common.QueryParams, error) {
+		credentials, err := source.Credentials(req.Context())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, input := range i.CustomOpts.Inputs {
+			if _, err := credentials.Get(input.Name); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		cfg := &CustomAuthParams{Values: credentials}
+
+		headers, err := getCustomHeaders(i, cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		params, err := getCustomParams(i, cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return headers, params, nil
+	}
+}
@@ -0,0 +1,256 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// rotatingCredentials returns the next key from the list on every load, imitating a secret store.
+type rotatingCredentials struct {
+	keys  []string
+	loads atomic.Int32
+}
+
+func (r *rotatingCredentials) Credentials(context.Context) (common.Credentials, error) {
+	position := int(r.loads.Add(1)) - 1
+	if position >= len(r.keys) {
+		position = len(r.keys) - 1
+	}
+
+	return common.Credentials{common.CredentialApiKey: r.keys[position]}, nil
+}
+
+func sendTestRequest(t *testing.T, client common.AuthenticatedHTTPClient, url string) int {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	rsp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	_ = rsp.Body.Close()
+
+	return rsp.StatusCode
+}
+
+func TestNewClientCredentialSourceRotation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "rotated" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	store := &rotatingCredentials{keys: []string{"revoked", "rotated"}}
+
+	client, err := newTestApiKeyInfo().NewClient(t.Context(), &NewClientParams{
+		CredentialSource: common.NewCachedCredentialSource(store, 0),
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	// The cached key is rejected, which invalidates it.
+	if status := sendTestRequest(t, client, server.URL); status != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, status)
+	}
+
+	for range 2 {
+		if status := sendTestRequest(t, client, server.URL); status != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, status)
+		}
+	}
+
+	if loads := store.loads.Load(); loads != 2 {
+		t.Fatalf("expected credentials to be loaded twice, got %d", loads)
+	}
+}
+
+func TestNewClientCredentialSource(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		info        *ProviderInfo
+		credentials common.Credentials
+		authorized  func(r *http.Request) bool
+	}{
+		{
+			name: "API key in query",
+			info: &ProviderInfo{
+				AuthType: ApiKey,
+				ApiKeyOpts: &ApiKeyOpts{
+					AttachmentType: Query,
+					Query:          &ApiKeyOptsQuery{Name: "key"},
+				},
+			},
+			credentials: common.Credentials{common.CredentialApiKey: "secret"},
+			authorized: func(r *http.Request) bool {
+				return r.URL.Query().Get("key") == "secret"
+			},
+		},
+		{
+			name: "Basic auth",
+			info: &ProviderInfo{AuthType: Basic},
+			credentials: common.Credentials{
+				common.CredentialUsername: "user",
+				common.CredentialPassword: "pass",
+			},
+			authorized: func(r *http.Request) bool {
+				user, pass, ok := r.BasicAuth()
+
+				return ok && user == "user" && pass == "pass"
+			},
+		},
+		{
+			name: "Custom auth",
+			info: func() *ProviderInfo {
+				info, err := ReadInfo(Discourse)
+				if err != nil {
+					t.Fatalf("failed to read provider info: %v", err)
+				}
+
+				return info
+			}(),
+			credentials: common.Credentials{"apiKey": "secret", "username": "system"},
+			authorized: func(r *http.Request) bool {
+				return r.Header.Get("Api-Key") == "secret" && r.Header.Get("Api-Username") == "system"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tt.authorized(r) {
+					w.WriteHeader(http.StatusUnauthorized)
+
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(server.Close)
+
+			client, err := tt.info.NewClient(t.Context(), &NewClientParams{
+				CredentialSource: common.StaticCredentials(tt.credentials),
+			})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			if status := sendTestRequest(t, client, server.URL); status != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, status)
+			}
+		})
+	}
+}
+
+// countingCredentials counts how many times the credentials are loaded.
+type countingCredentials struct {
+	credentials common.Credentials
+	loads       atomic.Int32
+}
+
+func (c *countingCredentials) Credentials(context.Context) (common.Credentials, error) {
+	c.loads.Add(1)
+
+	return c.credentials, nil
+}
+
+func TestNewClientCustomAuthLoadsCredentialsOnce(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" || r.URL.Query().Get("account") != "acme" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	info := &ProviderInfo{
+		AuthType: Custom,
+		CustomOpts: &CustomAuthOpts{
+			Headers:     []CustomAuthHeader{{Name: "X-Api-Key", ValueTemplate: "{{.apiKey}}"}},
+			QueryParams: []CustomAuthQueryParam{{Name: "account", ValueTemplate: "{{.account}}"}},
+			Inputs:      []CustomAuthInput{{Name: "apiKey"}, {Name: "account"}},
+		},
+	}
+
+	store := &countingCredentials{credentials: common.Credentials{"apiKey": "secret", "account": "acme"}}
+
+	client, err := info.NewClient(t.Context(), &NewClientParams{CredentialSource: store})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if status := sendTestRequest(t, client, server.URL); status != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, status)
+	}
+
+	if loads := store.loads.Load(); loads != 1 {
+		t.Fatalf("expected credentials to be loaded once per request, got %d", loads)
+	}
+}
+
+func TestNewClientCredentialSourceMissingCredential(t *testing.T) {
+	t.Parallel()
+
+	client, err := newTestApiKeyInfo().NewClient(t.Context(), &NewClientParams{
+		CredentialSource: common.StaticCredentials(common.Credentials{}),
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	if _, err = client.Do(req); !errors.Is(err, common.ErrMissingCredential) {
+		t.Fatalf("expected error %v, got %v", common.ErrMissingCredential, err)
+	}
+}
+
+func TestNewClientCredentialSourceRejectedByOAuth(t *testing.T) {
+	t.Parallel()
+
+	info := &ProviderInfo{
+		AuthType: Oauth2,
+		Oauth2Opts: &Oauth2Opts{
+			GrantType: ClientCredentials,
+			TokenURL:  "http://localhost/token",
+		},
+	}
+
+	_, err := info.NewClient(t.Context(), &NewClientParams{
+		CredentialSource: common.StaticCredentials(common.Credentials{}),
+	})
+	if !errors.Is(err, ErrClient) {
+		t.Fatalf("expected error %v, got %v", ErrClient, err)
+	}
+}
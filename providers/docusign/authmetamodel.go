@@ -6,11 +6,6 @@ import (
 
 const serverKey = "server"
 
-// Metadata fields that must be specified to initialize connector.
-var requiredMetadataFields = []string{ // nolint:gochecknoglobals
-	serverKey,
-}
-
 // AuthMetadataVars is a complete list of authentication metadata associated with connector.
 // This model serves as a documentation of map[string]string contents.
 type AuthMetadataVars struct {
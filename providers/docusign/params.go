@@ -2,7 +2,6 @@ package docusign
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
 	"github.com/amp-labs/connectors/common"
@@ -30,10 +29,8 @@ func newParams(opts []Option) (*common.ConnectorParams, error) { // nolint:unuse
 }
 
 func (p parameters) ValidateParams() error {
-	return errors.Join(
-		p.Client.ValidateParams(),
-		p.Metadata.ValidateParams(),
-	)
+	// Metadata parameter is optional, server is collected after authentication.
+	return p.Client.ValidateParams()
 }
 
 // WithClient sets the http client to use for the connector. Saves some boilerplate.
@@ -54,6 +51,6 @@ func WithAuthenticatedClient(client common.AuthenticatedHTTPClient) Option {
 // WithMetadata sets authentication metadata expected by connector.
 func WithMetadata(metadata map[string]string) Option {
 	return func(params *parameters) {
-		params.WithMetadata(metadata, requiredMetadataFields)
+		params.WithMetadata(metadata, nil)
 	}
 }
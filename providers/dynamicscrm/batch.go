@@ -0,0 +1,420 @@
+package dynamicscrm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/httpkit"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrBatchResponseFormat is returned when $batch response cannot be understood.
+	ErrBatchResponseFormat = errors.New("unexpected batch response format")
+	// ErrBatchTooLarge is returned when records don't fit into a single change set.
+	ErrBatchTooLarge = errors.New("batch exceeds the operation limit")
+)
+
+// maxBatchOperations is the number of operations a single $batch request may hold.
+// A change set is applied as a whole, therefore larger batches are rejected rather than split.
+// nolint:lll
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/execute-batch-operations-using-web-api#batch-requests
+const maxBatchOperations = 1000
+
+// Record identifier is enclosed in brackets at the end of OData-EntityId.
+// Ex: https://org.crm.dynamics.com/api/data/v9.2/contacts(cdcfa450-cb0c-ea11-a813-000d3a1b1223)
+var entityIdentifierRegex = regexp.MustCompile(`\(([^()]+)\)$`) // nolint:gochecknoglobals
+
+// BatchWrite creates or updates records using a single OData $batch request.
+// All operations belong to one change set, therefore either every record is written or none.
+// Records for update must include an "id" property, which is moved from the payload into the URL.
+//
+// When the change set fails, Dataverse stops processing and responds only for the failed operation.
+// Such operation gets its error, while every other record is reported as unprocessed.
+//
+// nolint:lll
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/execute-batch-operations-using-web-api#change-sets
+func (c *Connector) BatchWrite(ctx context.Context, params *common.BatchWriteParam) (*common.BatchWriteResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if !params.IsCreate() && !params.IsUpdate() {
+		return nil, common.ErrUnsupportedBatchWriteType
+	}
+
+	if size := params.Size(); size > maxBatchOperations {
+		return nil, fmt.Errorf("%w: %v records, limit is %v", ErrBatchTooLarge, size, maxBatchOperations)
+	}
+
+	operations, err := c.buildChangeSet(params)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := c.getURL("$batch")
+	if err != nil {
+		return nil, err
+	}
+
+	payload, headers, err := buildBatchRequest(operations, params.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Request rejected as a whole is interpreted by the error handler of the client.
+	rsp, body, err := c.Client.HTTPClient.Post(ctx, url.String(), payload, headers...)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := parseBatchResponse(rsp.Header.Get("Content-Type"), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var unmatchedErrors []any
+
+	for _, response := range responses {
+		if response.ContentID == "" && !httpkit.Status2xx(response.StatusCode) {
+			unmatchedErrors = append(unmatchedErrors, response.toError())
+		}
+	}
+
+	return common.ParseBatchWrite(
+		operations,
+		batchResponseMatcher(responses),
+		batchResultBuilder,
+		unmatchedErrors,
+	)
+}
+
+// batchOperation is a single request inside the change set.
+type batchOperation struct {
+	ContentID string
+	Method    string
+	URL       string
+	RecordID  string
+	Body      []byte
+}
+
+func (c *Connector) buildChangeSet(params *common.BatchWriteParam) ([]batchOperation, error) {
+	records, err := params.GetRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	operations := make([]batchOperation, len(records))
+
+	for index, record := range records {
+		operation := batchOperation{
+			ContentID: strconv.Itoa(index + 1),
+			Method:    http.MethodPost,
+		}
+
+		resource := params.ObjectName.String()
+
+		if params.IsUpdate() {
+			identifier, ok := record["id"].(string)
+			if !ok || identifier == "" {
+				return nil, fmt.Errorf("%w: record %v has no id", common.ErrMissingRecordID, index)
+			}
+
+			// Identifier belongs to the URL, the caller's record is left intact.
+			record = maps.Clone(record)
+			delete(record, "id")
+
+			operation.Method = http.MethodPatch
+			operation.RecordID = identifier
+			resource = fmt.Sprintf("%s(%s)", resource, identifier)
+		}
+
+		url, err := c.getURL(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		operation.URL = url.String()
+
+		operation.Body, err = json.Marshal(record)
+		if err != nil {
+			return nil, errors.Join(common.ErrPreprocessingWritePayload, err)
+		}
+
+		operations[index] = operation
+	}
+
+	return operations, nil
+}
+
+// buildBatchRequest returns the payload of the $batch request with its headers.
+// Headers given by the caller overwrite the default ones.
+func buildBatchRequest(
+	operations []batchOperation, extraHeaders []common.WriteHeader,
+) ([]byte, common.Headers, error) {
+	payload, contentType, err := encodeChangeSet(operations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headers := common.Headers{
+		{Key: "Content-Type", Value: contentType},
+		{Key: "Accept", Value: "application/json"},
+		{Key: "OData-MaxVersion", Value: "4.0"},
+		{Key: "OData-Version", Value: "4.0"},
+	}
+
+	for _, header := range extraHeaders {
+		headers = slices.DeleteFunc(headers, func(existing common.Header) bool {
+			return strings.EqualFold(existing.Key, header.Key)
+		})
+		headers = append(headers, common.Header{Key: header.Key, Value: header.Value})
+	}
+
+	return payload, headers, nil
+}
+
+// encodeChangeSet produces multipart/mixed batch body, which has one change set holding every operation.
+// Returns payload with its content type.
+//
+// Example:
+//
+//	--batch_AAA123
+//	Content-Type: multipart/mixed; boundary=changeset_BBB456
+//
+//	--changeset_BBB456
+//	Content-Type: application/http
+//	Content-Transfer-Encoding: binary
+//	Content-ID: 1
+//
+//	POST https://org.crm.dynamics.com/api/data/v9.2/contacts HTTP/1.1
+//	Content-Type: application/json; type=entry
+//
+//	{"firstname":"Jane"}
+//	--changeset_BBB456--
+//	--batch_AAA123--
+func encodeChangeSet(operations []batchOperation) ([]byte, string, error) {
+	var (
+		payload   bytes.Buffer
+		changeSet bytes.Buffer
+	)
+
+	batchWriter := multipart.NewWriter(&payload)
+	if err := batchWriter.SetBoundary("batch_" + uuid.NewString()); err != nil {
+		return nil, "", err
+	}
+
+	changeSetWriter := multipart.NewWriter(&changeSet)
+	if err := changeSetWriter.SetBoundary("changeset_" + uuid.NewString()); err != nil {
+		return nil, "", err
+	}
+
+	for _, operation := range operations {
+		part, err := changeSetWriter.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {"application/http"},
+			"Content-Transfer-Encoding": {"binary"},
+			"Content-ID":                {operation.ContentID},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if _, err = part.Write(operation.encode()); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := changeSetWriter.Close(); err != nil {
+		return nil, "", err
+	}
+
+	part, err := batchWriter.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"multipart/mixed; boundary=" + changeSetWriter.Boundary()},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err = part.Write(changeSet.Bytes()); err != nil {
+		return nil, "", err
+	}
+
+	if err = batchWriter.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return payload.Bytes(), "multipart/mixed; boundary=" + batchWriter.Boundary(), nil
+}
+
+func (o batchOperation) encode() []byte {
+	var request bytes.Buffer
+
+	request.WriteString(o.Method + " " + o.URL + " HTTP/1.1\r\n")
+	request.WriteString("Content-Type: application/json; type=entry\r\n")
+
+	if o.Method == http.MethodPatch {
+		// Prevents PATCH from acting as an upsert.
+		// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/perform-conditional-operations-using-web-api#prevent-create-in-upsert
+		request.WriteString("If-Match: *\r\n")
+	}
+
+	request.WriteString("\r\n")
+	request.Write(o.Body)
+	request.WriteString("\r\n")
+
+	return request.Bytes()
+}
+
+// batchResponse is a single operation response extracted from the batch response.
+type batchResponse struct {
+	ContentID  string
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// parseBatchResponse walks multipart batch response, descending into change sets.
+func parseBatchResponse(contentType string, body []byte) ([]batchResponse, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, errors.Join(ErrBatchResponseFormat, err)
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("%w: content type %v", ErrBatchResponseFormat, mediaType)
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	responses := make([]batchResponse, 0)
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return responses, nil
+		}
+
+		if err != nil {
+			return nil, errors.Join(ErrBatchResponseFormat, err)
+		}
+
+		partBody, err := io.ReadAll(part)
+		if err != nil {
+			return nil, errors.Join(ErrBatchResponseFormat, err)
+		}
+
+		partType := part.Header.Get("Content-Type")
+		if strings.HasPrefix(partType, "multipart/") {
+			nested, err := parseBatchResponse(partType, partBody)
+			if err != nil {
+				return nil, err
+			}
+
+			responses = append(responses, nested...)
+
+			continue
+		}
+
+		response, err := parseOperationResponse(part.Header.Get("Content-ID"), partBody)
+		if err != nil {
+			return nil, err
+		}
+
+		responses = append(responses, *response)
+	}
+}
+
+func parseOperationResponse(contentID string, data []byte) (*batchResponse, error) {
+	rsp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), nil)
+	if err != nil {
+		return nil, errors.Join(ErrBatchResponseFormat, err)
+	}
+
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, errors.Join(ErrBatchResponseFormat, err)
+	}
+
+	return &batchResponse{
+		ContentID:  contentID,
+		StatusCode: rsp.StatusCode,
+		Header:     rsp.Header,
+		Body:       bytes.TrimSpace(body),
+	}, nil
+}
+
+func (r batchResponse) toError() error {
+	var payload CRMResponseError
+	if err := json.Unmarshal(r.Body, &payload); err == nil && payload.Err.Message != "" {
+		return fmt.Errorf("%w: %v", common.ErrRequestFailed, payload.Err.Message)
+	}
+
+	return fmt.Errorf("%w: status %v", common.ErrRequestFailed, r.StatusCode)
+}
+
+func (r batchResponse) recordID() string {
+	entityID := r.Header.Get("OData-EntityId")
+
+	matches := entityIdentifierRegex.FindStringSubmatch(entityID)
+	if len(matches) != 2 { // nolint:mnd
+		return ""
+	}
+
+	return matches[1]
+}
+
+func batchResponseMatcher(responses []batchResponse) common.BatchWriteResponseMatcher[batchOperation, batchResponse] {
+	registry := make(map[string]*batchResponse, len(responses))
+	for index := range responses {
+		registry[responses[index].ContentID] = &responses[index]
+	}
+
+	return func(_ int, operation batchOperation) *batchResponse {
+		return registry[operation.ContentID]
+	}
+}
+
+func batchResultBuilder(operation batchOperation, response *batchResponse) (*common.WriteResult, error) {
+	if response == nil {
+		// Change set was rolled back because some other operation has failed.
+		return &common.WriteResult{
+			Success:  false,
+			RecordId: operation.RecordID,
+			Errors:   []any{common.ErrBatchUnprocessedRecord},
+		}, nil
+	}
+
+	if !httpkit.Status2xx(response.StatusCode) {
+		return &common.WriteResult{
+			Success:  false,
+			RecordId: operation.RecordID,
+			Errors:   []any{response.toError()},
+		}, nil
+	}
+
+	recordID := response.recordID()
+	if recordID == "" {
+		recordID = operation.RecordID
+	}
+
+	return &common.WriteResult{
+		Success:  true,
+		RecordId: recordID,
+	}, nil
+}
@@ -0,0 +1,201 @@
+package dynamicscrm
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestBatchWrite(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responseCreateSuccess := testutils.DataFromFile(t, "batch/create-success.txt")
+	responseUpdateRollback := testutils.DataFromFile(t, "batch/update-rollback.txt")
+
+	createRecords := common.BatchItems{{
+		Record: map[string]any{"firstname": "Jane", "lastname": "Doe"},
+	}, {
+		Record: map[string]any{"firstname": "John", "lastname": "Doe"},
+	}}
+
+	updateRecords := common.BatchItems{{
+		Record: map[string]any{"id": "cdcfa450-cb0c-ea11-a813-000d3a1b1223", "fax": "614-555-0100"},
+	}, {
+		Record: map[string]any{"id": "9fd4a450-cb0c-ea11-a813-000d3a1b1223", "fax": "281-555-0100"},
+	}}
+
+	tests := []testroutines.BatchWrite{
+		{
+			Name:         "Object name must be included",
+			Input:        &common.BatchWriteParam{},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjects},
+		},
+		{
+			Name: "Batch write type is missing",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnknownBatchWriteType},
+		},
+		{
+			Name: "Update requires record identifier",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeUpdate,
+				Batch:      createRecords,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingRecordID},
+		},
+		{
+			Name: "Batch over the operation limit is rejected",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeCreate,
+				Batch:      make(common.BatchItems, maxBatchOperations+1),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrBatchTooLarge},
+		},
+		{
+			Name: "Rejected batch request is reported as error",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeCreate,
+				Batch:      createRecords,
+			},
+			Server: mockserver.Fixed{
+				Setup: mockserver.ContentJSON(),
+				Always: mockserver.ResponseString(http.StatusBadRequest, `{
+					"error": {
+						"code": "0x0",
+						"message": "The batch request must have a boundary."
+					}
+				}`),
+			}.Server(),
+			ExpectedErrs: []error{
+				common.ErrBadRequest, errors.New("The batch request must have a boundary."), // nolint:goerr113
+			},
+		},
+		{
+			Name: "Successful change set creates every record",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeCreate,
+				Batch:      createRecords,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentMIME(
+					"multipart/mixed; boundary=batchresponse_c1bd45c1-dd81-470d-b897-e965846aad2f"),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/api/data/v9.2/$batch"),
+					mockcond.Header(http.Header{"OData-Version": []string{"4.0"}}),
+				},
+				Then: mockserver.Response(http.StatusOK, responseCreateSuccess),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetBatchWrite,
+			Expected: &common.BatchWriteResult{
+				Status: common.BatchStatusSuccess,
+				Results: []common.WriteResult{{
+					Success:  true,
+					RecordId: "1ac9e0c5-6c3d-f011-877a-000d3a5c6a1e",
+				}, {
+					Success:  true,
+					RecordId: "1cc9e0c5-6c3d-f011-877a-000d3a5c6a1e",
+				}},
+				SuccessCount: 2,
+				FailureCount: 0,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Failed operation rolls back the whole change set",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeUpdate,
+				Batch:      updateRecords,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentMIME(
+					"multipart/mixed; boundary=batchresponse_0a1b0e3c-5d2c-4b4f-8d1e-6f6a9f8b4c21"),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/api/data/v9.2/$batch"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseUpdateRollback),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetBatchWrite,
+			Expected: &common.BatchWriteResult{
+				Status: common.BatchStatusFailure,
+				Results: []common.WriteResult{{
+					Success:  false,
+					RecordId: "cdcfa450-cb0c-ea11-a813-000d3a1b1223",
+					Errors:   []any{common.ErrBatchUnprocessedRecord},
+				}, {
+					Success:  false,
+					RecordId: "9fd4a450-cb0c-ea11-a813-000d3a1b1223",
+					Errors: []any{
+						errors.New("Contact With Id = 9fd4a450-cb0c-ea11-a813-000d3a1b1223 Does Not Exist"), // nolint:goerr113,lll
+					},
+				}},
+				SuccessCount: 0,
+				FailureCount: 2,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.BatchWriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestBatchWriteKeepsCallerRecords(t *testing.T) {
+	t.Parallel()
+
+	responseUpdateRollback := testutils.DataFromFile(t, "batch/update-rollback.txt")
+
+	server := mockserver.Fixed{
+		Setup: mockserver.ContentMIME(
+			"multipart/mixed; boundary=batchresponse_0a1b0e3c-5d2c-4b4f-8d1e-6f6a9f8b4c21"),
+		Always: mockserver.Response(http.StatusOK, responseUpdateRollback),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("failed to construct connector: %v", err)
+	}
+
+	record := map[string]any{"id": "cdcfa450-cb0c-ea11-a813-000d3a1b1223", "fax": "614-555-0100"}
+
+	_, err = connector.BatchWrite(t.Context(), &common.BatchWriteParam{
+		ObjectName: "contacts",
+		Type:       common.BatchWriteTypeUpdate,
+		Batch:      common.BatchItems{{Record: record}},
+	})
+	if err != nil {
+		t.Fatalf("failed to write batch: %v", err)
+	}
+
+	if record["id"] != "cdcfa450-cb0c-ea11-a813-000d3a1b1223" {
+		t.Fatalf("expected record to keep its id, got %v", record)
+	}
+}
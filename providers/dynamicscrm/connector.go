@@ -6,6 +6,7 @@ import (
 	"github.com/amp-labs/connectors/common/paramsbuilder"
 	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
 	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/datautils"
 	"github.com/amp-labs/connectors/providers"
 )
 
@@ -15,6 +16,7 @@ type Connector struct {
 	BaseURL                     string
 	Client                      *common.JSONHTTPClient
 	metadataDiscoveryRepository metadataDiscoveryRepository
+	changeTrackingRegistry      *datautils.Cache[string, bool]
 }
 
 func NewConnector(opts ...Option) (conn *Connector, outErr error) {
@@ -28,6 +30,7 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		Client: &common.JSONHTTPClient{
 			HTTPClient: httpClient,
 		},
+		changeTrackingRegistry: datautils.NewCache[string, bool](),
 	}
 
 	// Combine workspace and metadata catalog variables
@@ -3,9 +3,14 @@ package dynamicscrm
 import (
 	"fmt"
 
+	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/interpreter"
 )
 
+// Delta token is older than the change tracking retention period, full sync is required.
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/use-change-tracking-synchronize-data-external-systems#retrieve-changes-in-entities-using-web-api-example
+const errCodeVersionStampExpired = "0x80044352"
+
 var errorFormats = interpreter.NewFormatSwitch( // nolint:gochecknoglobals
 	[]interpreter.FormatTemplate{
 		{
@@ -33,6 +38,10 @@ type EnhancedCRMError struct {
 }
 
 func (r CRMResponseError) CombineErr(base error) error {
+	if r.Err.Code == errCodeVersionStampExpired {
+		base = fmt.Errorf("%w: %w", common.ErrCursorGone, base)
+	}
+
 	if len(r.Err.Message) > 0 {
 		return fmt.Errorf("%w: %s", base, r.Err.Message)
 	}
@@ -18,6 +18,7 @@ var (
 	ErrFetchAttributesPicklists = errors.New("failed to fetch object's PicklistType attributes")
 	ErrFetchAttributesStatuses  = errors.New("failed to fetch object's StatusType attributes")
 	ErrFetchAttributesStates    = errors.New("failed to fetch object's StateType attributes")
+	ErrFetchChangeTracking      = errors.New("failed to fetch object's change tracking setting")
 )
 
 // This repository is a grouping of Microsoft Dataverse API concerned with fetching Object metadata.
@@ -62,6 +63,40 @@ func (r metadataDiscoveryRepository) fetchEntityDefinition(
 	return common.UnmarshalJSON[entityDefinitionResponse](resp)
 }
 
+// fetchChangeTracking tells whether the object can be read using delta tokens.
+// Change tracking is an opt-in setting of each entity.
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/use-change-tracking-synchronize-data-external-systems
+func (r metadataDiscoveryRepository) fetchChangeTracking(
+	ctx context.Context, objectName naming.SingularString,
+) (enabled bool, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("%w: %w", ErrFetchChangeTracking, err)
+		}
+	}()
+
+	path := fmt.Sprintf("EntityDefinitions(LogicalName='%v')", objectName.String())
+
+	url, err := r.buildURL(path)
+	if err != nil {
+		return false, err
+	}
+
+	url.WithQueryParam("$select", "ChangeTrackingEnabled")
+
+	resp, err := r.performGetRequest(ctx, url)
+	if err != nil {
+		return false, errors.Join(ErrObjectNotFound, err)
+	}
+
+	definition, err := common.UnmarshalJSON[changeTrackingResponse](resp)
+	if err != nil {
+		return false, err
+	}
+
+	return definition.ChangeTrackingEnabled, nil
+}
+
 func (r metadataDiscoveryRepository) fetchAttributes(
 	ctx context.Context, objectName naming.SingularString,
 ) (dao *attributesResponse, err error) {
@@ -195,6 +230,11 @@ type entityDefinitionResponse struct {
 	} `json:"DisplayCollectionName"`
 }
 
+// nolint:tagliatelle
+type changeTrackingResponse struct {
+	ChangeTrackingEnabled bool `json:"ChangeTrackingEnabled"`
+}
+
 type attributesResponse struct {
 	Values []attributeItem `json:"value"`
 }
@@ -213,6 +253,10 @@ type attributeItem struct {
 		localizedLabels
 	} `json:"DisplayName"`
 	Format string `json:"Format"`
+	// MaxLength is defined for textual attributes.
+	MaxLength *int `json:"MaxLength,omitempty"`
+	// Precision is the number of digits after the decimal point of decimal and money attributes.
+	Precision *int `json:"Precision,omitempty"`
 }
 
 // nolint:tagliatelle
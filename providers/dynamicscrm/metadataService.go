@@ -102,13 +102,26 @@ func combineAttributesMetadata(
 		valueType := item.getValueType()
 		values := attributeOptions[item.LogicalName]
 
-		fieldsMap[name] = common.FieldMetadata{
+		field := common.FieldMetadata{
 			DisplayName:  item.getDisplayName(),
 			ValueType:    valueType,
 			ProviderType: item.AttributeTypeName.Value,
 			ReadOnly:     goutils.Pointer(!modifiable),
 			Values:       values,
 		}
+
+		switch valueType.BaseType() {
+		case common.ValueTypeString:
+			field.MaxLength = item.MaxLength
+		case common.ValueTypeFloat:
+			field.Scale = item.Precision
+		}
+
+		if valueType == common.ValueTypeReference {
+			field.ReferenceTo = item.Targets
+		}
+
+		fieldsMap[name] = field
 	}
 
 	return fieldsMap
@@ -160,14 +173,31 @@ func (item attributeItem) getDisplayName() string {
 // https://learn.microsoft.com/en-us/dynamics365/customerengagement/on-premises/developer/introduction-to-entity-attributes?view=op-9-1#types-of-attributes
 func (item attributeItem) getValueType() common.ValueType { // nolint:cyclop
 	switch item.AttributeTypeName.Value {
-	case "StringType", "MemoType":
+	case "StringType":
+		// https://learn.microsoft.com/en-us/dynamics365/customerengagement/on-premises/developer/introduction-to-entity-attributes?view=op-9-1#string-attributes
+		switch item.Format {
+		case "Email":
+			return common.ValueTypeEmail
+		case "Url":
+			return common.ValueTypeURL
+		case "Phone":
+			return common.ValueTypePhone
+		default:
+			return common.ValueTypeString
+		}
+	case "MemoType":
 		return common.ValueTypeString
 	case "BooleanType":
 		return common.ValueTypeBoolean
 	case "BigIntType", "IntegerType":
 		return common.ValueTypeInt
-	case "DecimalType", "MoneyType":
+	case "DecimalType", "DoubleType":
 		return common.ValueTypeFloat
+	case "MoneyType":
+		return common.ValueTypeCurrency
+	case "CustomerType", "LookupType", "OwnerType":
+		// https://learn.microsoft.com/en-us/dynamics365/customerengagement/on-premises/developer/introduction-to-entity-attributes?view=op-9-1#reference-data-attributes
+		return common.ValueTypeReference
 	case "DateTimeType":
 		// https://learn.microsoft.com/en-us/dynamics365/customerengagement/on-premises/developer/introduction-to-entity-attributes?view=op-9-1#date-and-time-data-attribute
 		switch item.Format {
@@ -186,9 +216,6 @@ func (item attributeItem) getValueType() common.ValueType { // nolint:cyclop
 		// ImageType.
 		// https://learn.microsoft.com/en-us/dynamics365/customerengagement/on-premises/developer/introduction-to-entity-attributes?view=op-9-1#image-data-attributes
 		//
-		// CustomerType, LookupType, OwnerType.
-		// https://learn.microsoft.com/en-us/dynamics365/customerengagement/on-premises/developer/introduction-to-entity-attributes?view=op-9-1#reference-data-attributes
-		//
 		// UniqueidentifierType.
 		// https://learn.microsoft.com/en-us/dynamics365/customerengagement/on-premises/developer/introduction-to-entity-attributes?view=op-9-1#unique-identifier-data-attributes
 		//
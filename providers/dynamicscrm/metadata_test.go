@@ -167,7 +167,7 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop,mai
 							},
 							"annualincome": {
 								DisplayName:  "Annual Income",
-								ValueType:    "float",
+								ValueType:    "currency",
 								ProviderType: "MoneyType",
 								ReadOnly:     goutils.Pointer(false),
 								Values:       nil,
@@ -263,17 +263,19 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop,mai
 							},
 							"_accountid_value": {
 								DisplayName:  "Account",
-								ValueType:    "other",
+								ValueType:    "reference",
 								ProviderType: "LookupType",
 								ReadOnly:     goutils.Pointer(true),
 								Values:       nil,
+								ReferenceTo:  []string{"account"},
 							},
 							"_createdby_value": {
 								DisplayName:  "Created By",
-								ValueType:    "other",
+								ValueType:    "reference",
 								ProviderType: "LookupType",
 								ReadOnly:     goutils.Pointer(true),
 								Values:       nil,
+								ReferenceTo:  []string{"systemuser"},
 							},
 						},
 						FieldsMap: map[string]string{
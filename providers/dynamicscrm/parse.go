@@ -1,10 +1,22 @@
 package dynamicscrm
 
 import (
+	"net/url"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
 	"github.com/amp-labs/connectors/internal/jsonquery"
 	"github.com/spyzhov/ajson"
 )
 
+const (
+	deltaTokenQueryParam = "$deltatoken"
+	// Removed records are described by the context ending with this suffix.
+	deletedEntityContextSuffix = "$deletedEntity"
+	modifiedOnField            = "modifiedon"
+)
+
 func getRecords(node *ajson.Node) ([]map[string]any, error) {
 	arr, err := jsonquery.New(node).ArrayRequired("value")
 	if err != nil {
@@ -17,3 +29,95 @@ func getRecords(node *ajson.Node) ([]map[string]any, error) {
 func getNextRecordsURL(node *ajson.Node) (string, error) {
 	return jsonquery.New(node).StrWithDefault("@odata.nextLink", "")
 }
+
+// parseChanges converts change tracking response into a ReadResult.
+// Deleted records carry only identifier, every other record is a complete snapshot.
+// The delta link is present on the last page only, its token is returned as SyncToken.
+//
+// Example of deleted record:
+//
+//	{
+//		"@odata.context": "https://org.crm.dynamics.com/api/data/v9.2/$metadata#contacts/$deletedEntity",
+//		"id": "2e451910-d587-e711-80e5-00155db19e6d",
+//		"reason": "deleted"
+//	}
+func parseChanges(rsp *common.JSONHTTPResponse, config common.ReadParams) (*common.ReadResult, error) {
+	body, ok := rsp.Body()
+	if !ok {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	records, err := getRecords(body)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPage, err := getNextRecordsURL(body)
+	if err != nil {
+		return nil, err
+	}
+
+	syncToken, err := getDeltaToken(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initial tracking read lists every record, older ones are not part of the requested window.
+	skipOlder := config.SyncToken == "" && !config.Since.IsZero()
+	primaryKey := naming.NewSingularString(config.ObjectName).String() + "id"
+	fields := config.Fields.List()
+	rows := make([]common.ReadResultRow, 0, len(records))
+
+	for _, record := range records {
+		if isDeletedEntity(record) {
+			identifier, _ := record["id"].(string)
+			rows = append(rows, common.ReadResultRow{
+				Fields:  map[string]any{},
+				Raw:     record,
+				Id:      identifier,
+				Deleted: true,
+			})
+
+			continue
+		}
+
+		if skipOlder && isModifiedBefore(record, config.Since) {
+			continue
+		}
+
+		identifier, _ := record[primaryKey].(string)
+		rows = append(rows, common.ReadResultRow{
+			Fields: common.ExtractLowercaseFieldsFromRaw(fields, record),
+			Raw:    record,
+			Id:     identifier,
+		})
+	}
+
+	return &common.ReadResult{
+		Rows:      int64(len(rows)),
+		Data:      rows,
+		NextPage:  common.NextPageToken(nextPage),
+		Done:      nextPage == "",
+		SyncToken: syncToken,
+	}, nil
+}
+
+func isDeletedEntity(record map[string]any) bool {
+	odataContext, _ := record["@odata.context"].(string)
+
+	return strings.HasSuffix(odataContext, deletedEntityContextSuffix)
+}
+
+func getDeltaToken(node *ajson.Node) (string, error) {
+	deltaLink, err := jsonquery.New(node).StrWithDefault("@odata.deltaLink", "")
+	if err != nil || deltaLink == "" {
+		return "", err
+	}
+
+	link, err := url.Parse(deltaLink)
+	if err != nil {
+		return "", err
+	}
+
+	return link.Query().Get(deltaTokenQueryParam), nil
+}
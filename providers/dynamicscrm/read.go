@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
 	"github.com/amp-labs/connectors/common/urlbuilder"
 )
 
@@ -13,38 +15,70 @@ import (
 // Microsoft API supports other capabilities like filtering, grouping,
 // and sorting which we can potentially tap into later.
 //
+// Incremental reads rely on change tracking, which must be enabled per entity.
+// When ReadParams.SyncToken is set, only records changed since the delta token was issued are returned,
+// including deleted records. When only ReadParams.Since is set and the entity is tracked,
+// records modified before Since are skipped and the last page returns the first delta token.
+// An expired delta token fails the read with common.ErrCursorGone, the caller must then read without it.
+//
 // nolint:lll
 // See https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/query-data-web-api#odata-query-options
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/use-change-tracking-synchronize-data-external-systems
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	if err := config.ValidateParams(true); err != nil {
 		return nil, err
 	}
 
-	url, err := c.buildReadURL(config)
+	tracking, err := c.isChangeTracked(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.read(ctx, config, tracking)
+}
+
+func (c *Connector) read(ctx context.Context, config common.ReadParams, tracking bool) (*common.ReadResult, error) {
+	url, err := c.buildReadURL(config, tracking)
 	if err != nil {
 		return nil, err
 	}
 
 	// always include annotations header
 	// response will describe enums, foreign relationship, etc.
-	rsp, err := c.Client.Get(ctx, url.String(), newPaginationHeader(DefaultPageSize), common.Header{
-		Key:   "Prefer",
-		Value: `odata.include-annotations="*"`,
-	})
+	headers := []common.Header{
+		newPaginationHeader(DefaultPageSize),
+		{
+			Key:   "Prefer",
+			Value: `odata.include-annotations="*"`,
+		},
+	}
+
+	if tracking {
+		headers = append(headers, common.Header{
+			Key:   "Prefer",
+			Value: "odata.track-changes",
+		})
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String(), headers...)
 	if err != nil {
 		return nil, err
 	}
 
-	return common.ParseResult(
-		rsp,
-		getRecords,
-		getNextRecordsURL,
-		common.GetMarshaledData,
-		config.Fields,
-	)
+	if !tracking {
+		return common.ParseResult(
+			rsp,
+			getRecords,
+			getNextRecordsURL,
+			common.GetMarshaledData,
+			config.Fields,
+		)
+	}
+
+	return parseChanges(rsp, config)
 }
 
-func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, error) {
+func (c *Connector) buildReadURL(config common.ReadParams, tracking bool) (*urlbuilder.URL, error) {
 	if len(config.NextPage) != 0 {
 		// Next page
 		return constructURL(config.NextPage.String())
@@ -57,16 +91,69 @@ func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, err
 	}
 
 	fields := config.Fields.List()
+	if tracking && config.SyncToken == "" && !config.Since.IsZero() {
+		// Records older than Since are filtered out locally, modification time must be present.
+		fields = append(fields, modifiedOnField)
+	}
+
 	if len(fields) != 0 {
 		url.WithQueryParam("$select", strings.Join(fields, ","))
 	}
 
+	if tracking && config.SyncToken != "" {
+		url.WithQueryParam(deltaTokenQueryParam, config.SyncToken)
+	}
+
 	return url, nil
 }
 
+// isChangeTracked decides if the read should be served using delta tokens.
+//
+// Delta token implies tracking. For time based reads the entity setting is checked once,
+// then cached. Assumes provider configuration doesn't change during the connector lifetime.
+func (c *Connector) isChangeTracked(ctx context.Context, config common.ReadParams) (bool, error) {
+	if config.SyncToken != "" {
+		return true, nil
+	}
+
+	if config.Since.IsZero() {
+		return false, nil
+	}
+
+	enabled, found := c.changeTrackingRegistry.Get(config.ObjectName)
+	if !found {
+		var err error
+
+		enabled, err = c.metadataDiscoveryRepository.fetchChangeTracking(ctx,
+			naming.NewSingularString(config.ObjectName))
+		if err != nil {
+			return false, err
+		}
+
+		c.changeTrackingRegistry.Set(config.ObjectName, enabled)
+	}
+
+	return enabled, nil
+}
+
 func newPaginationHeader(pageSize int) common.Header {
 	return common.Header{
 		Key:   "Prefer",
 		Value: fmt.Sprintf("odata.maxpagesize=%v", pageSize),
 	}
 }
+
+// isModifiedBefore reports if record modification time is known and precedes the timestamp.
+func isModifiedBefore(record map[string]any, timestamp time.Time) bool {
+	value, ok := record[modifiedOnField].(string)
+	if !ok {
+		return false
+	}
+
+	modifiedOn, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+
+	return modifiedOn.Before(timestamp)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
@@ -19,6 +20,11 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop
 	t.Parallel()
 
 	responseContactsGet := testutils.DataFromFile(t, "contacts-read.json")
+	responseContactsChanges := testutils.DataFromFile(t, "read/contacts-changes.json")
+	responseContactsTrackingInitial := testutils.DataFromFile(t, "read/contacts-tracking-initial.json")
+	errorVersionStampExpired := testutils.DataFromFile(t, "read/error-version-stamp-expired.json")
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := []testroutines.Read{
 		{
@@ -126,6 +132,129 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Delta token returns changed and deleted records",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				SyncToken:  "918911!08/23/2025 13:02:47",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/api/data/v9.2/contacts"),
+					mockcond.QueryParam("$deltatoken", "918911!08/23/2025 13:02:47"),
+					mockcond.Header(http.Header{"Prefer": []string{"odata.track-changes"}}),
+				},
+				Then: mockserver.Response(http.StatusOK, responseContactsChanges),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"fullname": "Heriberto Nathan"},
+					Raw:    map[string]any{"contactid": "cdcfa450-cb0c-ea11-a813-000d3a1b1223"},
+					Id:     "cdcfa450-cb0c-ea11-a813-000d3a1b1223",
+				}, {
+					Fields:  map[string]any{},
+					Raw:     map[string]any{"reason": "deleted"},
+					Id:      "9fd4a450-cb0c-ea11-a813-000d3a1b1223",
+					Deleted: true,
+				}},
+				Done:      true,
+				SyncToken: "919043!08/23/2025 14:10:25",
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Tracked entity read since timestamp skips older records and issues delta token",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				Since:      since,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.Path("/api/data/v9.2/EntityDefinitions(LogicalName='contact')"),
+						mockcond.QueryParam("$select", "ChangeTrackingEnabled"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"ChangeTrackingEnabled": true}`),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/api/data/v9.2/contacts"),
+						mockcond.QueryParam("$select", "fullname,modifiedon"),
+						mockcond.QueryParamsMissing("$filter", "$deltatoken"),
+						mockcond.Header(http.Header{"Prefer": []string{"odata.track-changes"}}),
+					},
+					Then: mockserver.Response(http.StatusOK, responseContactsTrackingInitial),
+				}},
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"fullname": "Heriberto Nathan"},
+					Raw:    map[string]any{"contactid": "cdcfa450-cb0c-ea11-a813-000d3a1b1223"},
+					Id:     "cdcfa450-cb0c-ea11-a813-000d3a1b1223",
+				}},
+				Done:      true,
+				SyncToken: "918911!08/23/2025 13:02:47",
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Entity without change tracking is read without delta token",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				Since:      since,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.Path("/api/data/v9.2/EntityDefinitions(LogicalName='contact')"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"ChangeTrackingEnabled": false}`),
+				}, {
+					If:   mockcond.Path("/api/data/v9.2/contacts"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"value": []}`),
+				}},
+			}.Server(),
+			Expected:     &common.ReadResult{Done: true, Data: []common.ReadResultRow{}},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Expired delta token requires full sync",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				SyncToken:  "100!01/01/2024 00:00:00",
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.QueryParam("$deltatoken", "100!01/01/2024 00:00:00"),
+					Then: mockserver.Response(http.StatusBadRequest, errorVersionStampExpired),
+				}},
+			}.Server(),
+			ExpectedErrs: []error{common.ErrCursorGone},
+		},
+		{
+			Name: "Expired delta token on next page is reported",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				SyncToken:  "100!01/01/2024 00:00:00",
+				NextPage: testroutines.URLTestServer +
+					"/api/data/v9.2/contacts?$deltatoken=100%2101%2f01%2f2024&$skiptoken=2",
+			},
+			Server: mockserver.Fixed{
+				Setup:  mockserver.ContentJSON(),
+				Always: mockserver.Response(http.StatusBadRequest, errorVersionStampExpired),
+			}.Server(),
+			ExpectedErrs: []error{common.ErrCursorGone},
+		},
 	}
 
 	for _, tt := range tests {
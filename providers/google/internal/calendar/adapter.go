@@ -1,6 +1,7 @@
 package calendar
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/amp-labs/connectors/common"
@@ -35,7 +36,11 @@ func constructor(base *components.Connector) (*Adapter, error) {
 	}
 
 	errorHandler := interpreter.ErrorHandler{
-		JSON: interpreter.NewFaultyResponder(errorFormats, nil),
+		JSON: interpreter.NewFaultyResponder(errorFormats, map[int]error{
+			// Sync token is no longer valid, the caller must wipe the local store and do a full sync.
+			// https://developers.google.com/workspace/calendar/api/guides/sync#full_sync_required_by_server
+			http.StatusGone: common.ErrCursorGone,
+		}),
 		HTML: interpreter.DirectFaultyResponder{Callback: adapter.interpretHTMLError},
 	}.Handle
 
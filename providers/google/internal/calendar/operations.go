@@ -39,6 +39,10 @@ func (a *Adapter) buildReadRequest(ctx context.Context, params common.ReadParams
 }
 
 func (a *Adapter) buildReadURL(params common.ReadParams) (*urlbuilder.URL, error) {
+	if params.SyncToken != "" && params.ObjectName != objectNameEvents {
+		return nil, fmt.Errorf("%w: sync token for %v", common.ErrOperationNotSupportedForObject, params.ObjectName)
+	}
+
 	if len(params.NextPage) != 0 {
 		return urlbuilder.New(params.NextPage.String())
 	}
@@ -60,6 +64,14 @@ func (a *Adapter) buildReadURL(params common.ReadParams) (*urlbuilder.URL, error
 	}
 
 	if params.ObjectName == objectNameEvents {
+		// Sync token cannot be combined with time filters, deleted events are always included.
+		// https://developers.google.com/workspace/calendar/api/guides/sync
+		if params.SyncToken != "" {
+			url.WithQueryParam("syncToken", params.SyncToken)
+
+			return url, nil
+		}
+
 		// https://developers.google.com/workspace/calendar/api/v3/reference/events/list
 		if !params.Since.IsZero() {
 			url.WithQueryParam("updatedMin", datautils.Time.FormatRFC3339inUTCWithMilliseconds(params.Since))
@@ -82,12 +94,25 @@ func (a *Adapter) parseReadResponse(
 		return nil, err
 	}
 
-	return common.ParseResult(resp,
+	result, err := common.ParseResult(resp,
 		common.ExtractOptionalRecordsFromPath(responseFieldName),
 		makeNextRecordsURL(url),
-		common.GetMarshaledData,
+		getMarshaledData(params.ObjectName),
 		params.Fields,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if body, ok := resp.Body(); ok && params.ObjectName == objectNameEvents {
+		// The last page of events carries a token for the next incremental read.
+		result.SyncToken, err = jsonquery.New(body).StrWithDefault("nextSyncToken", "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 
 func makeNextRecordsURL(url *urlbuilder.URL) common.NextPageFunc {
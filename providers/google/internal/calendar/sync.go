@@ -0,0 +1,29 @@
+package calendar
+
+import (
+	"github.com/amp-labs/connectors/common"
+)
+
+// Cancelled events are returned by incremental reads in place of deleted ones.
+// https://developers.google.com/workspace/calendar/api/v3/reference/events#status
+const eventStatusCancelled = "cancelled"
+
+func getMarshaledData(objectName string) common.MarshalFunc {
+	if objectName != objectNameEvents {
+		return common.GetMarshaledData
+	}
+
+	return func(records []map[string]any, fields []string) ([]common.ReadResultRow, error) {
+		rows, err := common.GetMarshaledData(records, fields)
+		if err != nil {
+			return nil, err
+		}
+
+		for index, record := range records {
+			rows[index].Id, _ = record["id"].(string)
+			rows[index].Deleted = record["status"] == eventStatusCancelled
+		}
+
+		return rows, nil
+	}
+}
@@ -35,6 +35,9 @@ func constructor(base *components.Connector) (*Adapter, error) {
 		HTML: &interpreter.DirectFaultyResponder{Callback: adapter.interpretHTMLError},
 	}.Handle
 
+	// Requests made outside the reader, such as profile lookup, share error interpretation.
+	adapter.SetErrorHandler(errorHandler)
+
 	adapter.Reader = reader.NewHTTPReader(
 		adapter.HTTPClient().Client,
 		components.NewEmptyEndpointRegistry(),
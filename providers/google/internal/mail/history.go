@@ -0,0 +1,213 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/readhelper"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/jsonquery"
+)
+
+const objectNameMessages = "messages"
+
+// Changes which are relevant when replicating messages.
+// https://developers.google.com/workspace/gmail/api/reference/rest/v1/users.history/list
+var historyTypes = []string{ // nolint:gochecknoglobals
+	"messageAdded", "messageDeleted", "labelAdded", "labelRemoved",
+}
+
+// Read delegates to the HTTP reader, extending messages with history based incremental reads.
+//
+// When the caller has no history ID, the mailbox position is captured before listing messages
+// and is returned as the sync token. Any change made while the listing is in progress
+// will therefore be replayed by the next incremental read.
+//
+// Gmail answers 404 Not Found when the history ID is too old or invalid, which is reported as ErrCursorGone.
+// The caller must then do a full synchronization by reading without the sync token.
+// https://developers.google.com/workspace/gmail/api/guides/sync#full_synchronization
+func (a *Adapter) Read(ctx context.Context, params common.ReadParams) (*common.ReadResult, error) {
+	if params.ObjectName != objectNameMessages {
+		return a.Reader.Read(ctx, params)
+	}
+
+	if err := params.ValidateParams(true); err != nil {
+		return nil, err
+	}
+
+	if params.SyncToken != "" {
+		result, err := a.Reader.Read(ctx, params)
+		if err == nil || !errors.Is(err, common.ErrNotFound) {
+			return result, err
+		}
+
+		return nil, errors.Join(common.ErrCursorGone, err)
+	}
+
+	if len(params.NextPage) != 0 {
+		return a.Reader.Read(ctx, params)
+	}
+
+	historyID, err := a.fetchHistoryID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.Reader.Read(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result.SyncToken = historyID
+
+	return result, nil
+}
+
+// fetchHistoryID returns the current position of the mailbox.
+// https://developers.google.com/workspace/gmail/api/reference/rest/v1/users/getProfile
+func (a *Adapter) fetchHistoryID(ctx context.Context) (string, error) {
+	url, err := urlbuilder.New(a.ModuleInfo().BaseURL, apiVersion, "/users/me/profile")
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := a.JSONHTTPClient().Get(ctx, url.String())
+	if err != nil {
+		return "", err
+	}
+
+	body, ok := resp.Body()
+	if !ok {
+		return "", common.ErrEmptyJSONHTTPResponse
+	}
+
+	return jsonquery.New(body).StringRequired("historyId")
+}
+
+// https://developers.google.com/workspace/gmail/api/reference/rest/v1/users.history/list
+func (a *Adapter) getHistoryURL(params common.ReadParams) (*urlbuilder.URL, error) {
+	url, err := urlbuilder.New(a.ModuleInfo().BaseURL, apiVersion, "/users/me/history")
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("startHistoryId", params.SyncToken)
+	url.WithQueryParamList("historyTypes", historyTypes)
+	url.WithQueryParam("maxResults", readhelper.PageSizeWithDefaultStr(params, defaultPageSize))
+
+	if params.NextPage != "" {
+		url.WithQueryParam("pageToken", params.NextPage.String())
+	}
+
+	return url, nil
+}
+
+type historyResponse struct {
+	History       []historyRecord `json:"history"`
+	NextPageToken string          `json:"nextPageToken"`
+	HistoryID     string          `json:"historyId"`
+}
+
+type historyRecord struct {
+	MessagesAdded   []messageChange `json:"messagesAdded"`
+	MessagesDeleted []messageChange `json:"messagesDeleted"`
+	LabelsAdded     []messageChange `json:"labelsAdded"`
+	LabelsRemoved   []messageChange `json:"labelsRemoved"`
+}
+
+type messageChange struct {
+	Message map[string]any `json:"message"`
+}
+
+// messageState is the latest known state of a message within one page of history.
+type messageState struct {
+	record  map[string]any
+	deleted bool
+}
+
+// parseHistoryResponse collapses history records per message,
+// producing one row with the latest message snapshot for every changed message.
+func parseHistoryResponse(params common.ReadParams, resp *common.JSONHTTPResponse) (*common.ReadResult, error) {
+	body, ok := resp.Body()
+	if !ok {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	history, err := jsonquery.ParseNode[historyResponse](body)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]string, 0)
+	states := make(map[string]*messageState)
+
+	track := func(changes []messageChange, deleted bool) {
+		for _, change := range changes {
+			identifier, _ := change.Message["id"].(string)
+			if identifier == "" {
+				continue
+			}
+
+			state, found := states[identifier]
+			if !found {
+				state = &messageState{}
+				states[identifier] = state
+				order = append(order, identifier)
+			}
+
+			state.record = change.Message
+			// Once removed, message cannot reappear with the same identifier.
+			state.deleted = state.deleted || deleted
+		}
+	}
+
+	for _, record := range history.History {
+		track(record.MessagesAdded, false)
+		track(record.LabelsAdded, false)
+		track(record.LabelsRemoved, false)
+		track(record.MessagesDeleted, true)
+	}
+
+	fields := params.Fields.List()
+	rows := make([]common.ReadResultRow, len(order))
+
+	for index, identifier := range order {
+		state := states[identifier]
+		rows[index] = common.ReadResultRow{
+			Fields:  common.ExtractLowercaseFieldsFromRaw(fields, state.record),
+			Raw:     state.record,
+			Id:      identifier,
+			Deleted: state.deleted,
+		}
+	}
+
+	result := &common.ReadResult{
+		Rows:     int64(len(rows)),
+		Data:     rows,
+		NextPage: common.NextPageToken(history.NextPageToken),
+		Done:     history.NextPageToken == "",
+	}
+
+	if result.Done {
+		// Mailbox position is final only after the last page.
+		result.SyncToken = history.HistoryID
+	}
+
+	return result, nil
+}
+
+func (a *Adapter) buildHistoryRequest(ctx context.Context, params common.ReadParams) (*http.Request, error) {
+	if params.ObjectName != objectNameMessages {
+		return nil, fmt.Errorf("%w: sync token for %v", common.ErrOperationNotSupportedForObject, params.ObjectName)
+	}
+
+	url, err := a.getHistoryURL(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+}
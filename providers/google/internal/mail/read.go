@@ -23,6 +23,10 @@ func (a *Adapter) buildReadRequest(ctx context.Context, params common.ReadParams
 		return nil, err
 	}
 
+	if params.SyncToken != "" {
+		return a.buildHistoryRequest(ctx, params)
+	}
+
 	url, err := a.getReadURL(params.ObjectName)
 	if err != nil {
 		return nil, err
@@ -76,6 +80,10 @@ func (a *Adapter) parseReadResponse(
 	request *http.Request,
 	resp *common.JSONHTTPResponse,
 ) (*common.ReadResult, error) {
+	if params.SyncToken != "" {
+		return parseHistoryResponse(params, resp)
+	}
+
 	responseFieldName := Schemas.LookupArrayFieldName(a.Module(), params.ObjectName)
 
 	return common.ParseResult(resp,
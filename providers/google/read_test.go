@@ -25,6 +25,8 @@ func TestCalendarRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 	responseSettingsLastPage := testutils.DataFromFile(t, "calendar/read/settings/2-last-page.json")
 	responseEventsFirstPage := testutils.DataFromFile(t, "calendar/read/events/1-first-page.json")
 	responseEventsLastPage := testutils.DataFromFile(t, "calendar/read/events/2-last-page.json")
+	responseEventsSyncChanges := testutils.DataFromFile(t, "calendar/read/events/sync-changes.json")
+	errorSyncTokenGone := testutils.DataFromFile(t, "calendar/read/events/error-sync-token-gone.json")
 
 	tests := []testroutines.Read{
 		{
@@ -267,7 +269,7 @@ func TestCalendarRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 				If:    mockcond.Path("/calendar/v3/calendars/primary/events"),
 				Then:  mockserver.Response(http.StatusOK, responseEventsLastPage),
 			}.Server(),
-			Comparator: testroutines.ComparatorSubsetRead,
+			Comparator: testroutines.ComparatorSubsetChanges,
 			Expected: &common.ReadResult{
 				Rows: 1,
 				Data: []common.ReadResultRow{{
@@ -280,12 +282,101 @@ func TestCalendarRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 						"summary": "Meeting with Integration",
 						"iCalUID": "p3nkh1hg41683vdhlcq4k12iso@google.com",
 					},
+					Id: "p3nkh1hg41683vdhlcq4k12iso",
 				}},
-				NextPage: "",
-				Done:     true,
+				NextPage:  "",
+				Done:      true,
+				SyncToken: "CO_5ie79sI4DEO_5ie79sI4DGAUgpczu8wIopczu8wI=",
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Read events changes using sync token",
+			Input: common.ReadParams{
+				ObjectName: "events",
+				Fields:     connectors.Fields("summary"),
+				Since:      time.Date(2024, 9, 19, 4, 30, 45, 600, time.UTC),
+				SyncToken:  "CO_5ie79sI4DEO_5ie79sI4DGAUgpczu8wIopczu8wI=",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/calendar/v3/calendars/primary/events"),
+					mockcond.QueryParam("syncToken", "CO_5ie79sI4DEO_5ie79sI4DGAUgpczu8wIopczu8wI="),
+					mockcond.QueryParamsMissing("updatedMin"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseEventsSyncChanges),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"summary": "Meeting with Integration (rescheduled)",
+					},
+					Raw: map[string]any{
+						"status": "confirmed",
+					},
+					Id: "p3nkh1hg41683vdhlcq4k12iso",
+				}, {
+					Fields: map[string]any{},
+					Raw: map[string]any{
+						"status": "cancelled",
+					},
+					Id:      "4of57j0lu6oqjdo62om7juoe3k",
+					Deleted: true,
+				}},
+				NextPage:  "",
+				Done:      true,
+				SyncToken: "CPDAlvWGtY4DEPDAlvWGtY4DGAUg7Mzu8wIo7Mzu8wI=",
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Expired sync token requires full sync",
+			Input: common.ReadParams{
+				ObjectName: "events",
+				Fields:     connectors.Fields("id"),
+				SyncToken:  "CAxDEkcMxKxzpIsxZzm5MjAyNC0wNy0xMFQxMzo1NzowNy4yMjBa",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.QueryParam("syncToken", "CAxDEkcMxKxzpIsxZzm5MjAyNC0wNy0xMFQxMzo1NzowNy4yMjBa"),
+				Then:  mockserver.Response(http.StatusGone, errorSyncTokenGone),
+			}.Server(),
+			ExpectedErrs: []error{
+				common.ErrCursorGone,
+				errors.New("Sync token is no longer valid, a full sync is required."),
+			},
+		},
+		{
+			Name: "Expired sync token on the next page is reported",
+			Input: common.ReadParams{
+				ObjectName: "events",
+				Fields:     connectors.Fields("id"),
+				SyncToken:  "CAxDEkcMxKxzpIsxZzm5MjAyNC0wNy0xMFQxMzo1NzowNy4yMjBa",
+				NextPage: testroutines.URLTestServer + "/calendar/v3/calendars/primary/events?maxResults=3000" +
+					"&syncToken=CAxDEkcMxKxzpIsxZzm5MjAyNC0wNy0xMFQxMzo1NzowNy4yMjBa&pageToken=CkAKMAouCgwIwcaswgYQ",
+			},
+			Server: mockserver.Fixed{
+				Setup:  mockserver.ContentJSON(),
+				Always: mockserver.Response(http.StatusGone, errorSyncTokenGone),
+			}.Server(),
+			ExpectedErrs: []error{
+				common.ErrCursorGone,
+				errors.New("Sync token is no longer valid, a full sync is required."),
+			},
+		},
+		{
+			Name: "Sync token is not supported for calendar list",
+			Input: common.ReadParams{
+				ObjectName: "calendarList",
+				Fields:     connectors.Fields("id"),
+				SyncToken:  "CO_5ie79sI4DEO_5ie79sI4DGAUgpczu8wIopczu8wI=",
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
 	}
 
 	for _, tt := range tests {
@@ -529,6 +620,10 @@ func TestMailRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 	errorNotFound := testutils.DataFromFile(t, "mail/not-found.html")
 	responseMessagesFirstPage := testutils.DataFromFile(t, "mail/read/messages/1-first-page.json")
 	responseMessagesLastPage := testutils.DataFromFile(t, "mail/read/messages/2-last-page.json")
+	responseProfile := testutils.DataFromFile(t, "mail/read/profile.json")
+	responseHistoryFirstPage := testutils.DataFromFile(t, "mail/read/history/1-first-page.json")
+	responseHistoryLastPage := testutils.DataFromFile(t, "mail/read/history/2-last-page.json")
+	errorHistoryNotFound := testutils.DataFromFile(t, "mail/read/history/error-not-found.json")
 
 	tests := []testroutines.Read{
 		{
@@ -577,16 +672,21 @@ func TestMailRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 				Since: time.Date(2024, 9, 19, 23, 0, 0, 0,
 					time.FixedZone("UTC-8", -8*60*60)),
 			},
-			Server: mockserver.Conditional{
+			Server: mockserver.Switch{
 				Setup: mockserver.ContentJSON(),
-				If: mockcond.And{
-					mockcond.Path("/gmail/v1/users/me/messages"),
-					mockcond.QueryParam("maxResults", "33"),      // from params
-					mockcond.QueryParam("q", "after:2024/09/20"), // it is 20 due to time zone
-				},
-				Then: mockserver.Response(http.StatusOK, responseMessagesFirstPage),
+				Cases: []mockserver.Case{{
+					If:   mockcond.Path("/gmail/v1/users/me/profile"),
+					Then: mockserver.Response(http.StatusOK, responseProfile),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/gmail/v1/users/me/messages"),
+						mockcond.QueryParam("maxResults", "33"),      // from params
+						mockcond.QueryParam("q", "after:2024/09/20"), // it is 20 due to time zone
+					},
+					Then: mockserver.Response(http.StatusOK, responseMessagesFirstPage),
+				}},
 			}.Server(),
-			Comparator: testroutines.ComparatorSubsetRead,
+			Comparator: testroutines.ComparatorSubsetChanges,
 			Expected: &common.ReadResult{
 				Rows: 2,
 				Data: []common.ReadResultRow{{
@@ -604,8 +704,9 @@ func TestMailRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 						"threadId": "1993fa50bd191f7b",
 					},
 				}},
-				NextPage: "08277485409175924556",
-				Done:     false,
+				NextPage:  "08277485409175924556",
+				Done:      false,
+				SyncToken: "1874352",
 			},
 			ExpectedErrs: nil,
 		},
@@ -644,6 +745,129 @@ func TestMailRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Read messages changes first page",
+			Input: common.ReadParams{
+				ObjectName: "messages",
+				Fields:     connectors.Fields("labelIds"),
+				SyncToken:  "1874352",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/gmail/v1/users/me/history"),
+					mockcond.QueryParam("startHistoryId", "1874352"),
+					mockcond.QueryParam("historyTypes", "messageAdded", "messageDeleted", "labelAdded", "labelRemoved"),
+					mockcond.QueryParam("maxResults", "500"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseHistoryFirstPage),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"labelids": []any{"INBOX"},
+					},
+					Raw: map[string]any{
+						"threadId": "1993fa50bd191f7b",
+					},
+					Id: "1993fb4b539b5a1a",
+				}, {
+					Fields: map[string]any{
+						"labelids": []any{"STARRED", "INBOX"},
+					},
+					Raw: map[string]any{
+						"threadId": "19174f3eeda702ed",
+					},
+					Id: "19174f3eeda702ed",
+				}},
+				NextPage:  "04416380498374290815",
+				Done:      false,
+				SyncToken: "",
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Read messages changes last page",
+			Input: common.ReadParams{
+				ObjectName: "messages",
+				Fields:     connectors.Fields("labelIds"),
+				SyncToken:  "1874352",
+				NextPage:   "04416380498374290815",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/gmail/v1/users/me/history"),
+					mockcond.QueryParam("startHistoryId", "1874352"),
+					mockcond.QueryParam("pageToken", "04416380498374290815"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseHistoryLastPage),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"labelids": []any{"TRASH"},
+					},
+					Raw: map[string]any{
+						"threadId": "1993fa50bd191f7b",
+					},
+					Id:      "1993fa50bd191f7b",
+					Deleted: true,
+				}},
+				NextPage:  "",
+				Done:      true,
+				SyncToken: "1874415",
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Expired history ID requires full sync",
+			Input: common.ReadParams{
+				ObjectName: "messages",
+				Fields:     connectors.Fields("id"),
+				SyncToken:  "1000",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/gmail/v1/users/me/history"),
+				Then:  mockserver.Response(http.StatusNotFound, errorHistoryNotFound),
+			}.Server(),
+			ExpectedErrs: []error{
+				common.ErrCursorGone,
+				common.ErrNotFound,
+			},
+		},
+		{
+			Name: "Expired history ID on the next page is reported",
+			Input: common.ReadParams{
+				ObjectName: "messages",
+				Fields:     connectors.Fields("id"),
+				SyncToken:  "1000",
+				NextPage:   "04416380498374290815",
+			},
+			Server: mockserver.Fixed{
+				Setup:  mockserver.ContentJSON(),
+				Always: mockserver.Response(http.StatusNotFound, errorHistoryNotFound),
+			}.Server(),
+			ExpectedErrs: []error{
+				common.ErrCursorGone,
+				common.ErrNotFound,
+			},
+		},
+		{
+			Name: "Sync token is not supported for drafts",
+			Input: common.ReadParams{
+				ObjectName: "drafts",
+				Fields:     connectors.Fields("id"),
+				SyncToken:  "1874352",
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
 	}
 
 	for _, tt := range tests {
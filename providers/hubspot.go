@@ -61,6 +61,16 @@ func init() { //nolint:funlen
 							ObjectRecordLimits: nil,
 							Supported:          true,
 						},
+						Upsert: BatchWriteSupportConfig{
+							DefaultRecordLimit: goutils.Pointer(100), // nolint:mnd
+							ObjectRecordLimits: nil,
+							Supported:          true,
+						},
+						Delete: BatchWriteSupportConfig{
+							DefaultRecordLimit: goutils.Pointer(100), // nolint:mnd
+							ObjectRecordLimits: nil,
+							Supported:          true,
+						},
 					},
 					Read:      true,
 					Subscribe: false,
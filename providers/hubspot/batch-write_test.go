@@ -19,6 +19,7 @@ func TestBatchCreate(t *testing.T) { // nolint:funlen,gocognit,cyclop,maintidx
 	errConflictExisting := testutils.DataFromFile(t, "batch/create/contacts/err-conflict.json")
 	errManyInvalidFields := testutils.DataFromFile(t, "batch/create/contacts/err-many-invalid-properties.json")
 	responseCreateContacts := testutils.DataFromFile(t, "batch/create/contacts/success.json")
+	errCreatePartial := testutils.DataFromFile(t, "batch/create/contacts/err-partial-success.json")
 
 	createRecords := common.BatchItems{{
 		Record: map[string]any{
@@ -142,6 +143,53 @@ func TestBatchCreate(t *testing.T) { // nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Partial success reports failed records by trace identifier",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeCreate,
+				Batch:      createRecords,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/crm/v3/objects/contacts/batch/create"),
+					mockcond.Body(`{"inputs":[
+						{"properties":{"email":"Markus.Blevins@hubspot.com","firstname":"Markus","lastname":"Blevins"},
+						"objectWriteTraceId":"0"},
+						{"properties":{"email":"Siena.Dyer@hubspot.com","firstname":"Siena","lastname":"Dyer"},
+						"objectWriteTraceId":"1"}]}`),
+				},
+				Then: mockserver.Response(http.StatusMultiStatus, errCreatePartial),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetBatchWrite,
+			Expected: &common.BatchWriteResult{
+				Status: common.BatchStatusPartial,
+				Errors: []any{},
+				Results: []common.WriteResult{{
+					Success: false,
+					Errors: []any{mockutils.JSONErrorWrapper(`{
+					  "status": "error",
+					  "category": "VALIDATION_ERROR",
+					  "message": "Contact already exists. Existing ID: 171591000198",
+					  "context": {"objectWriteTraceId": ["0"]}
+					}`)},
+					Data: nil,
+				}, {
+					Success:  true,
+					RecordId: "171596044871",
+					Errors:   nil,
+					Data: map[string]any{
+						"email":     "siena.dyer@hubspot.com",
+						"firstname": "Siena",
+					},
+				}},
+				SuccessCount: 1,
+				FailureCount: 1,
+			},
+			ExpectedErrs: nil,
+		},
 		{
 			Name: "Successful write",
 			Input: &common.BatchWriteParam{
@@ -399,3 +447,143 @@ func TestBatchUpdate(t *testing.T) { // nolint:funlen,gocognit,cyclop,maintidx
 		})
 	}
 }
+
+func TestBatchUpsert(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	upsertPayload := testutils.DataFromFile(t, "batch/upsert/contacts/payload.json")
+	responseUpsertContacts := testutils.DataFromFile(t, "batch/upsert/contacts/success.json")
+
+	upsertRecords := common.BatchItems{{
+		Record: map[string]any{
+			"email":     "Markus.Blevins@hubspot.com",
+			"firstname": "Markus",
+		},
+	}, {
+		Record: map[string]any{
+			"email":     "Siena.Dyer@hubspot.com",
+			"firstname": "Siena",
+		},
+	}}
+
+	tests := []testroutines.BatchWrite{
+		{
+			Name: "Record without unique property cannot be upserted",
+			Input: &common.BatchWriteParam{
+				ObjectName:      "contacts",
+				Type:            common.BatchWriteTypeUpsert,
+				ExternalIdField: "email",
+				Batch: common.BatchItems{{
+					Record: map[string]any{"firstname": "Markus"},
+				}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingRecordID},
+		},
+		{
+			Name: "Results are matched by unique property",
+			Input: &common.BatchWriteParam{
+				ObjectName:      "contacts",
+				Type:            common.BatchWriteTypeUpsert,
+				ExternalIdField: "email",
+				Batch:           upsertRecords,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/crm/v3/objects/contacts/batch/upsert"),
+					mockcond.BodyBytes(upsertPayload),
+				},
+				Then: mockserver.Response(http.StatusOK, responseUpsertContacts),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetBatchWrite,
+			Expected: &common.BatchWriteResult{
+				Status: common.BatchStatusSuccess,
+				Errors: []any{},
+				Results: []common.WriteResult{{
+					Success:  true,
+					RecordId: "171591000198",
+					Errors:   nil,
+					Data: map[string]any{
+						"email":     "markus.blevins@hubspot.com",
+						"firstname": "Markus",
+					},
+				}, {
+					Success:  true,
+					RecordId: "171591000199",
+					Errors:   nil,
+					Data: map[string]any{
+						"email":     "siena.dyer@hubspot.com",
+						"firstname": "Siena",
+					},
+				}},
+				SuccessCount: 2,
+				FailureCount: 0,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.BatchWriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestBatchDelete(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	archivePayload := testutils.DataFromFile(t, "batch/delete/contacts/payload.json")
+
+	tests := []testroutines.BatchWrite{
+		{
+			Name: "Records are archived",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeDelete,
+				RecordIds:  []string{"171591000198", "171591000199"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/crm/v3/objects/contacts/batch/archive"),
+					mockcond.BodyBytes(archivePayload),
+				},
+				Then: mockserver.Response(http.StatusNoContent),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetBatchWrite,
+			Expected: &common.BatchWriteResult{
+				Status: common.BatchStatusSuccess,
+				Results: []common.WriteResult{{
+					Success:  true,
+					RecordId: "171591000198",
+				}, {
+					Success:  true,
+					RecordId: "171591000199",
+				}},
+				SuccessCount: 2,
+				FailureCount: 0,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.BatchWriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
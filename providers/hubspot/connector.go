@@ -63,7 +63,7 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 	conn.moduleInfo = conn.providerInfo.ReadModuleInfo(conn.moduleID)
 
 	conn.customAdapter = custom.NewAdapter(conn.Client, conn.moduleInfo)
-	conn.batchAdapter = batch.NewAdapter(conn.Client.HTTPClient, conn.moduleInfo)
+	conn.batchAdapter = batch.NewAdapter(conn.Client.HTTPClient, conn.moduleInfo, conn.resolveAssociationType)
 
 	return conn, nil
 }
@@ -12,16 +12,21 @@ import (
 
 const apiVersion = "v3"
 
-// Adapter handles batched record operations (create/update) against HubSpot's REST API.
+// Adapter handles batched record operations (create/update/upsert/archive) against HubSpot's REST API.
 // It abstracts API endpoint construction, versioning, and JSON response processing
 // specific to the HubSpot Batch feature.
 type Adapter struct {
 	Client     *common.JSONHTTPClient
 	moduleInfo *providers.ModuleInfo
+	// resolveAssociation gives association types of created records.
+	resolveAssociation AssociationResolver
 }
 
 // NewAdapter creates a new batch Adapter configured to work with Hubspot's APIs.
-func NewAdapter(hubspotCRMClient *common.HTTPClient, moduleInfo *providers.ModuleInfo) *Adapter {
+// Associations of created records are sent along with the records, the resolver gives their types.
+func NewAdapter(
+	hubspotCRMClient *common.HTTPClient, moduleInfo *providers.ModuleInfo, resolveAssociation AssociationResolver,
+) *Adapter {
 	shouldHandleError := func(response *http.Response) bool {
 		// 2xx responses are normal.
 		// 400 (Bad Request) and 409 (Conflict) are considered valid "soft failures"
@@ -42,8 +47,9 @@ func NewAdapter(hubspotCRMClient *common.HTTPClient, moduleInfo *providers.Modul
 	}
 
 	return &Adapter{
-		Client:     jsonHTTPClient,
-		moduleInfo: moduleInfo,
+		Client:             jsonHTTPClient,
+		moduleInfo:         moduleInfo,
+		resolveAssociation: resolveAssociation,
 	}
 }
 
@@ -66,3 +72,19 @@ func (a *Adapter) getCreateURL(objectName common.ObjectName) (*urlbuilder.URL, e
 func (a *Adapter) getUpdateURL(objectName common.ObjectName) (*urlbuilder.URL, error) {
 	return urlbuilder.New(a.getModuleURL(), apiVersion, "objects", objectName.String(), "batch/update")
 }
+
+// getUpsertURL builds the HubSpot batch upsert endpoint for the given object type.
+//
+// nolint:lll
+// Contacts example: https://developers.hubspot.com/docs/api-reference/crm-contacts-v3/batch/post-crm-v3-objects-contacts-batch-upsert
+func (a *Adapter) getUpsertURL(objectName common.ObjectName) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.getModuleURL(), apiVersion, "objects", objectName.String(), "batch/upsert")
+}
+
+// getArchiveURL builds the HubSpot batch archive endpoint for the given object type.
+//
+// nolint:lll
+// Contacts example: https://developers.hubspot.com/docs/api-reference/crm-contacts-v3/batch/post-crm-v3-objects-contacts-batch-archive
+func (a *Adapter) getArchiveURL(objectName common.ObjectName) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.getModuleURL(), apiVersion, "objects", objectName.String(), "batch/archive")
+}
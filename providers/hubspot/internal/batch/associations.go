@@ -0,0 +1,91 @@
+package batch
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// AssociationType is the association type accepted by HubSpot when records are linked.
+type AssociationType struct {
+	Category string `json:"associationCategory"`
+	TypeId   int    `json:"associationTypeId"`
+}
+
+// Association links the created record with another record, HubSpot writes it along with the record.
+// Only create requests accept associations.
+//
+// nolint:lll
+// Contacts example: https://developers.hubspot.com/docs/api-reference/crm-contacts-v3/basic/post-crm-v3-objects-contacts
+type Association struct {
+	To    AssociationTarget `json:"to"`
+	Types []AssociationType `json:"types"`
+}
+
+type AssociationTarget struct {
+	ID string `json:"id"`
+}
+
+// AssociationResolver converts the association requested by the caller into the type known to HubSpot.
+type AssociationResolver func(
+	ctx context.Context, objectName string, association common.AssociationInput,
+) (*AssociationType, error)
+
+// NewAssociations converts associations of the created record into the payload form.
+func NewAssociations(
+	ctx context.Context, objectName string, inputs []common.AssociationInput, resolve AssociationResolver,
+) ([]Association, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	associations := make([]Association, len(inputs))
+
+	for index, input := range inputs {
+		associationType, err := resolve(ctx, objectName, input)
+		if err != nil {
+			return nil, err
+		}
+
+		associations[index] = Association{
+			To:    AssociationTarget{ID: input.TargetId},
+			Types: []AssociationType{*associationType},
+		}
+	}
+
+	return associations, nil
+}
+
+// cacheAssociationTypes remembers resolved types, records of a batch often share the same association.
+func cacheAssociationTypes(resolve AssociationResolver) AssociationResolver {
+	type key struct {
+		targetObject    string
+		associationType string
+		label           string
+	}
+
+	resolved := make(map[key]*AssociationType)
+
+	return func(
+		ctx context.Context, objectName string, association common.AssociationInput,
+	) (*AssociationType, error) {
+		lookup := key{
+			targetObject:    association.TargetObject,
+			associationType: association.AssociationType,
+			label:           association.Label,
+		}
+
+		if associationType, ok := resolved[lookup]; ok {
+			return associationType, nil
+		}
+
+		associationType, err := resolve(ctx, objectName, association)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved[lookup] = associationType
+
+		return associationType, nil
+	}
+}
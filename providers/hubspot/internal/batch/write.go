@@ -2,6 +2,9 @@ package batch
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/amp-labs/connectors/common"
@@ -11,25 +14,33 @@ import (
 	"github.com/amp-labs/connectors/internal/jsonquery"
 )
 
-// BatchWrite performs a HubSpot batch create or update request.
+// BatchWrite performs a HubSpot batch create, update, upsert or delete request.
 //
 // The request body always includes an "inputs" array of record payloads,
 // and the response contains a "results" array aligned by index.
+// Delete archives records, the successful response has no body.
 //
 // HubSpot may return 400 (Bad Request) or 409 (Conflict) when record-level
 // validation fails — these are treated as soft issues (non-fatal responses)
 // and are parsed into a structured BatchWriteResult rather than raised as errors.
+//
+// HubSpot has no all-or-none mode, AllOrNone is not sent. A batch which is accepted may still be
+// applied partially, HubSpot then responds with 207 (Multi-Status) and per-record outcomes are reported.
 func (a *Adapter) BatchWrite(ctx context.Context, params *common.BatchWriteParam) (*common.BatchWriteResult, error) {
 	if err := params.ValidateParams(); err != nil {
 		return nil, err
 	}
 
+	if params.IsDelete() {
+		return a.batchArchive(ctx, params)
+	}
+
 	url, err := a.buildBatchWriteURL(params)
 	if err != nil {
 		return nil, err
 	}
 
-	payload, err := buildBatchWritePayload(params)
+	payload, err := a.buildBatchWritePayload(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +55,7 @@ func (a *Adapter) BatchWrite(ctx context.Context, params *common.BatchWriteParam
 		// 4xx responses (e.g., 400 or 409) represent valid request outcomes
 		// that include structured issue details, not fatal API failures.
 		// Critical errors (5xx and the rest of 4xx) are handled by the HTTP client and returned as Go errors.
-		return parseBulkIssue(payload, rsp)
+		return parseBulkIssue(len(payload.Items), rsp)
 	}
 
 	return parseBulkResponse(params, payload, rsp)
@@ -58,7 +69,7 @@ func (a *Adapter) BatchWrite(ctx context.Context, params *common.BatchWriteParam
 // may contain six validation errors in total. Because these messages lack
 // per-record identifiers, the errors cannot be matched back to individual payloads.
 // In such cases, all errors are returned at the BatchWriteResult's top level.
-func parseBulkIssue(payload *Payload, rsp *common.JSONHTTPResponse) (*common.BatchWriteResult, error) {
+func parseBulkIssue(totalNumRecords int, rsp *common.JSONHTTPResponse) (*common.BatchWriteResult, error) {
 	response, err := common.UnmarshalJSON[IssueResponse](rsp)
 	if err != nil {
 		return nil, err
@@ -72,17 +83,17 @@ func parseBulkIssue(payload *Payload, rsp *common.JSONHTTPResponse) (*common.Bat
 		failures = datautils.ToAnySlice(response.Errors)
 	}
 
-	totalNumRecords := len(payload.Items)
-
 	return common.NewBatchWriteResultFailed(nil, totalNumRecords, failures)
 }
 
-// parseBulkResponse handles successful (2xx) HubSpot batch responses.
+// parseBulkResponse handles successful (2xx) HubSpot batch responses,
+// including 207 (Multi-Status) where only some records were written.
 // It maps each response item back to its corresponding payload record,
 // producing a per-record WriteResult when possible.
 //
-// For create operations, HubSpot preserves payload order — the Nth response
-// corresponds to the Nth payload. For updates, results are matched by record ID.
+// Errors name the failed inputs by objectWriteTraceId, the remaining errors are reported at the top level.
+// For create operations, results follow the order of inputs which didn't fail.
+// For updates, results are matched by record ID, for upserts by the unique property.
 func parseBulkResponse(
 	params *common.BatchWriteParam, payload *Payload, rsp *common.JSONHTTPResponse,
 ) (*common.BatchWriteResult, error) {
@@ -96,61 +107,113 @@ func parseBulkResponse(
 			nil, len(payload.Items), []any{common.ErrEmptyJSONHTTPResponse})
 	}
 
-	// == Create == //
-	if params.IsCreate() {
-		// Response order matches payload order.
-		return common.ParseBatchWrite(
-			payload.Items,
-			func(index int, payloadItem PayloadItem) *ResponseItem {
-				return &response.Results[index]
-			},
-			func(_ PayloadItem, respItem *ResponseItem) (*common.WriteResult, error) {
-				if respItem == nil {
-					return createUnprocessableItem(""), nil
-				}
+	failures, unmatchedErrors := response.GetErrorsByTraceID()
 
-				return respItem.ToWriteResult()
-			},
-			datautils.ToAnySlice(response.Errors),
-		)
+	var items map[string]*ResponseItem
+
+	matchItem := func(payloadItem PayloadItem) *ResponseItem {
+		return items[payloadItem.ID]
 	}
 
-	// == UPDATE == //
-	// Build a lookup table keyed by record ID.
-	items := response.GetItemsMap()
+	switch {
+	case params.IsCreate():
+		items = response.GetCreatedItemsMap(payload.Items, failures)
+		matchItem = func(payloadItem PayloadItem) *ResponseItem {
+			return items[payloadItem.ObjectWriteTraceID]
+		}
+	case params.IsUpsert():
+		// Records are matched by the value of the unique property.
+		// Values are compared ignoring case, HubSpot stores emails in lower case.
+		items = response.GetItemsMapByProperty(params.ExternalIdField)
+		matchItem = func(payloadItem PayloadItem) *ResponseItem {
+			return items[strings.ToLower(payloadItem.ID)]
+		}
+	default:
+		// Each record must have an id when performing Bulk Update.
+		items = response.GetItemsMap()
+	}
 
 	return common.ParseBatchWrite(
 		payload.Items,
 		func(_ int, payloadItem PayloadItem) *ResponseItem {
-			// Each record must have an id when performing Bulk Update.
-			return items[payloadItem.ID]
+			return matchItem(payloadItem)
 		},
 		func(payloadItem PayloadItem, respItem *ResponseItem) (*common.WriteResult, error) {
+			// Upserted records may not exist yet, identifier is known only for updates.
+			identifier := ""
+			if params.IsUpdate() {
+				identifier = payloadItem.ID
+			}
+
+			if issues, failed := failures[payloadItem.ObjectWriteTraceID]; failed {
+				return &common.WriteResult{
+					Success:  false,
+					RecordId: identifier,
+					Errors:   issues,
+				}, nil
+			}
+
 			if respItem == nil {
-				// No matching response, but we still know which record failed.
-				return createUnprocessableItem(payloadItem.ID), nil
+				return createUnprocessableItem(identifier), nil
 			}
 
 			return respItem.ToWriteResult()
 		},
-		datautils.ToAnySlice(response.Errors),
+		unmatchedErrors,
 	)
 }
 
-func (a *Adapter) buildBatchWriteURL(params *common.BatchWriteParam) (*urlbuilder.URL, error) {
-	if params.IsCreate() {
-		return a.getCreateURL(params.ObjectName)
+// batchArchive moves records to the recycling bin.
+// HubSpot responds with 204 No Content when every record was archived.
+func (a *Adapter) batchArchive(ctx context.Context, params *common.BatchWriteParam) (*common.BatchWriteResult, error) {
+	url, err := a.getArchiveURL(params.ObjectName)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.IsUpdate() {
-		return a.getUpdateURL(params.ObjectName)
+	payload := &ArchivePayload{Items: make([]ArchiveItem, len(params.RecordIds))}
+	for index, identifier := range params.RecordIds {
+		payload.Items[index] = ArchiveItem{ID: identifier}
 	}
 
-	return nil, common.ErrUnsupportedBatchWriteType
+	rsp, err := a.Client.Post(ctx, url.String(), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpkit.Status4xx(rsp.Code) {
+		return parseBulkIssue(len(payload.Items), rsp)
+	}
+
+	results := make([]common.WriteResult, len(params.RecordIds))
+	for index, identifier := range params.RecordIds {
+		results[index] = common.WriteResult{
+			Success:  true,
+			RecordId: identifier,
+		}
+	}
+
+	return common.NewBatchWriteResult(results, len(results), len(results), nil)
 }
 
-func buildBatchWritePayload(params *common.BatchWriteParam) (*Payload, error) {
+func (a *Adapter) buildBatchWriteURL(params *common.BatchWriteParam) (*urlbuilder.URL, error) {
+	switch params.Type {
+	case common.BatchWriteTypeCreate:
+		return a.getCreateURL(params.ObjectName)
+	case common.BatchWriteTypeUpdate:
+		return a.getUpdateURL(params.ObjectName)
+	case common.BatchWriteTypeUpsert:
+		return a.getUpsertURL(params.ObjectName)
+	default:
+		return nil, common.ErrUnsupportedBatchWriteType
+	}
+}
+
+// buildBatchWritePayload converts the batch into HubSpot inputs.
+// Created records carry their associations, other writes link records separately.
+func (a *Adapter) buildBatchWritePayload(ctx context.Context, params *common.BatchWriteParam) (*Payload, error) {
 	payloadItems := make([]PayloadItem, len(params.Batch))
+	resolveAssociation := cacheAssociationTypes(a.resolveAssociation)
 
 	for index, batchItem := range params.Batch {
 		record, err := batchItem.GetRecord()
@@ -158,11 +221,26 @@ func buildBatchWritePayload(params *common.BatchWriteParam) (*Payload, error) {
 			return nil, err
 		}
 
-		item, err := NewPayloadItem(record, batchItem.Associations)
+		var item *PayloadItem
+		if params.IsUpsert() {
+			item, err = NewUpsertPayloadItem(record, params.ExternalIdField)
+		} else {
+			item, err = NewPayloadItem(record)
+		}
+
 		if err != nil {
 			return nil, err
 		}
 
+		if params.IsCreate() {
+			item.Associations, err = NewAssociations(ctx,
+				params.ObjectName.String(), batchItem.Associations, resolveAssociation)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		item.ObjectWriteTraceID = strconv.Itoa(index)
 		payloadItems[index] = *item
 	}
 
@@ -185,16 +263,28 @@ type Payload struct {
 	Items []PayloadItem `json:"inputs"`
 }
 
+// ArchivePayload represents the HubSpot batch archive request body.
+type ArchivePayload struct {
+	Items []ArchiveItem `json:"inputs"`
+}
+
+type ArchiveItem struct {
+	ID string `json:"id"`
+}
+
 // PayloadItem represents a single item in the API payload.
-// Hubspot's payload is identical to what client supplies to the connector.
-// This is an alias.
 type PayloadItem struct {
-	ID           string        `json:"id,omitempty"`
-	Properties   common.Record `json:"properties"`
-	Associations any           `json:"associations,omitempty"`
+	ID string `json:"id,omitempty"`
+	// IDProperty names the unique property the ID refers to, used by upsert.
+	IDProperty string        `json:"idProperty,omitempty"`
+	Properties common.Record `json:"properties"`
+	// Associations are accepted only by create.
+	Associations []Association `json:"associations,omitempty"`
+	// ObjectWriteTraceID identifies the input in errors of partially applied batches.
+	ObjectWriteTraceID string `json:"objectWriteTraceId,omitempty"`
 }
 
-func NewPayloadItem(record common.Record, associations any) (*PayloadItem, error) {
+func NewPayloadItem(record common.Record) (*PayloadItem, error) {
 	node, err := jsonquery.Convertor.NodeFromMap(record)
 	if err != nil {
 		return nil, err
@@ -214,9 +304,28 @@ func NewPayloadItem(record common.Record, associations any) (*PayloadItem, error
 	delete(properties, "id")
 
 	return &PayloadItem{
-		ID:           identifier,
-		Properties:   common.Record(properties),
-		Associations: associations,
+		ID:         identifier,
+		Properties: common.Record(properties),
+	}, nil
+}
+
+// NewUpsertPayloadItem identifies the record by the value of the unique property.
+// The property stays among the record properties, so that created records have it set.
+func NewUpsertPayloadItem(record common.Record, idProperty string) (*PayloadItem, error) {
+	value, ok := record[idProperty]
+	if !ok || value == nil {
+		return nil, fmt.Errorf("%w: %s", common.ErrMissingRecordID, idProperty)
+	}
+
+	properties, err := datautils.FromMap(record).DeepCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PayloadItem{
+		ID:         fmt.Sprint(value),
+		IDProperty: idProperty,
+		Properties: common.Record(properties),
 	}, nil
 }
 
@@ -229,6 +338,61 @@ type Response struct {
 	Errors      []Issue        `json:"errors"`
 }
 
+// GetErrorsByTraceID groups errors by objectWriteTraceId of the failed inputs.
+// Errors naming no input are returned separately.
+func (r Response) GetErrorsByTraceID() (map[string][]any, []any) {
+	failures := make(map[string][]any)
+	unmatched := make([]any, 0)
+
+	for _, issue := range r.Errors {
+		traceIDs := issueTraceIDs(issue)
+		if len(traceIDs) == 0 {
+			unmatched = append(unmatched, issue)
+
+			continue
+		}
+
+		for _, traceID := range traceIDs {
+			failures[traceID] = append(failures[traceID], issue)
+		}
+	}
+
+	return failures, unmatched
+}
+
+// GetCreatedItemsMap builds a lookup table keyed by objectWriteTraceId of the inputs.
+// Results which don't echo the trace ID are assigned to inputs which didn't fail, in the order of inputs.
+func (r Response) GetCreatedItemsMap(
+	payloadItems []PayloadItem, failures map[string][]any,
+) map[string]*ResponseItem {
+	written := make([]string, 0, len(payloadItems))
+
+	for _, item := range payloadItems {
+		if _, failed := failures[item.ObjectWriteTraceID]; !failed {
+			written = append(written, item.ObjectWriteTraceID)
+		}
+	}
+
+	mapping := make(map[string]*ResponseItem)
+
+	for index := range r.Results {
+		item := &r.Results[index]
+
+		traceID := item.ObjectWriteTraceID
+		if traceID == "" {
+			if index >= len(written) {
+				break
+			}
+
+			traceID = written[index]
+		}
+
+		mapping[traceID] = item
+	}
+
+	return mapping
+}
+
 func (r Response) GetItemsMap() map[string]*ResponseItem {
 	mapping := make(map[string]*ResponseItem)
 
@@ -239,13 +403,32 @@ func (r Response) GetItemsMap() map[string]*ResponseItem {
 	return mapping
 }
 
+// GetItemsMapByProperty builds a lookup table keyed by the lower-cased value of the property.
+func (r Response) GetItemsMapByProperty(property string) map[string]*ResponseItem {
+	mapping := make(map[string]*ResponseItem)
+
+	for _, item := range r.Results {
+		properties, ok := item.Properties.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if value, ok := properties[property]; ok && value != nil {
+			mapping[strings.ToLower(fmt.Sprint(value))] = &item
+		}
+	}
+
+	return mapping
+}
+
 type ResponseItem struct {
-	ID         string    `json:"id"`
-	Properties any       `json:"properties"`
-	CreatedAt  time.Time `json:"createdAt"`
-	UpdatedAt  time.Time `json:"updatedAt"`
-	Archived   bool      `json:"archived"`
-	URL        string    `json:"url"`
+	ID                 string    `json:"id"`
+	ObjectWriteTraceID string    `json:"objectWriteTraceId,omitempty"`
+	Properties         any       `json:"properties"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+	Archived           bool      `json:"archived"`
+	URL                string    `json:"url"`
 }
 
 func (i ResponseItem) ToWriteResult() (*common.WriteResult, error) {
@@ -274,3 +457,31 @@ type IssueResponse struct {
 // Issue represents a single HubSpot error entry.
 // Its structure varies by error type, but typically includes "message" and "context" fields.
 type Issue any
+
+// issueTraceIDs returns objectWriteTraceId of inputs the error is about, listed under "context".
+func issueTraceIDs(issue Issue) []string {
+	fields, ok := issue.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	context, ok := fields["context"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	values, ok := context["objectWriteTraceId"].([]any)
+	if !ok {
+		return nil
+	}
+
+	traceIDs := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if traceID, ok := value.(string); ok {
+			traceIDs = append(traceIDs, traceID)
+		}
+	}
+
+	return traceIDs
+}
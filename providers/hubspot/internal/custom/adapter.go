@@ -43,3 +43,26 @@ func (a *Adapter) getPropertyGroupNameURL(objectName, groupName string) (*urlbui
 func (a *Adapter) getPropertyGroupNameCreationURL(objectName string) (*urlbuilder.URL, error) {
 	return urlbuilder.New(a.moduleInfo.BaseURL, "properties", ModuleCRMVersion, objectName, "groups")
 }
+
+// nolint:lll
+// https://developers.hubspot.com/docs/api-reference/crm-properties-v3/core/get-crm-v3-properties-objectType
+func (a *Adapter) getPropertiesURL(objectName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.moduleInfo.BaseURL, "properties", ModuleCRMVersion, objectName)
+}
+
+// nolint:lll
+// https://developers.hubspot.com/docs/api-reference/crm-properties-v3/core/delete-crm-v3-properties-objectType-propertyName
+func (a *Adapter) getPropertyArchiveURL(objectName, propertyName string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.moduleInfo.BaseURL, "properties", ModuleCRMVersion, objectName, propertyName)
+}
+
+// https://developers.hubspot.com/docs/api-reference/crm-schemas-v3/core/get-crm-object-schemas-v3-schemas
+func (a *Adapter) getSchemasURL() (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.moduleInfo.BaseURL, ModuleCRMVersion, "schemas")
+}
+
+// nolint:lll
+// https://developers.hubspot.com/docs/api-reference/crm-schemas-v3/core/patch-crm-object-schemas-v3-schemas-objectType
+func (a *Adapter) getSchemaURL(objectTypeID string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.moduleInfo.BaseURL, ModuleCRMVersion, "schemas", objectTypeID)
+}
@@ -0,0 +1,84 @@
+package custom
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// deleteCustomFields archives properties of the object.
+// Properties that don't exist are reported with the action none.
+func (a *Adapter) deleteCustomFields(
+	ctx context.Context, objectType string, fieldNames []string,
+) (map[string]common.FieldUpsertResult, error) {
+	fields := make(map[string]common.FieldUpsertResult)
+
+	for _, fieldName := range fieldNames {
+		action, err := a.deleteCustomField(ctx, objectType, fieldName)
+		if err != nil {
+			return nil, err
+		}
+
+		fields[fieldName] = common.FieldUpsertResult{
+			FieldName: fieldName,
+			Action:    action,
+		}
+	}
+
+	return fields, nil
+}
+
+func (a *Adapter) deleteCustomField(
+	ctx context.Context, objectType, fieldName string,
+) (common.UpsertMetadataAction, error) {
+	url, err := a.getPropertyArchiveURL(objectType, fieldName)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = a.Client.Delete(ctx, url.String()); err != nil {
+		var httpError *common.HTTPError
+		if errors.As(err, &httpError) && httpError.Status == http.StatusNotFound {
+			return common.UpsertMetadataActionNone, nil
+		}
+
+		return "", err
+	}
+
+	return common.UpsertMetadataActionDelete, nil
+}
+
+// fetchPropertyNames returns names of all properties defined for the object.
+func (a *Adapter) fetchPropertyNames(ctx context.Context, objectType string) (map[string]bool, error) {
+	url, err := a.getPropertiesURL(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := common.UnmarshalJSON[PropertiesResponse](response)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	names := make(map[string]bool, len(properties.Results))
+	for _, property := range properties.Results {
+		names[property.Name] = true
+	}
+
+	return names, nil
+}
+
+type PropertiesResponse struct {
+	Results BatchResults `json:"results"`
+}
@@ -0,0 +1,138 @@
+package custom
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// planUpsertMetadata resolves what UpsertMetadata would do without changing HubSpot.
+// Definitions are converted into payloads, so invalid definitions fail the same way as the real call.
+func (a *Adapter) planUpsertMetadata(
+	ctx context.Context, params *common.UpsertMetadataParams,
+) (*common.UpsertMetadataResult, error) {
+	result := &common.UpsertMetadataResult{
+		Success: true,
+		DryRun:  true,
+		Fields:  make(map[string]map[string]common.FieldUpsertResult),
+	}
+
+	existing, err := a.loadCustomObjectSchemas(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	objectTypes := customObjectTypes(existing)
+
+	if err = planCustomObjects(params, existing, result); err != nil {
+		return nil, err
+	}
+
+	for _, objectName := range objectNamesWithFields(params) {
+		if err = a.planCustomFields(ctx, params, objectName, objectTypes, result); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// planCustomObjects records object actions.
+func planCustomObjects(
+	params *common.UpsertMetadataParams, existing map[string]SchemaResponse, result *common.UpsertMetadataResult,
+) error {
+	if len(params.Objects) == 0 {
+		return nil
+	}
+
+	result.Objects = make(map[string]common.ObjectUpsertResult, len(params.Objects))
+
+	for objectName, definition := range params.Objects {
+		if _, err := newSchemaPayload(objectName, definition); err != nil {
+			return err
+		}
+
+		action := common.UpsertMetadataActionCreate
+		if _, ok := existing[objectName]; ok {
+			action = common.UpsertMetadataActionUpdate
+		}
+
+		result.Objects[objectName] = common.ObjectUpsertResult{
+			ObjectName: objectName,
+			Action:     action,
+		}
+	}
+
+	return nil
+}
+
+func (a *Adapter) planCustomFields(
+	ctx context.Context, params *common.UpsertMetadataParams, objectName string,
+	objectTypes map[string]string, result *common.UpsertMetadataResult,
+) error {
+	for _, definition := range params.Fields[objectName] {
+		if _, err := newPayload(defaultGroupNameID, definition); err != nil {
+			return err
+		}
+	}
+
+	// Object that is yet to be created has no properties.
+	existing := make(map[string]bool)
+
+	_, isUpserted := params.Objects[objectName]
+	if _, isExisting := objectTypes[objectName]; isExisting || !isUpserted {
+		var err error
+
+		existing, err = a.fetchPropertyNames(ctx, resolveObjectType(objectName, objectTypes))
+		if err != nil {
+			return err
+		}
+	}
+
+	fields := make(map[string]common.FieldUpsertResult)
+
+	for _, definition := range params.Fields[objectName] {
+		action := common.UpsertMetadataActionCreate
+		if existing[definition.FieldName] {
+			action = common.UpsertMetadataActionUpdate
+		}
+
+		fields[definition.FieldName] = common.FieldUpsertResult{
+			FieldName: definition.FieldName,
+			Action:    action,
+		}
+	}
+
+	for _, fieldName := range params.DeleteFields[objectName] {
+		action := common.UpsertMetadataActionNone
+		if existing[fieldName] {
+			action = common.UpsertMetadataActionDelete
+		}
+
+		fields[fieldName] = common.FieldUpsertResult{
+			FieldName: fieldName,
+			Action:    action,
+		}
+	}
+
+	result.Fields[objectName] = fields
+
+	return nil
+}
+
+// objectNamesWithFields lists objects which have fields to upsert or delete, sorted alphabetically.
+func objectNamesWithFields(params *common.UpsertMetadataParams) []string {
+	names := slices.Collect(maps.Keys(params.Fields))
+
+	for objectName := range params.DeleteFields {
+		if _, ok := params.Fields[objectName]; !ok {
+			names = append(names, objectName)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
@@ -3,13 +3,15 @@ package custom
 import (
 	"context"
 	"errors"
+	"maps"
 	"net/http"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/internal/datautils"
 )
 
-// UpsertMetadata creates or updates the definition of a custom field.
+// UpsertMetadata creates or updates custom objects and the definitions of custom fields,
+// and archives fields listed for deletion.
 //
 // This operation manages the field schema in HubSpot via API. Note that while
 // field definitions can be created and updated programmatically, property
@@ -24,19 +26,41 @@ func (a *Adapter) UpsertMetadata(
 		return nil, err
 	}
 
+	if params.DryRun {
+		return a.planUpsertMetadata(ctx, params)
+	}
+
 	result := &common.UpsertMetadataResult{
 		Success: true,
 		Fields:  make(map[string]map[string]common.FieldUpsertResult),
 	}
 
+	existing, err := a.loadCustomObjectSchemas(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	objectTypes := customObjectTypes(existing)
+
+	// Objects come first, so that fields can be added to newly created objects.
+	objects, err := a.upsertCustomObjects(ctx, params, existing, objectTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Objects = objects
+
 	for objectName, fieldDefinitions := range params.Fields {
+		// Custom objects are addressed by objectTypeId, results are keyed by the requested name.
+		objectType := resolveObjectType(objectName, objectTypes)
+
 		// Each object has a list of groups to organize fields.
-		groupName, err := a.getOrCreateGroupName(ctx, objectName)
+		groupName, err := a.getOrCreateGroupName(ctx, objectType)
 		if err != nil {
 			return nil, err
 		}
 
-		fields, err := a.upsertCustomFields(ctx, objectName, groupName, fieldDefinitions)
+		fields, err := a.upsertCustomFields(ctx, objectType, groupName, fieldDefinitions)
 		if err != nil {
 			return nil, err
 		}
@@ -44,9 +68,31 @@ func (a *Adapter) UpsertMetadata(
 		result.Fields[objectName] = fields
 	}
 
+	for objectName, fieldNames := range params.DeleteFields {
+		fields, err := a.deleteCustomFields(ctx, resolveObjectType(objectName, objectTypes), fieldNames)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Fields[objectName] == nil {
+			result.Fields[objectName] = fields
+		} else {
+			maps.Copy(result.Fields[objectName], fields)
+		}
+	}
+
 	return result, nil
 }
 
+// resolveObjectType returns objectTypeId of custom objects, standard objects are addressed by name.
+func resolveObjectType(objectName string, objectTypes map[string]string) string {
+	if objectType, ok := objectTypes[objectName]; ok {
+		return objectType
+	}
+
+	return objectName
+}
+
 // upsertCustomFields ensures that all given field definitions exist by
 // performing an upsert operation against HubSpot.
 //
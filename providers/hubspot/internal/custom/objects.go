@@ -0,0 +1,268 @@
+package custom
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// Primary display property used when the object definition doesn't name one.
+const defaultPrimaryPropertyName = "name"
+
+// upsertCustomObjects creates or updates custom object schemas.
+// The objectTypeId of created objects, which identifies the object in the properties API, is added to objectTypes.
+func (a *Adapter) upsertCustomObjects(
+	ctx context.Context, params *common.UpsertMetadataParams,
+	existing map[string]SchemaResponse, objectTypes map[string]string,
+) (map[string]common.ObjectUpsertResult, error) {
+	if len(params.Objects) == 0 {
+		return nil, nil
+	}
+
+	results := make(map[string]common.ObjectUpsertResult, len(params.Objects))
+
+	for _, objectName := range slices.Sorted(maps.Keys(params.Objects)) {
+		definition := params.Objects[objectName]
+
+		var (
+			schema *SchemaResponse
+			action common.UpsertMetadataAction
+			err    error
+		)
+
+		if current, ok := existing[objectName]; ok {
+			action = common.UpsertMetadataActionUpdate
+			schema, err = a.updateCustomObject(ctx, current.ObjectTypeID, definition)
+		} else {
+			action = common.UpsertMetadataActionCreate
+			schema, err = a.createCustomObject(ctx, objectName, definition)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		metadata, err := datautils.StructToMap(schema)
+		if err != nil {
+			metadata = nil // No need to fail. Sending empty data is fine.
+		}
+
+		results[objectName] = common.ObjectUpsertResult{
+			ObjectName: objectName,
+			Action:     action,
+			Metadata:   metadata,
+		}
+		objectTypes[objectName] = schema.ObjectTypeID
+	}
+
+	return results, nil
+}
+
+func (a *Adapter) createCustomObject(
+	ctx context.Context, objectName string, definition common.ObjectDefinition,
+) (*SchemaResponse, error) {
+	url, err := a.getSchemasURL()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := newSchemaPayload(objectName, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.Client.Post(ctx, url.String(), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSchemaResponse(response)
+}
+
+// updateCustomObject changes labels of an existing object.
+// The primary display property is changed only when the definition names it.
+// Properties of the object are managed through field definitions.
+func (a *Adapter) updateCustomObject(
+	ctx context.Context, objectTypeID string, definition common.ObjectDefinition,
+) (*SchemaResponse, error) {
+	url, err := a.getSchemaURL(objectTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.Client.Patch(ctx, url.String(), &SchemaPayload{
+		Labels:                 newSchemaLabels(definition),
+		Description:            definition.Description,
+		PrimaryDisplayProperty: definition.PrimaryDisplayField.FieldName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSchemaResponse(response)
+}
+
+// loadCustomObjectSchemas returns schemas of all custom objects keyed by object name.
+// Fields of standard objects can be managed without custom object scopes,
+// missing scopes are not an error unless objects are upserted.
+func (a *Adapter) loadCustomObjectSchemas(
+	ctx context.Context, params *common.UpsertMetadataParams,
+) (map[string]SchemaResponse, error) {
+	if len(params.Objects) != 0 {
+		return a.fetchCustomObjectSchemas(ctx)
+	}
+
+	if len(params.Fields) == 0 && len(params.DeleteFields) == 0 {
+		return nil, nil
+	}
+
+	schemas, err := a.fetchCustomObjectSchemas(ctx)
+	if err != nil {
+		var httpError *common.HTTPError
+		if errors.As(err, &httpError) && httpError.Status == http.StatusForbidden {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return schemas, nil
+}
+
+// customObjectTypes returns the objectTypeId of each custom object keyed by object name.
+func customObjectTypes(schemas map[string]SchemaResponse) map[string]string {
+	objectTypes := make(map[string]string, len(schemas))
+
+	for objectName, schema := range schemas {
+		objectTypes[objectName] = schema.ObjectTypeID
+	}
+
+	return objectTypes
+}
+
+// fetchCustomObjectSchemas returns schemas of all custom objects keyed by object name.
+func (a *Adapter) fetchCustomObjectSchemas(ctx context.Context) (map[string]SchemaResponse, error) {
+	url, err := a.getSchemasURL()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := common.UnmarshalJSON[SchemasResponse](response)
+	if err != nil {
+		return nil, err
+	}
+
+	if schemas == nil {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return datautils.SliceToMap(schemas.Results, func(schema SchemaResponse) string {
+		return schema.Name
+	}), nil
+}
+
+func unmarshalSchemaResponse(response *common.JSONHTTPResponse) (*SchemaResponse, error) {
+	schema, err := common.UnmarshalJSON[SchemaResponse](response)
+	if err != nil {
+		return nil, err
+	}
+
+	if schema == nil {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	return schema, nil
+}
+
+func newSchemaPayload(objectName string, definition common.ObjectDefinition) (*SchemaPayload, error) {
+	primaryField := primaryDisplayField(definition)
+
+	// Group is not required for the properties created together with the object.
+	property, err := newPayload("", primaryField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchemaPayload{
+		Name:                   objectName,
+		Labels:                 newSchemaLabels(definition),
+		Description:            definition.Description,
+		PrimaryDisplayProperty: primaryField.FieldName,
+		RequiredProperties:     []string{primaryField.FieldName},
+		Properties:             []Payload{*property},
+	}, nil
+}
+
+// primaryDisplayField fills the defaults of the property, which titles records.
+func primaryDisplayField(definition common.ObjectDefinition) common.FieldDefinition {
+	field := definition.PrimaryDisplayField
+
+	if field.FieldName == "" {
+		field.FieldName = defaultPrimaryPropertyName
+	}
+
+	if field.DisplayName == "" {
+		field.DisplayName = naming.CapitalizeFirstLetter(field.FieldName)
+	}
+
+	if field.ValueType == "" {
+		field.ValueType = common.FieldTypeString
+	}
+
+	return field
+}
+
+func newSchemaLabels(definition common.ObjectDefinition) *SchemaLabels {
+	plural := definition.PluralDisplayName
+	if plural == "" {
+		plural = naming.NewSingularString(definition.DisplayName).Plural().String()
+	}
+
+	return &SchemaLabels{
+		Singular: definition.DisplayName,
+		Plural:   plural,
+	}
+}
+
+// SchemaPayload is used both to create and to update the custom object schema.
+// https://developers.hubspot.com/docs/guides/api/crm/objects/custom-objects
+type SchemaPayload struct {
+	Name                   string        `json:"name,omitempty"`
+	Labels                 *SchemaLabels `json:"labels,omitempty"`
+	Description            string        `json:"description,omitempty"`
+	PrimaryDisplayProperty string        `json:"primaryDisplayProperty,omitempty"`
+	RequiredProperties     []string      `json:"requiredProperties,omitempty"`
+	Properties             []Payload     `json:"properties,omitempty"`
+}
+
+type SchemaLabels struct {
+	Singular string `json:"singular"`
+	Plural   string `json:"plural"`
+}
+
+type SchemasResponse struct {
+	Results []SchemaResponse `json:"results"`
+}
+
+type SchemaResponse struct {
+	ID                     string       `json:"id"`
+	ObjectTypeID           string       `json:"objectTypeId"`
+	FullyQualifiedName     string       `json:"fullyQualifiedName"`
+	Name                   string       `json:"name"`
+	Labels                 SchemaLabels `json:"labels"`
+	Description            string       `json:"description"`
+	PrimaryDisplayProperty string       `json:"primaryDisplayProperty"`
+	RequiredProperties     []string     `json:"requiredProperties"`
+	Archived               bool         `json:"archived"`
+}
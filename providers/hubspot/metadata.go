@@ -10,7 +10,9 @@ import (
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/common/logging"
+	"github.com/amp-labs/connectors/common/naming"
 	"github.com/amp-labs/connectors/internal/datautils"
 	"github.com/amp-labs/connectors/internal/goutils"
 	"github.com/amp-labs/connectors/internal/simultaneously"
@@ -81,6 +83,8 @@ func (c *Connector) ListObjectMetadata( // nolint:cyclop,funlen
 	// This will block until all callbacks are done. Note that since the
 	// channels are buffered, the above code won't block on sending to them
 	// even if we're not receiving yet.
+	ctx = governor.WithContext(ctx, c.Client.HTTPClient.Governor())
+
 	if err := simultaneously.DoCtx(ctx, -1, callbacks...); err != nil {
 		close(metadataChannel)
 		close(errChannel)
@@ -244,7 +248,10 @@ type fieldDescription struct {
 	FieldType string `json:"fieldType"`
 	// IsBuiltIn indicates whether the field is HubSpot-defined (built-in).
 	// If false or omitted, the field is custom.
-	IsBuiltIn            bool                      `json:"hubspotDefined"`
+	IsBuiltIn bool `json:"hubspotDefined"`
+	// ReferencedObjectType is set for properties holding an ID of another object, ex: OWNER, COMPANY.
+	ReferencedObjectType string                    `json:"referencedObjectType"`
+	ShowCurrencySymbol   bool                      `json:"showCurrencySymbol"`
 	Options              []fieldEnumerationOption  `json:"options"`
 	ModificationMetadata fieldModificationMetadata `json:"modificationMetadata"`
 }
@@ -282,8 +289,16 @@ func (f fieldDescription) transformToFieldMetadata() common.FieldMetadata {
 	switch f.Type {
 	case "string":
 		valueType = common.ValueTypeString
+		if f.FieldType == "phonenumber" {
+			valueType = common.ValueTypePhone
+		}
+	case "phone_number":
+		valueType = common.ValueTypePhone
 	case "number":
 		valueType = common.ValueTypeFloat
+		if f.ShowCurrencySymbol {
+			valueType = common.ValueTypeCurrency
+		}
 	case "bool":
 		valueType = common.ValueTypeBoolean
 	case "datetime":
@@ -292,10 +307,17 @@ func (f fieldDescription) transformToFieldMetadata() common.FieldMetadata {
 		valueType, values = f.implyEnumerationType(f.Name)
 		// Enumeration type means there are predefined field values.
 	default:
-		// ex: object_coordinates, phone_number
+		// ex: object_coordinates
 		valueType = common.ValueTypeOther
 	}
 
+	var referenceTo []string
+
+	if f.ReferencedObjectType != "" {
+		valueType = common.ValueTypeReference
+		referenceTo = []string{referencedObjectName(f.ReferencedObjectType)}
+	}
+
 	return common.FieldMetadata{
 		DisplayName:  f.Label,
 		ValueType:    valueType,
@@ -304,11 +326,17 @@ func (f fieldDescription) transformToFieldMetadata() common.FieldMetadata {
 		IsCustom:     goutils.Pointer(!f.IsBuiltIn),
 		// IsRequired is not known from current struct,
 		// info is acquired by different API call and set by fetchRequiredFieldsBestEffort.
-		IsRequired: nil,
-		Values:     values,
+		IsRequired:  nil,
+		Values:      values,
+		ReferenceTo: referenceTo,
 	}
 }
 
+// referencedObjectName converts the type of referenced object into the object name, ex: COMPANY -> companies.
+func referencedObjectName(objectType string) string {
+	return naming.NewSingularString(strings.ToLower(objectType)).Plural().String()
+}
+
 func (f fieldDescription) implyEnumerationType(fieldName string) (common.ValueType, []common.FieldValue) {
 	var values []common.FieldValue
 
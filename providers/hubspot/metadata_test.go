@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"net/http"
+	"reflect"
 	"testing"
 
 	"github.com/amp-labs/connectors"
@@ -70,7 +71,7 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop,mai
 							},
 							"mobilephone": {
 								DisplayName:  "Mobile Phone Number",
-								ValueType:    "string",
+								ValueType:    common.ValueTypePhone,
 								ProviderType: "string.phonenumber",
 								ReadOnly:     goutils.Pointer(false),
 								IsCustom:     goutils.Pointer(false),
@@ -98,15 +99,16 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop,mai
 								Values:       nil,
 							},
 
-							// Float.
+							// Reference to another object.
 							"associatedcompanyid": {
 								DisplayName:  "Primary Associated Company ID",
-								ValueType:    "float",
+								ValueType:    common.ValueTypeReference,
 								ProviderType: "number.number",
 								ReadOnly:     goutils.Pointer(false),
 								IsCustom:     goutils.Pointer(false),
 								IsRequired:   goutils.Pointer(false),
 								Values:       nil,
+								ReferenceTo:  []string{"companies"},
 							},
 							"hubspotscore": {
 								DisplayName:  "HubSpot Score",
@@ -439,3 +441,250 @@ func TestPersonaFieldDisplayValuePrefersDescription(t *testing.T) {
 		t.Fatalf("expected second display value to fall back to label, got %q", metadata.Values[1].DisplayValue)
 	}
 }
+
+func TestUpsertMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responseSchemasEmpty := testutils.DataFromFile(t, "upsert-metadata/schemas-empty.json")
+	responseSchemasVehicles := testutils.DataFromFile(t, "upsert-metadata/schemas-vehicles.json")
+	payloadCreateVehicles := testutils.DataFromFile(t, "upsert-metadata/create-vehicles-payload.json")
+	responseCreateVehicles := testutils.DataFromFile(t, "upsert-metadata/create-vehicles-response.json")
+	responsePropertiesVehicles := testutils.DataFromFile(t, "upsert-metadata/properties-vehicles.json")
+	responsePropertiesContacts := testutils.DataFromFile(t, "upsert-metadata/properties-contacts.json")
+	errPropertyNotFound := testutils.DataFromFile(t, "upsert-metadata/err-property-not-found.json")
+
+	vehiclesObject := common.ObjectDefinition{
+		DisplayName: "Vehicle",
+		Description: "Company fleet.",
+		PrimaryDisplayField: common.FieldDefinition{
+			FieldName:   "plate",
+			DisplayName: "Plate Number",
+			ValueType:   common.FieldTypeString,
+		},
+	}
+	legacyFieldsDeletion := map[string]common.FieldUpsertResult{
+		"legacy": {
+			FieldName: "legacy",
+			Action:    common.UpsertMetadataActionDelete,
+		},
+		"unknown": {
+			FieldName: "unknown",
+			Action:    common.UpsertMetadataActionNone,
+		},
+	}
+
+	tests := []testroutines.UpsertMetadata{
+		{
+			Name: "Object must have a display name",
+			Input: &common.UpsertMetadataParams{
+				Objects: map[string]common.ObjectDefinition{
+					"vehicles": {},
+				},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjectDisplayName},
+		},
+		{
+			Name: "Dry run reports planned changes without applying them",
+			Input: &common.UpsertMetadataParams{
+				Objects: map[string]common.ObjectDefinition{
+					"vehicles": vehiclesObject,
+				},
+				Fields: map[string][]common.FieldDefinition{
+					"vehicles": {{
+						FieldName:   "plate",
+						DisplayName: "Plate Number",
+						ValueType:   common.FieldTypeString,
+					}, {
+						FieldName:   "mileage",
+						DisplayName: "Mileage",
+						ValueType:   common.FieldTypeInt,
+					}},
+				},
+				DeleteFields: map[string][]string{
+					"contacts": {"legacy", "unknown"},
+				},
+				DryRun: true,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.And{mockcond.MethodGET(), mockcond.Path("/crm/v3/schemas")},
+					Then: mockserver.Response(http.StatusOK, responseSchemasVehicles),
+				}, {
+					// Custom object properties are addressed by objectTypeId.
+					If:   mockcond.And{mockcond.MethodGET(), mockcond.Path("/crm/properties/v3/2-123")},
+					Then: mockserver.Response(http.StatusOK, responsePropertiesVehicles),
+				}, {
+					If:   mockcond.And{mockcond.MethodGET(), mockcond.Path("/crm/properties/v3/contacts")},
+					Then: mockserver.Response(http.StatusOK, responsePropertiesContacts),
+				}},
+			}.Server(),
+			Expected: &common.UpsertMetadataResult{
+				Success: true,
+				DryRun:  true,
+				Objects: map[string]common.ObjectUpsertResult{
+					"vehicles": {
+						ObjectName: "vehicles",
+						Action:     common.UpsertMetadataActionUpdate,
+					},
+				},
+				Fields: map[string]map[string]common.FieldUpsertResult{
+					"vehicles": {
+						"plate": {
+							FieldName: "plate",
+							Action:    common.UpsertMetadataActionUpdate,
+						},
+						"mileage": {
+							FieldName: "mileage",
+							Action:    common.UpsertMetadataActionCreate,
+						},
+					},
+					"contacts": legacyFieldsDeletion,
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Create custom object and delete fields",
+			Input: &common.UpsertMetadataParams{
+				Objects: map[string]common.ObjectDefinition{
+					"vehicles": vehiclesObject,
+				},
+				DeleteFields: map[string][]string{
+					"contacts": {"legacy", "unknown"},
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.And{mockcond.MethodGET(), mockcond.Path("/crm/v3/schemas")},
+					Then: mockserver.Response(http.StatusOK, responseSchemasEmpty),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/schemas"),
+						mockcond.BodyBytes(payloadCreateVehicles),
+					},
+					Then: mockserver.Response(http.StatusCreated, responseCreateVehicles),
+				}, {
+					If:   mockcond.And{mockcond.MethodDELETE(), mockcond.Path("/crm/properties/v3/contacts/legacy")},
+					Then: mockserver.Response(http.StatusNoContent),
+				}, {
+					If:   mockcond.And{mockcond.MethodDELETE(), mockcond.Path("/crm/properties/v3/contacts/unknown")},
+					Then: mockserver.Response(http.StatusNotFound, errPropertyNotFound),
+				}},
+			}.Server(),
+			Comparator: func(_ string, actual, expected *common.UpsertMetadataResult) bool {
+				return actual.Success == expected.Success &&
+					actual.Objects["vehicles"].Action == expected.Objects["vehicles"].Action &&
+					actual.Objects["vehicles"].Metadata["objectTypeId"] == "2-123" &&
+					reflect.DeepEqual(actual.Fields, expected.Fields)
+			},
+			Expected: &common.UpsertMetadataResult{
+				Success: true,
+				Objects: map[string]common.ObjectUpsertResult{
+					"vehicles": {
+						ObjectName: "vehicles",
+						Action:     common.UpsertMetadataActionCreate,
+					},
+				},
+				Fields: map[string]map[string]common.FieldUpsertResult{
+					"contacts": legacyFieldsDeletion,
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Existing object keeps its primary display property and unlisted objects resolve",
+			Input: &common.UpsertMetadataParams{
+				Objects: map[string]common.ObjectDefinition{
+					"vehicles": {DisplayName: "Vehicle"},
+				},
+				DeleteFields: map[string][]string{
+					"vehicles": {"legacy"},
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.And{mockcond.MethodGET(), mockcond.Path("/crm/v3/schemas")},
+					Then: mockserver.Response(http.StatusOK, responseSchemasVehicles),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPATCH(),
+						mockcond.Path("/crm/v3/schemas/2-123"),
+						mockcond.Body(`{"labels":{"singular":"Vehicle","plural":"Vehicles"}}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseCreateVehicles),
+				}, {
+					If:   mockcond.And{mockcond.MethodDELETE(), mockcond.Path("/crm/properties/v3/2-123/legacy")},
+					Then: mockserver.Response(http.StatusNoContent),
+				}},
+			}.Server(),
+			Comparator: func(_ string, actual, expected *common.UpsertMetadataResult) bool {
+				return actual.Success == expected.Success &&
+					actual.Objects["vehicles"].Action == expected.Objects["vehicles"].Action &&
+					reflect.DeepEqual(actual.Fields, expected.Fields)
+			},
+			Expected: &common.UpsertMetadataResult{
+				Success: true,
+				Objects: map[string]common.ObjectUpsertResult{
+					"vehicles": {
+						ObjectName: "vehicles",
+						Action:     common.UpsertMetadataActionUpdate,
+					},
+				},
+				Fields: map[string]map[string]common.FieldUpsertResult{
+					"vehicles": {
+						"legacy": {
+							FieldName: "legacy",
+							Action:    common.UpsertMetadataActionDelete,
+						},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Fields of custom object are deleted without upserting the object",
+			Input: &common.UpsertMetadataParams{
+				DeleteFields: map[string][]string{
+					"vehicles": {"legacy"},
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.And{mockcond.MethodGET(), mockcond.Path("/crm/v3/schemas")},
+					Then: mockserver.Response(http.StatusOK, responseSchemasVehicles),
+				}, {
+					If:   mockcond.And{mockcond.MethodDELETE(), mockcond.Path("/crm/properties/v3/2-123/legacy")},
+					Then: mockserver.Response(http.StatusNoContent),
+				}},
+			}.Server(),
+			Expected: &common.UpsertMetadataResult{
+				Success: true,
+				Fields: map[string]map[string]common.FieldUpsertResult{
+					"vehicles": {
+						"legacy": {
+							FieldName: "legacy",
+							Action:    common.UpsertMetadataActionDelete,
+						},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.UpsertMetadataConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
@@ -180,6 +180,10 @@ func makeFilterBody(config SearchParams) map[string]any {
 		"limit": searchPageSize,
 	}
 
+	if config.PageSize != 0 {
+		filterBody["limit"] = strconv.Itoa(config.PageSize)
+	}
+
 	if config.FilterGroups != nil {
 		filterBody["filterGroups"] = config.FilterGroups
 	}
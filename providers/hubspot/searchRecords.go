@@ -0,0 +1,203 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.SearchConnector = &Connector{}
+
+// Search endpoint limits the size of the query.
+// https://developers.hubspot.com/docs/api/crm/search#limitations
+const (
+	maxFilterGroups      = 5
+	maxFiltersPerGroup   = 6
+	maxFiltersInSearch   = 18
+	maxSortKeysInSearch  = 1
+	maxSearchPageSizeInt = 200
+)
+
+var searchOperators = map[common.SearchOperator]FilterOperatorType{ // nolint:gochecknoglobals
+	common.SearchOperatorEQ:          FilterOperatorTypeEQ,
+	common.SearchOperatorNEQ:         FilterOperatorTypeNEQ,
+	common.SearchOperatorGT:          FilterOperatorTypeGT,
+	common.SearchOperatorGTE:         FilterOperatorTypeGTE,
+	common.SearchOperatorLT:          FilterOperatorTypeLT,
+	common.SearchOperatorLTE:         FilterOperatorTypeLTE,
+	common.SearchOperatorIn:          FilterOperatorIN,
+	common.SearchOperatorNotIn:       FilterOperatorNIN,
+	common.SearchOperatorContains:    FilterPropertyContainsToken,
+	common.SearchOperatorNotContains: FilterPropertyNotContainsToken,
+	common.SearchOperatorExists:      FilterPropertyHasProperty,
+	common.SearchOperatorNotExists:   FilterPropertyNotHasProperty,
+}
+
+// SearchRecords implements connectors.SearchConnector on top of Search.
+// HubSpot filter groups are joined by OR, filters within a group are joined by AND.
+// Any filter tree is expanded into this form as long as it fits the limits of the endpoint.
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	filterGroups, err := makeFilterGroups(params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sortBy, err := makeSortBy(params.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Search(ctx, SearchParams{
+		ObjectName:   params.ObjectName,
+		NextPage:     params.NextPage,
+		SortBy:       sortBy,
+		FilterGroups: filterGroups,
+		Fields:       params.Fields,
+		PageSize:     min(params.PageSize, maxSearchPageSizeInt),
+	})
+}
+
+func makeFilterGroups(filter *common.SearchFilter) ([]FilterGroup, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	disjunction, err := toDisjunction(*filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(disjunction) > maxFilterGroups {
+		return nil, fmt.Errorf("%w: at most %d filter groups are allowed, got %d",
+			common.ErrUnsupportedSearchFilter, maxFilterGroups, len(disjunction))
+	}
+
+	total := 0
+	groups := make([]FilterGroup, 0, len(disjunction))
+
+	for _, conjunction := range disjunction {
+		if len(conjunction) > maxFiltersPerGroup {
+			return nil, fmt.Errorf("%w: at most %d filters are allowed in a group, got %d",
+				common.ErrUnsupportedSearchFilter, maxFiltersPerGroup, len(conjunction))
+		}
+
+		total += len(conjunction)
+
+		groups = append(groups, FilterGroup{Filters: conjunction})
+	}
+
+	if total > maxFiltersInSearch {
+		return nil, fmt.Errorf("%w: at most %d filters are allowed, got %d",
+			common.ErrUnsupportedSearchFilter, maxFiltersInSearch, total)
+	}
+
+	return groups, nil
+}
+
+// toDisjunction rewrites the filter as OR of AND groups.
+// AND distributes over OR, ex: a AND (b OR c) => (a AND b) OR (a AND c).
+func toDisjunction(filter common.SearchFilter) ([][]Filter, error) {
+	operands := make([][][]Filter, 0, len(filter.Conditions)+len(filter.Groups))
+
+	for _, condition := range filter.Conditions {
+		converted, err := makeFilter(condition)
+		if err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, [][]Filter{{converted}})
+	}
+
+	for _, group := range filter.Groups {
+		disjunction, err := toDisjunction(group)
+		if err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, disjunction)
+	}
+
+	if filter.JoinLogic() == common.SearchLogicOr {
+		result := make([][]Filter, 0)
+		for _, operand := range operands {
+			result = append(result, operand...)
+		}
+
+		return result, nil
+	}
+
+	result := [][]Filter{{}}
+
+	for _, operand := range operands {
+		product := make([][]Filter, 0, len(result)*len(operand))
+
+		for _, left := range result {
+			for _, right := range operand {
+				combined := make([]Filter, 0, len(left)+len(right))
+				combined = append(combined, left...)
+				combined = append(combined, right...)
+				product = append(product, combined)
+			}
+		}
+
+		result = product
+
+		if len(result) > maxFilterGroups {
+			return nil, fmt.Errorf("%w: filter expands to more than %d filter groups",
+				common.ErrUnsupportedSearchFilter, maxFilterGroups)
+		}
+	}
+
+	return result, nil
+}
+
+func makeFilter(condition common.SearchCondition) (Filter, error) {
+	operator, ok := searchOperators[condition.Operator]
+	if !ok {
+		return Filter{}, fmt.Errorf("%w: operator %q", common.ErrUnsupportedSearchFilter, condition.Operator)
+	}
+
+	filter := Filter{
+		FieldName: condition.FieldName,
+		Operator:  operator,
+	}
+
+	switch {
+	case condition.Operator.IsList():
+		values, err := condition.Values()
+		if err != nil {
+			return Filter{}, err
+		}
+
+		filter.Values = values
+	case condition.Operator != common.SearchOperatorExists && condition.Operator != common.SearchOperatorNotExists:
+		filter.Value = condition.StringValue()
+	}
+
+	return filter, nil
+}
+
+func makeSortBy(keys []common.SearchSort) ([]SortBy, error) {
+	if len(keys) > maxSortKeysInSearch {
+		return nil, fmt.Errorf("%w: only %d sort key is allowed", common.ErrUnsupportedSearchSort, maxSortKeysInSearch)
+	}
+
+	sortBy := make([]SortBy, 0, len(keys))
+
+	for _, key := range keys {
+		direction := SortDirectionAsc
+		if key.Descending {
+			direction = SortDirectionDesc
+		}
+
+		sortBy = append(sortBy, BuildSort(ObjectField(key.FieldName), direction))
+	}
+
+	return sortBy, nil
+}
@@ -0,0 +1,97 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"gotest.tools/v3/assert"
+)
+
+func TestSearchRecords(t *testing.T) {
+	t.Parallel()
+
+	requestContacts := testutils.DataFromFile(t, "search/contacts-req-payload.json")
+	responseContacts := testutils.DataFromFile(t, "read/objects-api/contacts-response.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodPOST(),
+			mockcond.Path("/crm/v3/objects/contacts/search"),
+			mockcond.BodyBytes(requestContacts),
+		},
+		Then: mockserver.Response(http.StatusOK, responseContacts),
+	}.Server()
+	defer server.Close()
+
+	conn, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	result, err := conn.SearchRecords(t.Context(), common.SearchParams{
+		ObjectName: "contacts",
+		Fields:     connectors.Fields("email"),
+		Filter: &common.SearchFilter{
+			Conditions: []common.SearchCondition{{
+				FieldName: "email", Operator: common.SearchOperatorContains, Value: "*@example.com",
+			}},
+			Groups: []common.SearchFilter{{
+				Logic: common.SearchLogicOr,
+				Conditions: []common.SearchCondition{{
+					FieldName: "lifecyclestage", Operator: common.SearchOperatorIn, Value: []string{"lead", "customer"},
+				}, {
+					FieldName: "hs_lead_status", Operator: common.SearchOperatorExists,
+				}},
+			}},
+		},
+		SortBy:   []common.SearchSort{{FieldName: "createdate", Descending: true}},
+		PageSize: 50,
+		NextPage: "100",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, result.Rows, int64(3))
+	assert.Equal(t, result.NextPage, common.NextPageToken("394"))
+}
+
+func TestMakeFilterGroupsLimits(t *testing.T) {
+	t.Parallel()
+
+	condition := func(name string) common.SearchCondition {
+		return common.SearchCondition{FieldName: name, Operator: common.SearchOperatorEQ, Value: "x"}
+	}
+
+	either := func(names ...string) common.SearchFilter {
+		group := common.SearchFilter{Logic: common.SearchLogicOr}
+		for _, name := range names {
+			group.Conditions = append(group.Conditions, condition(name))
+		}
+
+		return group
+	}
+
+	// (a OR b) AND (c OR d) AND (e OR f) expands to 8 groups.
+	_, err := makeFilterGroups(&common.SearchFilter{
+		Groups: []common.SearchFilter{either("a", "b"), either("c", "d"), either("e", "f")},
+	})
+	assert.ErrorIs(t, err, common.ErrUnsupportedSearchFilter)
+
+	// (a OR b) AND c expands to 2 groups.
+	groups, err := makeFilterGroups(&common.SearchFilter{
+		Conditions: []common.SearchCondition{condition("c")},
+		Groups:     []common.SearchFilter{either("a", "b")},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, groups, []FilterGroup{
+		{Filters: []Filter{{FieldName: "c", Operator: FilterOperatorTypeEQ, Value: "x"},
+			{FieldName: "a", Operator: FilterOperatorTypeEQ, Value: "x"}}},
+		{Filters: []Filter{{FieldName: "c", Operator: FilterOperatorTypeEQ, Value: "x"},
+			{FieldName: "b", Operator: FilterOperatorTypeEQ, Value: "x"}}},
+	})
+
+	_, err = makeSortBy([]common.SearchSort{{FieldName: "a"}, {FieldName: "b"}})
+	assert.ErrorIs(t, err, common.ErrUnsupportedSearchSort)
+}
@@ -21,6 +21,8 @@ type SearchParams struct {
 	Fields datautils.Set[string] // optional
 	// AssociatedObjects is a list of associated objects to fetch along with the main object.
 	AssociatedObjects []string // optional
+	// PageSize is the number of records per page, up to 200.
+	PageSize int // optional
 }
 
 func (p SearchParams) ValidateParams() error {
@@ -86,6 +88,8 @@ type Filter struct {
 	FieldName string             `json:"propertyName,omitempty"`
 	Operator  FilterOperatorType `json:"operator,omitempty"`
 	Value     string             `json:"value,omitempty"`
+	// Values are used by IN and NIN operators.
+	Values []string `json:"values,omitempty"`
 }
 
 type (
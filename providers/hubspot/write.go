@@ -8,6 +8,7 @@ import (
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/logging"
 	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/batch"
 )
 
 type writeResponse struct {
@@ -48,7 +49,18 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 	// have to worry about it.
 	data := make(map[string]any)
 	data["properties"] = config.RecordData
-	data["associations"] = config.Associations
+
+	if config.RecordId == "" {
+		// Created records are linked by HubSpot along with the write.
+		associations, err := batch.NewAssociations(ctx, config.ObjectName, config.Associations, c.resolveAssociationType)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(associations) != 0 {
+			data["associations"] = associations
+		}
+	}
 
 	json, err := write(ctx, url, data)
 	if err != nil {
@@ -65,14 +77,59 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 		return nil, err
 	}
 
-	return &common.WriteResult{
+	result := &common.WriteResult{
 		RecordId: rsp.ID,
 		Success:  true,
 		Data:     record,
-	}, nil
+	}
+
+	if config.RecordId != "" {
+		// Existing records are linked once updated, failures don't undo the write.
+		if err = c.writeAssociations(ctx, config.ObjectName, rsp.ID, config.Associations); err != nil {
+			result.Success = false
+			result.Errors = []any{err}
+		}
+	}
+
+	return result, nil
 }
 
 func (c *Connector) BatchWrite(ctx context.Context, params *common.BatchWriteParam) (*common.BatchWriteResult, error) {
 	// Delegated.
-	return c.batchAdapter.BatchWrite(ctx, params)
+	result, err := c.batchAdapter.BatchWrite(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IsCreate() || params.IsDelete() {
+		// Created records carry their associations.
+		return result, nil
+	}
+
+	// Results are aligned with the batch by index.
+	// Records whose associations failed are reported as failed, the write itself is not undone.
+	unlinked := false
+
+	for index, item := range params.Batch {
+		if len(item.Associations) == 0 || index >= len(result.Results) {
+			continue
+		}
+
+		written := &result.Results[index]
+		if !written.Success {
+			continue
+		}
+
+		if err = c.writeAssociations(ctx, params.ObjectName.String(), written.RecordId, item.Associations); err != nil {
+			written.Success = false
+			written.Errors = append(written.Errors, err)
+			unlinked = true
+		}
+	}
+
+	if !unlinked {
+		return result, nil
+	}
+
+	return common.NewBatchWriteResult(result.Results, -1, result.SuccessCount+result.FailureCount, result.Errors)
 }
@@ -0,0 +1,189 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/hubspot/internal/batch"
+)
+
+const (
+	assocCategoryHubspotDefined = "HUBSPOT_DEFINED"
+	assocCategoryUserDefined    = "USER_DEFINED"
+)
+
+type assocLabelsOutput struct {
+	Results []assocType `json:"results"`
+}
+
+type assocArchiveLabelsInput struct {
+	Inputs []assocArchiveLabelsItem `json:"inputs"`
+}
+
+type assocArchiveLabelsItem struct {
+	Types []batch.AssociationType `json:"types"`
+	From  assocId                 `json:"from"`
+	To    assocId                 `json:"to"`
+}
+
+// writeAssociations links the existing record with targets via the v4 associations API.
+// Created records get associations along with the record, see batch.NewAssociations.
+// Each association is written separately, the first failure is returned.
+// See https://developers.hubspot.com/docs/guides/api/crm/associations/associations-v4
+func (c *Connector) writeAssociations(
+	ctx context.Context, objectName, recordID string, associations []common.AssociationInput,
+) error {
+	for _, association := range associations {
+		if err := c.writeAssociation(ctx, objectName, recordID, association); err != nil {
+			return fmt.Errorf("failed to write association with %s %s: %w",
+				association.TargetObject, association.TargetId, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Connector) writeAssociation(
+	ctx context.Context, objectName, recordID string, association common.AssociationInput,
+) error {
+	if association.TargetObject == "" {
+		return fmt.Errorf("%w: target object is required", common.ErrInvalidAssociationInput)
+	}
+
+	recordURL := fmt.Sprintf("%s/crm/v4/objects/%s/%s/associations",
+		c.providerInfo.BaseURL, objectName, recordID)
+
+	isDefault := association.AssociationType == "" && association.Label == ""
+
+	switch {
+	case isDefault && association.IsDissociate():
+		// Removes associations of every type between the records.
+		_, err := c.Client.Delete(ctx, fmt.Sprintf("%s/%s/%s",
+			recordURL, association.TargetObject, association.TargetId))
+
+		return err
+	case isDefault:
+		_, err := c.Client.Put(ctx, fmt.Sprintf("%s/default/%s/%s",
+			recordURL, association.TargetObject, association.TargetId), nil)
+
+		return err
+	}
+
+	assocType, err := c.resolveAssociationType(ctx, objectName, association)
+	if err != nil {
+		return err
+	}
+
+	if association.IsDissociate() {
+		_, err = c.Client.Post(ctx, fmt.Sprintf("%s/crm/v4/associations/%s/%s/batch/labels/archive",
+			c.providerInfo.BaseURL, objectName, association.TargetObject), assocArchiveLabelsInput{
+			Inputs: []assocArchiveLabelsItem{{
+				Types: []batch.AssociationType{*assocType},
+				From:  assocId{Id: recordID},
+				To:    assocId{Id: association.TargetId},
+			}},
+		})
+
+		return err
+	}
+
+	_, err = c.Client.Put(ctx, fmt.Sprintf("%s/%s/%s",
+		recordURL, association.TargetObject, association.TargetId), []batch.AssociationType{*assocType})
+
+	return err
+}
+
+// resolveAssociationType converts AssociationType or Label into the type accepted by HubSpot.
+// AssociationType can be a numeric type ID or a type as reported by reads, ex: "category=USER_DEFINED id=36".
+// Type of the label is looked up among labels defined between the objects,
+// without either of them the unlabelled type defined by HubSpot is used.
+func (c *Connector) resolveAssociationType(
+	ctx context.Context, objectName string, association common.AssociationInput,
+) (*batch.AssociationType, error) {
+	if association.TargetObject == "" {
+		return nil, fmt.Errorf("%w: target object is required", common.ErrInvalidAssociationInput)
+	}
+
+	if association.AssociationType != "" {
+		return parseAssociationType(association)
+	}
+
+	rsp, err := c.Client.Get(ctx, fmt.Sprintf("%s/crm/v4/associations/%s/%s/labels",
+		c.providerInfo.BaseURL, objectName, association.TargetObject))
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := common.UnmarshalJSON[assocLabelsOutput](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, label := range output.Results {
+		if matchesAssociationLabel(label, association.Label) {
+			return &batch.AssociationType{
+				Category: label.Category,
+				TypeId:   label.TypeId,
+			}, nil
+		}
+	}
+
+	if association.Label == "" {
+		return nil, fmt.Errorf("%w: no default association between %s and %s",
+			common.ErrUnsupportedAssociation, objectName, association.TargetObject)
+	}
+
+	return nil, fmt.Errorf("%w: label %q is not defined between %s and %s",
+		common.ErrInvalidAssociationInput, association.Label, objectName, association.TargetObject)
+}
+
+func matchesAssociationLabel(associationType assocType, label string) bool {
+	if label == "" {
+		return associationType.Label == nil && associationType.Category == assocCategoryHubspotDefined
+	}
+
+	return associationType.Label != nil && strings.EqualFold(*associationType.Label, label)
+}
+
+func parseAssociationType(association common.AssociationInput) (*batch.AssociationType, error) {
+	result := &batch.AssociationType{
+		Category: assocCategoryHubspotDefined,
+	}
+
+	if association.Label != "" {
+		result.Category = assocCategoryUserDefined
+	}
+
+	text := association.AssociationType
+	if !strings.Contains(text, "=") {
+		// Plain type ID.
+		text = "id=" + text
+	}
+
+	for _, part := range strings.Fields(text) {
+		key, value, _ := strings.Cut(part, "=")
+
+		switch key {
+		case "category":
+			result.Category = value
+		case "id":
+			typeID, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: association type %q is not a type ID",
+					common.ErrInvalidAssociationInput, association.AssociationType)
+			}
+
+			result.TypeId = typeID
+		}
+	}
+
+	if result.TypeId == 0 {
+		return nil, fmt.Errorf("%w: association type %q has no type ID",
+			common.ErrInvalidAssociationInput, association.AssociationType)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,322 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestWriteAssociations(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseCreateContact := []byte(`{"id":"101","properties":{"email":"john@hubspot.com"}}`)
+
+	caseCompanyLabels := mockserver.Case{
+		If: mockcond.And{
+			mockcond.MethodGET(),
+			mockcond.Path("/crm/v4/associations/contacts/companies/labels"),
+		},
+		Then: mockserver.ResponseString(http.StatusOK, `{"results":[
+			{"category":"HUBSPOT_DEFINED","typeId":1,"label":"Primary"},
+			{"category":"HUBSPOT_DEFINED","typeId":279,"label":null},
+			{"category":"USER_DEFINED","typeId":36,"label":"Manager"}
+		]}`),
+	}
+
+	expectedData := map[string]any{"id": "101"}
+
+	tests := []testroutines.Write{
+		{
+			Name: "Association target id is required",
+			Input: common.WriteParams{
+				ObjectName:   "contacts",
+				RecordData:   map[string]any{"email": "john@hubspot.com"},
+				Associations: []common.AssociationInput{{TargetObject: "companies"}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrInvalidAssociationInput},
+		},
+		{
+			Name: "Created record cannot be dissociated",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordData: map[string]any{"email": "john@hubspot.com"},
+				Associations: []common.AssociationInput{{
+					TargetObject: "companies",
+					TargetId:     "123",
+					Operation:    common.AssociationOperationDissociate,
+				}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrInvalidAssociationInput},
+		},
+		{
+			Name: "Default and labelled associations are written along with the created record",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordData: map[string]any{"email": "john@hubspot.com"},
+				Associations: []common.AssociationInput{{
+					TargetObject: "companies",
+					TargetId:     "123",
+				}, {
+					TargetObject: "companies",
+					TargetId:     "456",
+					Label:        "Manager",
+				}},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{caseCompanyLabels, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts"),
+						mockcond.Body(`{
+							"properties":{"email":"john@hubspot.com"},
+							"associations":[{
+								"to":{"id":"123"},
+								"types":[{"associationCategory":"HUBSPOT_DEFINED","associationTypeId":279}]
+							},{
+								"to":{"id":"456"},
+								"types":[{"associationCategory":"USER_DEFINED","associationTypeId":36}]
+							}]
+						}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseCreateContact),
+				}},
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "101",
+				Data:     expectedData,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Typed association is dissociated by archiving its label",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordId:   "101",
+				RecordData: map[string]any{"email": "john@hubspot.com"},
+				Associations: []common.AssociationInput{{
+					TargetObject:    "companies",
+					TargetId:        "456",
+					AssociationType: "category=USER_DEFINED id=36",
+					Operation:       common.AssociationOperationDissociate,
+				}},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.MethodPATCH(),
+						mockcond.Path("/crm/v3/objects/contacts/101"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseCreateContact),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v4/associations/contacts/companies/batch/labels/archive"),
+						mockcond.Body(`{"inputs":[{
+							"types":[{"associationCategory":"USER_DEFINED","associationTypeId":36}],
+							"from":{"id":"101"},
+							"to":{"id":"456"}
+						}]}`),
+					},
+					Then: mockserver.Response(http.StatusNoContent),
+				}},
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "101",
+				Data:     expectedData,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Unknown label prevents the record from being created",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordData: map[string]any{"email": "john@hubspot.com"},
+				Associations: []common.AssociationInput{{
+					TargetObject: "companies",
+					TargetId:     "456",
+					Label:        "Unknown",
+				}},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{caseCompanyLabels},
+			}.Server(),
+			ExpectedErrs: []error{common.ErrInvalidAssociationInput},
+		},
+		{
+			Name: "Failed association of updated record is reported as failed write",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordId:   "101",
+				RecordData: map[string]any{"email": "john@hubspot.com"},
+				Associations: []common.AssociationInput{{
+					TargetObject: "companies",
+					TargetId:     "456",
+				}},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.MethodPATCH(),
+						mockcond.Path("/crm/v3/objects/contacts/101"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseCreateContact),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPUT(),
+						mockcond.Path("/crm/v4/objects/contacts/101/associations/default/companies/456"),
+					},
+					Then: mockserver.ResponseString(http.StatusNotFound,
+						`{"status":"error","message":"Object not found","category":"OBJECT_NOT_FOUND"}`),
+				}},
+			}.Server(),
+			Comparator: func(_ string, actual, expected *common.WriteResult) bool {
+				return actual.Success == expected.Success &&
+					actual.RecordId == expected.RecordId &&
+					len(actual.Errors) == 1
+			},
+			Expected: &common.WriteResult{
+				Success:  false,
+				RecordId: "101",
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.WriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestBatchWriteAssociations(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseCreateContacts := testutils.DataFromFile(t, "batch/create/contacts/success.json")
+	responseUpdateContacts := testutils.DataFromFile(t, "batch/update/contacts/success.json")
+
+	tests := []testroutines.BatchWrite{
+		{
+			Name: "Created records carry their associations",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeCreate,
+				Batch: common.BatchItems{{
+					Record: map[string]any{"email": "Markus.Blevins@hubspot.com"},
+					Associations: []common.AssociationInput{{
+						TargetObject:    "companies",
+						TargetId:        "123",
+						AssociationType: "279",
+					}},
+				}, {
+					Record: map[string]any{"email": "Siena.Dyer@hubspot.com"},
+				}},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/crm/v3/objects/contacts/batch/create"),
+					mockcond.Body(`{"inputs":[{
+						"properties":{"email":"Markus.Blevins@hubspot.com"},
+						"associations":[{
+							"to":{"id":"123"},
+							"types":[{"associationCategory":"HUBSPOT_DEFINED","associationTypeId":279}]
+						}],
+						"objectWriteTraceId":"0"
+					},{
+						"properties":{"email":"Siena.Dyer@hubspot.com"},
+						"objectWriteTraceId":"1"
+					}]}`),
+				},
+				Then: mockserver.Response(http.StatusCreated, responseCreateContacts),
+			}.Server(),
+			Comparator: func(_ string, actual, expected *common.BatchWriteResult) bool {
+				return actual.Status == expected.Status && actual.SuccessCount == expected.SuccessCount
+			},
+			Expected: &common.BatchWriteResult{
+				Status:       common.BatchStatusSuccess,
+				SuccessCount: 2,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Updated record with failed association is reported as failed",
+			Input: &common.BatchWriteParam{
+				ObjectName: "contacts",
+				Type:       common.BatchWriteTypeUpdate,
+				Batch: common.BatchItems{{
+					Record: map[string]any{"id": "171591000198", "firstname": "Markus (updated)"},
+					Associations: []common.AssociationInput{{
+						TargetObject: "companies",
+						TargetId:     "456",
+					}},
+				}, {
+					Record: map[string]any{"id": "171591000199", "firstname": "Siena (updated)"},
+				}},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.Path("/crm/v3/objects/contacts/batch/update"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseUpdateContacts),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPUT(),
+						mockcond.Path("/crm/v4/objects/contacts/171591000198/associations/default/companies/456"),
+					},
+					Then: mockserver.ResponseString(http.StatusNotFound,
+						`{"status":"error","message":"Object not found","category":"OBJECT_NOT_FOUND"}`),
+				}},
+			}.Server(),
+			Comparator: func(_ string, actual, expected *common.BatchWriteResult) bool {
+				return actual.Status == expected.Status &&
+					actual.SuccessCount == expected.SuccessCount &&
+					actual.FailureCount == expected.FailureCount &&
+					!actual.Results[0].Success && len(actual.Results[0].Errors) == 1 &&
+					actual.Results[1].Success
+			},
+			Expected: &common.BatchWriteResult{
+				Status:       common.BatchStatusPartial,
+				SuccessCount: 1,
+				FailureCount: 1,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.BatchWriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
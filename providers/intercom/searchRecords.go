@@ -0,0 +1,211 @@
+package intercom
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/jsonquery"
+	"github.com/spyzhov/ajson"
+)
+
+var _ connectors.SearchConnector = &Connector{}
+
+// Intercom query language operators.
+// Missing values are matched by comparing with null.
+// Greater or equal and less or equal comparisons are not supported.
+// https://developers.intercom.com/docs/references/rest-api/api.intercom.io/contacts/searchcontacts
+var searchOperators = map[common.SearchOperator]string{ // nolint:gochecknoglobals
+	common.SearchOperatorEQ:          "=",
+	common.SearchOperatorNEQ:         "!=",
+	common.SearchOperatorGT:          ">",
+	common.SearchOperatorLT:          "<",
+	common.SearchOperatorIn:          "IN",
+	common.SearchOperatorNotIn:       "NIN",
+	common.SearchOperatorContains:    "~",
+	common.SearchOperatorNotContains: "!~",
+	common.SearchOperatorExists:      "!=",
+	common.SearchOperatorNotExists:   "=",
+}
+
+// SearchRecords implements connectors.SearchConnector for contacts, conversations and tickets.
+// The filter tree is sent as nested queries, Intercom allows at most 2 levels of nesting.
+// Only a single sort key is supported. NextPage holds "starting_after" cursor.
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if !incrementalSearchObjectPagination.Has(params.ObjectName) {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
+	payload, err := makeSearchRecordsPayload(params)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := constructURL(c.BaseURL, params.ObjectName, "search")
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Post(ctx, url.String(), payload, apiVersionHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		getRecords,
+		getNextSearchCursor,
+		common.GetMarshaledData,
+		params.Fields,
+	)
+}
+
+type searchRecordsPayload struct {
+	Query      *searchNode      `json:"query,omitempty"`
+	Sort       *searchSort      `json:"sort,omitempty"`
+	Pagination searchPagination `json:"pagination"`
+}
+
+// searchNode is either a condition on the field or a group of nodes.
+type searchNode struct {
+	Field    string `json:"field,omitempty"`
+	Operator string `json:"operator"`
+	Value    any    `json:"value"`
+}
+
+type searchSort struct {
+	Field string `json:"field"`
+	Order string `json:"order"`
+}
+
+func makeSearchRecordsPayload(params common.SearchParams) (*searchRecordsPayload, error) {
+	payload := &searchRecordsPayload{
+		Pagination: searchPagination{
+			PerPage:       incrementalSearchObjectPagination.Get(params.ObjectName),
+			StartingAfter: params.NextPage.String(),
+		},
+	}
+
+	if params.PageSize != 0 {
+		payload.Pagination.PerPage = params.PageSize
+	}
+
+	if params.Filter != nil {
+		query, err := makeSearchGroup(*params.Filter, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		payload.Query = query
+	}
+
+	switch len(params.SortBy) {
+	case 0:
+	case 1:
+		order := "ascending"
+		if params.SortBy[0].Descending {
+			order = "descending"
+		}
+
+		payload.Sort = &searchSort{
+			Field: params.SortBy[0].FieldName,
+			Order: order,
+		}
+	default:
+		return nil, fmt.Errorf("%w: only one sort key is allowed", common.ErrUnsupportedSearchSort)
+	}
+
+	return payload, nil
+}
+
+// maxSearchNesting is how deep groups can be nested below the top level query.
+const maxSearchNesting = 2
+
+func makeSearchGroup(filter common.SearchFilter, depth int) (*searchNode, error) {
+	if depth > maxSearchNesting {
+		return nil, fmt.Errorf("%w: at most %d levels of nesting are allowed",
+			common.ErrUnsupportedSearchFilter, maxSearchNesting)
+	}
+
+	nodes := make([]*searchNode, 0, len(filter.Conditions)+len(filter.Groups))
+
+	for _, condition := range filter.Conditions {
+		node, err := makeSearchCondition(condition)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	for _, group := range filter.Groups {
+		node, err := makeSearchGroup(group, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	operator := "AND"
+	if filter.JoinLogic() == common.SearchLogicOr {
+		operator = "OR"
+	}
+
+	return &searchNode{
+		Operator: operator,
+		Value:    nodes,
+	}, nil
+}
+
+func makeSearchCondition(condition common.SearchCondition) (*searchNode, error) {
+	operator, ok := searchOperators[condition.Operator]
+	if !ok {
+		return nil, fmt.Errorf("%w: operator %q", common.ErrUnsupportedSearchFilter, condition.Operator)
+	}
+
+	node := &searchNode{
+		Field:    condition.FieldName,
+		Operator: operator,
+		Value:    condition.Value,
+	}
+
+	switch {
+	case condition.Operator == common.SearchOperatorExists || condition.Operator == common.SearchOperatorNotExists:
+		node.Value = nil
+	case condition.Operator.IsList():
+		values, err := condition.Values()
+		if err != nil {
+			return nil, err
+		}
+
+		node.Value = values
+	default:
+		// Timestamps are compared in Unix time format.
+		if moment, isTime := condition.Value.(time.Time); isTime {
+			node.Value = moment.Unix()
+		}
+	}
+
+	return node, nil
+}
+
+// Search response carries the cursor at "pages.next.starting_after".
+func getNextSearchCursor(node *ajson.Node) (string, error) {
+	cursor, err := jsonquery.New(node, "pages", "next").StringOptional("starting_after")
+	if err != nil {
+		return "", err
+	}
+
+	if cursor == nil {
+		return "", nil
+	}
+
+	return *cursor, nil
+}
@@ -0,0 +1,71 @@
+package intercom
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"gotest.tools/v3/assert"
+)
+
+func TestSearchRecords(t *testing.T) {
+	t.Parallel()
+
+	requestSearchConversations := testutils.DataFromFile(t, "search-conversations-request.json")
+	responseSearchConversations := testutils.DataFromFile(t, "read-search-conversations.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodPOST(),
+			mockcond.Path("/conversations/search"),
+			mockcond.BodyBytes(requestSearchConversations),
+		},
+		Then: mockserver.Response(http.StatusOK, responseSearchConversations),
+	}.Server()
+	defer server.Close()
+
+	conn, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	result, err := conn.SearchRecords(t.Context(), common.SearchParams{
+		ObjectName: "conversations",
+		Fields:     connectors.Fields("state"),
+		Filter: &common.SearchFilter{
+			Conditions: []common.SearchCondition{{
+				FieldName: "state", Operator: common.SearchOperatorIn, Value: []string{"open", "snoozed"},
+			}},
+			Groups: []common.SearchFilter{{
+				Logic: common.SearchLogicOr,
+				Conditions: []common.SearchCondition{{
+					FieldName: "updated_at", Operator: common.SearchOperatorGT, Value: time.Unix(1726674883, 0),
+				}, {
+					FieldName: "admin_assignee_id", Operator: common.SearchOperatorNotExists,
+				}},
+			}},
+		},
+		SortBy:   []common.SearchSort{{FieldName: "updated_at", Descending: true}},
+		PageSize: 1,
+		NextPage: "WzE3MjY3NTIxNDUwMDAsNCwyXQ==",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, result.Rows, int64(1))
+	assert.Equal(t, result.NextPage, common.NextPageToken("WzE3MjY3NTIxNDUwMDAsNSwyXQ=="))
+	assert.DeepEqual(t, result.Data[0].Fields, map[string]any{"state": "open"})
+
+	_, err = conn.SearchRecords(t.Context(), common.SearchParams{
+		ObjectName: "conversations",
+		Fields:     connectors.Fields("state"),
+		Filter: &common.SearchFilter{
+			Conditions: []common.SearchCondition{{
+				FieldName: "updated_at", Operator: common.SearchOperatorGTE, Value: 1726674883,
+			}},
+		},
+	})
+	assert.ErrorIs(t, err, common.ErrUnsupportedSearchFilter)
+}
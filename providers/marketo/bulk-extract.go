@@ -0,0 +1,427 @@
+package marketo
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+)
+
+const (
+	bulkPrefix = "bulk/v1"
+
+	// Marketo rejects export jobs with a date filter spanning more than 31 days.
+	maxExtractWindow = 31 * 24 * time.Hour
+
+	// Job status is updated by Marketo at most every minute.
+	defaultExtractPollInterval = time.Minute
+)
+
+// BulkExtractDateField selects the timestamp filtering exported records.
+type BulkExtractDateField string
+
+const (
+	BulkExtractCreatedAt BulkExtractDateField = "createdAt"
+	// BulkExtractUpdatedAt is supported by leads only.
+	BulkExtractUpdatedAt BulkExtractDateField = "updatedAt"
+)
+
+// Export job statuses.
+// https://experienceleague.adobe.com/en/docs/marketo-developer/marketo/rest/bulk-extract/bulk-extract
+const (
+	extractStatusCompleted = "Completed"
+	extractStatusFailed    = "Failed"
+	extractStatusCancelled = "Cancelled"
+)
+
+var (
+	// ErrBulkExtractUnsupportedObject is returned for objects other than leads and activities.
+	ErrBulkExtractUnsupportedObject = errors.New("bulk extract supports leads and activities only")
+
+	// ErrBulkExtractMissingSince is returned when the start of the date window is not given.
+	ErrBulkExtractMissingSince = errors.New("bulk extract requires the Since timestamp")
+
+	// ErrBulkExtractDateField is returned when the object cannot be filtered by the date field.
+	ErrBulkExtractDateField = errors.New("bulk extract date field is not supported by the object")
+
+	// ErrBulkExtractJobFailed is returned when Marketo fails or cancels the export job.
+	ErrBulkExtractJobFailed = errors.New("bulk extract job did not complete")
+)
+
+// BulkExtractParams describes records exported by BulkExtract.
+// Records created (or updated) within [Since, Until] are exported, Until defaults to now.
+// Marketo date filters include both ends and have a precision of one second.
+// Fields name the exported columns of leads. Activities are exported with every column,
+// Filter may hold comma-separated activityTypeIds to narrow them down.
+type BulkExtractParams struct {
+	common.ReadParams
+
+	// DateField defaults to createdAt.
+	DateField BulkExtractDateField
+}
+
+// BulkExtractHandler receives exported records one at a time.
+// Returning an error stops the extract, the error is returned to the caller.
+type BulkExtractHandler func(row common.ReadResultRow) error
+
+func (p BulkExtractParams) ValidateParams() error {
+	if err := p.ReadParams.ValidateParams(p.ObjectName == leads); err != nil {
+		return err
+	}
+
+	if p.ObjectName != leads && p.ObjectName != activities {
+		return fmt.Errorf("%w: %s", ErrBulkExtractUnsupportedObject, p.ObjectName)
+	}
+
+	if p.Since.IsZero() {
+		return ErrBulkExtractMissingSince
+	}
+
+	if p.ObjectName == activities && p.dateField() != BulkExtractCreatedAt {
+		return fmt.Errorf("%w: %s of %s", ErrBulkExtractDateField, p.dateField(), p.ObjectName)
+	}
+
+	return nil
+}
+
+func (p BulkExtractParams) dateField() BulkExtractDateField {
+	if p.DateField == "" {
+		return BulkExtractCreatedAt
+	}
+
+	return p.DateField
+}
+
+// BulkExtract exports leads or activities through the Bulk Extract API, which doesn't consume
+// the daily quota of REST calls per record page.
+// The date range is split into windows of at most 31 days, each window is exported by its own job.
+// Jobs run one after another: every job is created, enqueued and polled until completion,
+// then its CSV file is streamed to the handler.
+//
+// https://experienceleague.adobe.com/en/docs/marketo-developer/marketo/rest/bulk-extract/bulk-extract
+func (c *Connector) BulkExtract(
+	ctx context.Context, params BulkExtractParams, handler BulkExtractHandler,
+) error {
+	if err := params.ValidateParams(); err != nil {
+		return err
+	}
+
+	until := params.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+
+	for _, window := range splitExtractWindows(params.Since, until) {
+		exportID, err := c.createExtractJob(ctx, params, window)
+		if err != nil {
+			return err
+		}
+
+		if err = c.enqueueExtractJob(ctx, params.ObjectName, exportID); err != nil {
+			return err
+		}
+
+		if err = c.awaitExtractJob(ctx, params.ObjectName, exportID); err != nil {
+			return err
+		}
+
+		if err = c.streamExtractFile(ctx, params, exportID, handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type extractWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// splitExtractWindows splits the range into consecutive windows not exceeding the Marketo limit.
+// Marketo includes both ends of the window and filters by the second, therefore the next window
+// starts a second after the previous one ends, so that records at the boundary are extracted once.
+func splitExtractWindows(since, until time.Time) []extractWindow {
+	var windows []extractWindow
+
+	for start := since.Truncate(time.Second); start.Before(until); {
+		end := minTime(start.Add(maxExtractWindow), until)
+
+		windows = append(windows, extractWindow{
+			start: start,
+			end:   end,
+		})
+
+		start = end.Add(time.Second)
+	}
+
+	return windows
+}
+
+func minTime(first, second time.Time) time.Time {
+	if first.Before(second) {
+		return first
+	}
+
+	return second
+}
+
+type extractJobPayload struct {
+	Fields []string         `json:"fields,omitempty"`
+	Format string           `json:"format"`
+	Filter extractJobFilter `json:"filter"`
+}
+
+type extractJobFilter map[string]any
+
+type extractDateFilter struct {
+	StartAt string `json:"startAt"`
+	EndAt   string `json:"endAt"`
+}
+
+type extractJobResponse struct {
+	Result []extractJob `json:"result"`
+}
+
+type extractJob struct {
+	ExportID string `json:"exportId"`
+	Status   string `json:"status"`
+	ErrorMsg string `json:"errorMsg,omitempty"`
+}
+
+func (r *extractJobResponse) job() (*extractJob, error) {
+	if r == nil || len(r.Result) == 0 {
+		return nil, fmt.Errorf("%w: export job is missing", common.ErrEmptyJSONHTTPResponse)
+	}
+
+	return &r.Result[0], nil
+}
+
+func (c *Connector) createExtractJob(
+	ctx context.Context, params BulkExtractParams, window extractWindow,
+) (string, error) {
+	payload, err := newExtractJobPayload(params, window)
+	if err != nil {
+		return "", err
+	}
+
+	url, err := c.getExtractURL(params.ObjectName, "create")
+	if err != nil {
+		return "", err
+	}
+
+	rsp, err := c.Client.Post(ctx, url.String(), payload)
+	if err != nil {
+		return "", err
+	}
+
+	job, err := parseExtractJob(rsp)
+	if err != nil {
+		return "", err
+	}
+
+	return job.ExportID, nil
+}
+
+func newExtractJobPayload(params BulkExtractParams, window extractWindow) (*extractJobPayload, error) {
+	filter := extractJobFilter{
+		string(params.dateField()): extractDateFilter{
+			StartAt: window.start.UTC().Format(time.RFC3339),
+			EndAt:   window.end.UTC().Format(time.RFC3339),
+		},
+	}
+
+	payload := &extractJobPayload{
+		Format: "CSV",
+		Filter: filter,
+	}
+
+	if params.ObjectName == leads {
+		payload.Fields = params.Fields.List()
+
+		return payload, nil
+	}
+
+	if params.Filter != "" {
+		typeIDs, err := parseActivityTypeIDs(params.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		filter["activityTypeIds"] = typeIDs
+	}
+
+	return payload, nil
+}
+
+func parseActivityTypeIDs(filter string) ([]int, error) {
+	values := strings.Split(filter, ",")
+	identifiers := make([]int, 0, len(values))
+
+	for _, value := range values {
+		identifier, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrFilterInvalid, err)
+		}
+
+		identifiers = append(identifiers, identifier)
+	}
+
+	return identifiers, nil
+}
+
+func (c *Connector) enqueueExtractJob(ctx context.Context, objectName, exportID string) error {
+	url, err := c.getExtractURL(objectName, exportID, "enqueue")
+	if err != nil {
+		return err
+	}
+
+	rsp, err := c.Client.Post(ctx, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = parseExtractJob(rsp)
+
+	return err
+}
+
+// awaitExtractJob polls the job status until the file is ready.
+func (c *Connector) awaitExtractJob(ctx context.Context, objectName, exportID string) error {
+	url, err := c.getExtractURL(objectName, exportID, "status")
+	if err != nil {
+		return err
+	}
+
+	for {
+		rsp, err := c.Client.Get(ctx, url.String())
+		if err != nil {
+			return err
+		}
+
+		job, err := parseExtractJob(rsp)
+		if err != nil {
+			return err
+		}
+
+		switch job.Status {
+		case extractStatusCompleted:
+			return nil
+		case extractStatusFailed, extractStatusCancelled:
+			return fmt.Errorf("%w: export %s is %s: %s", ErrBulkExtractJobFailed, exportID, job.Status, job.ErrorMsg)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.extractPollInterval):
+		}
+	}
+}
+
+func parseExtractJob(rsp *common.JSONHTTPResponse) (*extractJob, error) {
+	response, err := common.UnmarshalJSON[extractJobResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.job()
+}
+
+// streamExtractFile reads the exported CSV file and passes every record to the handler.
+// The file is not JSON, it is requested with the authenticated client directly.
+func (c *Connector) streamExtractFile(
+	ctx context.Context, params BulkExtractParams, exportID string, handler BulkExtractHandler,
+) error {
+	url, err := c.getExtractURL(params.ObjectName, exportID, "file")
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	rsp, err := c.Client.HTTPClient.Client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: export file returned status %d", common.ErrRequestFailed, rsp.StatusCode)
+	}
+
+	reader := csv.NewReader(rsp.Body)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			// No records within the window.
+			return nil
+		}
+
+		return errors.Join(common.ErrParseError, err)
+	}
+
+	for {
+		line, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return errors.Join(common.ErrParseError, err)
+		}
+
+		if err = handler(newExtractRow(params, header, line)); err != nil {
+			return err
+		}
+	}
+}
+
+func newExtractRow(params BulkExtractParams, header, line []string) common.ReadResultRow {
+	raw := make(map[string]any, len(header))
+
+	for position, column := range header {
+		if position >= len(line) || line[position] == "null" {
+			// Marketo exports empty values as null.
+			raw[column] = nil
+
+			continue
+		}
+
+		raw[column] = line[position]
+	}
+
+	identifierColumn := idFilter
+	if params.ObjectName == activities {
+		identifierColumn = "marketoGUID"
+	}
+
+	identifier, _ := raw[identifierColumn].(string)
+
+	return common.ReadResultRow{
+		Fields: common.ExtractLowercaseFieldsFromRaw(params.Fields.List(), raw),
+		Raw:    raw,
+		Id:     identifier,
+	}
+}
+
+func (c *Connector) getExtractURL(objectName string, paths ...string) (*urlbuilder.URL, error) {
+	parts := append([]string{bulkPrefix, objectName, "export"}, paths...)
+	parts[len(parts)-1] = common.AddSuffixIfNotExists(parts[len(parts)-1], ".json")
+
+	return urlbuilder.New(c.BaseURL, parts...)
+}
@@ -0,0 +1,271 @@
+package marketo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+// extractServer is a stand-in for the Marketo Bulk Extract API.
+// Every created job is completed after one status check and serves the CSV file of its window.
+type extractServer struct {
+	mu      sync.Mutex
+	filters []map[string]any
+	polls   map[string]int
+	files   []string
+	// failure is reported as the job status instead of completing the job.
+	failure string
+}
+
+func (s *extractServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	path := strings.TrimPrefix(r.URL.Path, "/bulk/v1/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case strings.HasSuffix(path, "/export/create.json"):
+		var payload map[string]any
+
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &payload)
+
+		filter, _ := payload["filter"].(map[string]any)
+		s.filters = append(s.filters, filter)
+
+		writeJob(w, fmt.Sprintf("job-%d", len(s.filters)-1), "Created")
+	case strings.HasSuffix(path, "/enqueue.json"):
+		writeJob(w, parts[2], "Queued")
+	case strings.HasSuffix(path, "/status.json"):
+		s.polls[parts[2]]++
+
+		status := "Processing"
+		if s.polls[parts[2]] > 1 {
+			status = "Completed"
+		}
+
+		if s.failure != "" {
+			status = s.failure
+		}
+
+		writeJob(w, parts[2], status)
+	case strings.HasSuffix(path, "/file.json"):
+		var index int
+
+		_, _ = fmt.Sscanf(parts[2], "job-%d", &index)
+
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte(s.files[index]))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeJob(w http.ResponseWriter, exportID, status string) {
+	_, _ = fmt.Fprintf(w, `{"success":true,"result":[{"exportId":"%s","status":"%s"}]}`, exportID, status)
+}
+
+func constructExtractConnector(t *testing.T, server *extractServer) *Connector {
+	t.Helper()
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	connector, err := constructTestConnector(httpServer.URL)
+	if err != nil {
+		t.Fatalf("failed to construct connector: %v", err)
+	}
+
+	connector.extractPollInterval = time.Millisecond
+
+	return connector
+}
+
+func TestBulkExtractLeadsSplitsWindows(t *testing.T) {
+	t.Parallel()
+
+	server := &extractServer{
+		polls: make(map[string]int),
+		files: []string{
+			"id,email,company\n1,first@example.com,Acme\n2,second@example.com,null\n",
+			"id,email,company\n3,third@example.com,\"Globex, Inc.\"\n",
+		},
+	}
+	connector := constructExtractConnector(t, server)
+
+	since := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var rows []common.ReadResultRow
+
+	err := connector.BulkExtract(t.Context(), BulkExtractParams{
+		ReadParams: common.ReadParams{
+			ObjectName: "leads",
+			Fields:     connectors.Fields("id", "email", "company"),
+			Since:      since,
+			Until:      since.Add(45 * 24 * time.Hour),
+		},
+		DateField: BulkExtractUpdatedAt,
+	}, func(row common.ReadResultRow) error {
+		rows = append(rows, row)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(server.filters) != 2 {
+		t.Fatalf("expected 2 export jobs, got %v", len(server.filters))
+	}
+
+	first, _ := server.filters[0]["updatedAt"].(map[string]any)
+	second, _ := server.filters[1]["updatedAt"].(map[string]any)
+
+	if first["startAt"] != "2025-01-01T00:00:00Z" || first["endAt"] != "2025-02-01T00:00:00Z" ||
+		second["startAt"] != "2025-02-01T00:00:01Z" || second["endAt"] != "2025-02-15T00:00:00Z" {
+		t.Fatalf("unexpected export windows: %v", server.filters)
+	}
+
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %v", len(rows))
+	}
+
+	if rows[0].Id != "1" || rows[0].Fields["email"] != "first@example.com" {
+		t.Fatalf("unexpected first row: %v", rows[0])
+	}
+
+	if rows[1].Raw["company"] != nil {
+		t.Fatalf("expected null company, got %v", rows[1].Raw["company"])
+	}
+
+	if rows[2].Fields["company"] != "Globex, Inc." {
+		t.Fatalf("unexpected quoted value: %v", rows[2].Fields["company"])
+	}
+}
+
+func TestBulkExtractActivities(t *testing.T) {
+	t.Parallel()
+
+	server := &extractServer{
+		polls: make(map[string]int),
+		files: []string{
+			"marketoGUID,leadId,activityDate,activityTypeId\n" +
+				"7c3a,1,2025-01-02T10:00:00Z,12\n",
+		},
+	}
+	connector := constructExtractConnector(t, server)
+
+	since := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var rows []common.ReadResultRow
+
+	err := connector.BulkExtract(t.Context(), BulkExtractParams{
+		ReadParams: common.ReadParams{
+			ObjectName: "activities",
+			Fields:     connectors.Fields("leadId"),
+			Since:      since,
+			Until:      since.Add(24 * time.Hour),
+			Filter:     "12, 13",
+		},
+	}, func(row common.ReadResultRow) error {
+		rows = append(rows, row)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	typeIDs, _ := server.filters[0]["activityTypeIds"].([]any)
+	if len(typeIDs) != 2 || server.filters[0]["createdAt"] == nil {
+		t.Fatalf("unexpected activities filter: %v", server.filters[0])
+	}
+
+	if len(rows) != 1 || rows[0].Id != "7c3a" || rows[0].Fields["leadid"] != "1" {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+}
+
+func TestBulkExtractFailedJob(t *testing.T) {
+	t.Parallel()
+
+	connector := constructExtractConnector(t, &extractServer{
+		polls:   make(map[string]int),
+		failure: "Failed",
+	})
+
+	err := connector.BulkExtract(t.Context(), BulkExtractParams{
+		ReadParams: common.ReadParams{
+			ObjectName: "leads",
+			Fields:     connectors.Fields("id"),
+			Since:      time.Now().Add(-time.Hour),
+		},
+	}, func(common.ReadResultRow) error {
+		t.Fatal("no rows are expected from a failed job")
+
+		return nil
+	})
+	if !errors.Is(err, ErrBulkExtractJobFailed) {
+		t.Fatalf("expected %v, got %v", ErrBulkExtractJobFailed, err)
+	}
+}
+
+func TestBulkExtractValidation(t *testing.T) {
+	t.Parallel()
+
+	connector := constructExtractConnector(t, &extractServer{polls: make(map[string]int)})
+
+	handler := func(common.ReadResultRow) error { return nil }
+
+	tests := []struct {
+		name     string
+		params   BulkExtractParams
+		expected error
+	}{
+		{
+			name: "Unsupported object",
+			params: BulkExtractParams{ReadParams: common.ReadParams{
+				ObjectName: "companies", Fields: connectors.Fields("id"), Since: time.Now(),
+			}},
+			expected: ErrBulkExtractUnsupportedObject,
+		},
+		{
+			name: "Since is required",
+			params: BulkExtractParams{ReadParams: common.ReadParams{
+				ObjectName: "leads", Fields: connectors.Fields("id"),
+			}},
+			expected: ErrBulkExtractMissingSince,
+		},
+		{
+			name: "Activities are filtered by creation only",
+			params: BulkExtractParams{
+				ReadParams: common.ReadParams{ObjectName: "activities", Since: time.Now()},
+				DateField:  BulkExtractUpdatedAt,
+			},
+			expected: ErrBulkExtractDateField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := connector.BulkExtract(t.Context(), tt.params, handler); !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
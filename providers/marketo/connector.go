@@ -1,6 +1,8 @@
 package marketo
 
 import (
+	"time"
+
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/paramsbuilder"
 	"github.com/amp-labs/connectors/common/urlbuilder"
@@ -10,6 +12,9 @@ import (
 type Connector struct {
 	BaseURL string
 	Client  *common.JSONHTTPClient
+
+	// extractPollInterval is the delay between status checks of bulk extract jobs.
+	extractPollInterval time.Duration
 }
 
 func NewConnector(opts ...Option) (conn *Connector, outErr error) {
@@ -31,6 +36,7 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 				ResponseHandler: responseHandler,
 			},
 		},
+		extractPollInterval: defaultExtractPollInterval,
 	}
 
 	conn.setBaseURL(providerInfo.BaseURL)
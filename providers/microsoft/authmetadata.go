@@ -0,0 +1,71 @@
+package microsoft
+
+import (
+	"context"
+	"errors"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/logging"
+)
+
+var ErrDiscoveryFailure = errors.New("failed to collect post authentication data")
+
+// GetPostAuthInfo finds the tenant of the signed-in user.
+// Connections made with the "common" tenant learn their actual tenant this way.
+// The catalog doesn't depend on the tenant, requests and token refreshes work with the "common" tenant,
+// therefore the discovery is not required to open the connection.
+// Personal accounts don't belong to any tenant, no metadata is reported for them.
+func (c *Connector) GetPostAuthInfo(ctx context.Context) (*common.PostAuthInfo, error) {
+	tenantId, rsp, err := c.retrieveTenantId(ctx)
+	if err != nil {
+		return nil, errors.Join(ErrDiscoveryFailure, err)
+	}
+
+	if tenantId == "" {
+		return &common.PostAuthInfo{RawResponse: rsp}, nil
+	}
+
+	return &common.PostAuthInfo{
+		CatalogVars: AuthMetadataVars{
+			TenantId: tenantId,
+		}.AsMap(),
+		RawResponse:          rsp,
+		ProviderWorkspaceRef: tenantId,
+	}, nil
+}
+
+type organizationResponse struct {
+	Value []struct {
+		Id string `json:"id"`
+	} `json:"value"`
+}
+
+// retrieveTenantId reads the organization of the signed-in user.
+// Personal accounts have no organization, the tenant is empty then.
+// https://learn.microsoft.com/en-us/graph/api/organization-list
+func (c *Connector) retrieveTenantId(ctx context.Context) (string, *common.JSONHTTPResponse, error) {
+	ctx = logging.With(ctx, "connector", "microsoft")
+
+	url, err := c.getURL("organization")
+	if err != nil {
+		return "", nil, err
+	}
+
+	url.WithQueryParam("$select", "id")
+
+	rsp, err := c.JSONHTTPClient().Get(ctx, url.String())
+	if err != nil {
+		return "", nil, err
+	}
+
+	organizations, err := common.UnmarshalJSON[organizationResponse](rsp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if organizations == nil || len(organizations.Value) == 0 {
+		return "", rsp, nil
+	}
+
+	return organizations.Value[0].Id, rsp, nil
+}
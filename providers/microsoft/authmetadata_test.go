@@ -0,0 +1,98 @@
+package microsoft
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+)
+
+func TestGetPostAuthInfo(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		server            mockserver.Switch
+		expectedTenant    string
+		expectedWorkspace string
+		expectedErrs      []error
+	}{
+		{
+			name: "Tenant of work account",
+			server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.Path("/v1.0/organization"),
+						mockcond.QueryParam("$select", "id"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK,
+						`{"value":[{"id":"84841066-274d-4ec0-a5c1-276be684bdd3"}]}`),
+				}},
+			},
+			expectedTenant:    "84841066-274d-4ec0-a5c1-276be684bdd3",
+			expectedWorkspace: "84841066-274d-4ec0-a5c1-276be684bdd3",
+		},
+		{
+			name: "Personal account has no tenant",
+			server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.Path("/v1.0/organization"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"value":[]}`),
+				}},
+			},
+			expectedTenant: "",
+		},
+		{
+			name: "Failed request",
+			server: mockserver.Switch{
+				Setup:   mockserver.ContentJSON(),
+				Default: mockserver.ResponseString(http.StatusForbidden, `{"error":{"message":"Forbidden"}}`),
+			},
+			expectedErrs: []error{ErrDiscoveryFailure},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tt.server.Server()
+			defer server.Close()
+
+			connector, err := constructTestConnector(server.URL)
+			if err != nil {
+				t.Fatalf("%s: failed to setup connector: %v", tt.name, err)
+			}
+
+			output, err := connector.GetPostAuthInfo(t.Context())
+
+			for _, expectedErr := range tt.expectedErrs {
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("%s: expected error (%v), got (%v)", tt.name, expectedErr, err)
+				}
+			}
+
+			if len(tt.expectedErrs) != 0 {
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s: expected no errors, got: (%v)", tt.name, err)
+			}
+
+			var expected *map[string]string
+			if tt.expectedTenant != "" {
+				expected = AuthMetadataVars{TenantId: tt.expectedTenant}.AsMap()
+			}
+
+			if !reflect.DeepEqual(output.CatalogVars, expected) || output.ProviderWorkspaceRef != tt.expectedWorkspace {
+				t.Fatalf("%s: unexpected output (%v, %q)", tt.name, output.CatalogVars, output.ProviderWorkspaceRef)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package microsoft
+
+const tenantIdKey = "tenantId"
+
+// AuthMetadataVars is a complete list of authentication metadata associated with connector.
+// This model serves as a documentation of map[string]string contents.
+type AuthMetadataVars struct {
+	// TenantId identifies the Microsoft Entra organization of the signed-in user.
+	// Empty for personal Microsoft accounts, which don't belong to any organization.
+	TenantId string
+}
+
+// NewAuthMetadataVars parses map into the model.
+func NewAuthMetadataVars(dictionary map[string]string) *AuthMetadataVars {
+	return &AuthMetadataVars{
+		TenantId: dictionary[tenantIdKey],
+	}
+}
+
+// AsMap converts model back to the map.
+func (v AuthMetadataVars) AsMap() *map[string]string {
+	return &map[string]string{
+		tenantIdKey: v.TenantId,
+	}
+}
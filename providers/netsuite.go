@@ -1,5 +1,7 @@
 package providers
 
+import "github.com/amp-labs/connectors/common"
+
 const (
 	Netsuite Provider = "netsuite"
 
@@ -95,4 +97,11 @@ func init() {
 			},
 		},
 	})
+
+	// Token-based authentication is accepted next to OAuth2, the realm is the account ID in its canonical form.
+	SetOAuth1Info(Netsuite, Oauth1Opts{
+		SignatureMethod: common.OAuth1HMACSHA256,
+		Realm:           netsuiteTokenBasedAuthRealm,
+		DocsURL:         "https://docs.oracle.com/en/cloud/saas/netsuite/ns-online-help/section_4247337262.html",
+	})
 }
@@ -21,9 +21,7 @@ type Connector struct {
 	common.RequireAuthenticatedClient
 	common.RequireWorkspace
 
-	// TODO: Make REST API module's concurrent record fetching limit configurable
-	// (currently hardcoded to 5)
-	// https://github.com/amp-labs/connectors/pull/1920#discussion_r2248615641
+	// REST API module fetches records concurrently, within the limits of ConnectorParams.Governor.
 	RESTAPI *restapi.Adapter
 	SuiteQL *suiteql.Adapter
 
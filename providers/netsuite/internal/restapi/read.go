@@ -17,8 +17,8 @@ import (
 
 const (
 	// maxRecordsToFetchConcurrently was chosen for the broadest compatibility.
-	// If a consumer has a license that allows for more than 5 concurrent requests,
-	// we should make this configurable.
+	// Consumers whose license allows for more concurrent requests (ex: SuiteCloud Plus)
+	// configure it via the governor of the connection.
 	maxRecordsToFetchConcurrently = 2
 
 	maxRecordsPerPage = 1000
@@ -126,7 +126,7 @@ func (a *Adapter) getMarshaledData(ctx context.Context) common.MarshalFunc {
 }
 
 // fetchRecords fetches records from the given URLs concurrently. It does so in
-// batches of maxRecordsToFetchConcurrently to avoid running into rate limits.
+// batches of maxRecordsToFetchConcurrently, unless the governor allows more requests in flight.
 // nolint:funlen
 func (a *Adapter) fetchRecords(ctx context.Context, recordsToFetch []string) ([]map[string]any, error) {
 	type result struct {
@@ -173,7 +173,9 @@ func (a *Adapter) fetchRecords(ctx context.Context, recordsToFetch []string) ([]
 
 	// This will block until all callbacks are done. Note that since the
 	// channel is buffered, we won't block on sending results.
-	if err := simultaneously.DoCtx(ctx, maxRecordsToFetchConcurrently, callbacks...); err != nil {
+	concurrency := a.Governor().Concurrency(maxRecordsToFetchConcurrently)
+
+	if err := simultaneously.DoCtx(ctx, concurrency, callbacks...); err != nil {
 		close(resultChan)
 
 		return nil, fmt.Errorf("error fetching records concurrently: %w", err)
@@ -0,0 +1,66 @@
+package providers
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Oauth1 marks OAuth 1.0a token-based authentication.
+// The catalog schema has no OAuth 1.0a options, providers accepting signed requests next to
+// their AuthType are registered with SetOAuth1Info instead.
+const Oauth1 AuthType = "oauth1"
+
+// Oauth1Opts configures OAuth 1.0a token-based authentication of the provider.
+type Oauth1Opts struct {
+	// SignatureMethod is the method used to sign requests.
+	SignatureMethod common.OAuth1SignatureMethod
+
+	// Realm derives the protection realm sent in the Authorization header from the resolved catalog entry.
+	// Nil means no realm is sent unless OAuth1Params.Realm is given.
+	Realm func(info *ProviderInfo) string
+
+	// DocsURL has more information about where to retrieve the consumer and token credentials.
+	DocsURL string
+}
+
+// oauth1Catalog holds OAuth 1.0a options by provider.
+var oauth1Catalog = make(map[Provider]Oauth1Opts) // nolint:gochecknoglobals
+
+// SetOAuth1Info registers OAuth 1.0a options of the provider.
+func SetOAuth1Info(provider Provider, opts Oauth1Opts) {
+	oauth1Catalog[provider] = opts
+}
+
+// Oauth1Options returns OAuth 1.0a options of the provider, nil if signed requests are not supported.
+func (i *ProviderInfo) Oauth1Options() *Oauth1Opts {
+	opts, ok := oauth1Catalog[i.Name]
+	if !ok {
+		return nil
+	}
+
+	return &opts
+}
+
+// oauth1Realm returns the realm given with credentials, otherwise the one derived by the provider.
+func (i *ProviderInfo) oauth1Realm(opts *Oauth1Opts, cfg *OAuth1Params) string {
+	if cfg.Realm != "" || opts.Realm == nil {
+		return cfg.Realm
+	}
+
+	return opts.Realm(i)
+}
+
+// netsuiteTokenBasedAuthRealm reads the account ID from the base URL (ex: "1234567-sb1")
+// and converts it to the realm expected by token-based authentication (ex: "1234567_SB1").
+func netsuiteTokenBasedAuthRealm(info *ProviderInfo) string {
+	baseURL, err := url.Parse(info.BaseURL)
+	if err != nil {
+		return ""
+	}
+
+	accountID, _, _ := strings.Cut(baseURL.Hostname(), ".")
+
+	return strings.ToUpper(strings.ReplaceAll(accountID, "-", "_"))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/common/naming"
 	"github.com/amp-labs/connectors/common/urlbuilder"
 	"github.com/amp-labs/connectors/internal/simultaneously"
@@ -102,6 +103,8 @@ func (c *Connector) Subscribe(
 		ObjectEvents: params.SubscriptionEvents,
 	}
 
+	ctx = governor.WithContext(ctx, c.Client.HTTPClient.Governor())
+
 	err = simultaneously.DoCtx(ctx, -1, callbacks...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to process subscriptions: %w", err)
@@ -487,6 +490,8 @@ func (c *Connector) rollbackSubscriptions(
 		)
 	}
 
+	ctx = governor.WithContext(ctx, c.Client.HTTPClient.Governor())
+
 	err = simultaneously.DoCtx(ctx, -1, callbacks...)
 	if err != nil {
 		rollbackErrors = errors.Join(rollbackErrors, fmt.Errorf("failed to rollback subscriptions: %w", err))
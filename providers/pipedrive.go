@@ -21,6 +21,7 @@ func init() { //nolint: funlen
 			ExplicitScopesRequired:    false,
 			ExplicitWorkspaceRequired: false,
 		},
+		PostAuthInfoNeeded: true,
 		Support: Support{
 			BulkWrite: BulkWriteSupport{
 				Insert: false,
@@ -76,5 +77,12 @@ func init() { //nolint: funlen
 				LogoURL: "https://res.cloudinary.com/dycvts6vp/image/upload/v1722469899/media/pipedrive_1722469898.svg",
 			},
 		},
+		Metadata: &ProviderMetadata{
+			PostAuthentication: []MetadataItemPostAuthentication{
+				{
+					Name: "companyDomain",
+				},
+			},
+		},
 	})
 }
@@ -0,0 +1,60 @@
+package pipedrive
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// lookupFields are fields referencing other objects, named after the associated object.
+// https://developers.pipedrive.com/docs/api/v1/Deals#addDeal
+var lookupFields = map[string]string{ // nolint:gochecknoglobals
+	"organizations": "org_id",
+	"persons":       "person_id",
+	"deals":         "deal_id",
+	"leads":         "lead_id",
+	"projects":      "project_id",
+}
+
+// applyAssociations moves associations into lookup fields of the record.
+// Pipedrive has no separate API to link records, e.g. a person is linked to an organization by "org_id".
+func applyAssociations(params common.WriteParams) (common.WriteParams, error) {
+	if len(params.Associations) == 0 {
+		return params, nil
+	}
+
+	record, err := params.GetRecord()
+	if err != nil {
+		return params, err
+	}
+
+	record, err = common.ApplyLookupAssociations(record, params.Associations, resolveLookupField)
+	if err != nil {
+		return params, err
+	}
+
+	params.RecordData = record
+	params.Associations = nil
+
+	return params, nil
+}
+
+func resolveLookupField(association common.AssociationInput) (string, any, error) {
+	field := association.AssociationType
+	if field == "" {
+		field = lookupFields[association.TargetObject]
+	}
+
+	if field == "" {
+		return "", nil, fmt.Errorf("%w: %s cannot be associated via lookup field",
+			common.ErrUnsupportedAssociation, association.TargetObject)
+	}
+
+	// Identifiers are numeric, except for leads.
+	if identifier, err := strconv.Atoi(association.TargetId); err == nil {
+		return field, identifier, nil
+	}
+
+	return field, association.TargetId, nil
+}
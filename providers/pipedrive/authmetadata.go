@@ -0,0 +1,65 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/logging"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+)
+
+var (
+	ErrDiscoveryFailure     = errors.New("failed to collect post authentication data")
+	ErrMissingCompanyDomain = errors.New("company domain is missing from the user profile")
+)
+
+// GetPostAuthInfo finds the company account of the authorized user.
+// The company domain identifies the workspace, OAuth apps are not told which company was chosen.
+func (c *Connector) GetPostAuthInfo(ctx context.Context) (*common.PostAuthInfo, error) {
+	companyDomain, rsp, err := c.retrieveCompanyDomain(ctx)
+	if err != nil {
+		return nil, errors.Join(ErrDiscoveryFailure, err)
+	}
+
+	return &common.PostAuthInfo{
+		CatalogVars: AuthMetadataVars{
+			CompanyDomain: companyDomain,
+		}.AsMap(),
+		RawResponse:          rsp,
+		ProviderWorkspaceRef: companyDomain,
+	}, nil
+}
+
+type currentUserResponse struct {
+	Data struct {
+		CompanyDomain string `json:"company_domain"`
+	} `json:"data"`
+}
+
+// retrieveCompanyDomain reads the profile of the authorized user.
+// https://developers.pipedrive.com/docs/api/v1/Users#getCurrentUser
+func (c *Connector) retrieveCompanyDomain(ctx context.Context) (string, *common.JSONHTTPResponse, error) {
+	ctx = logging.With(ctx, "connector", "pipedrive")
+
+	url, err := urlbuilder.New(c.providerInfo.BaseURL, "v1/users/me")
+	if err != nil {
+		return "", nil, err
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return "", nil, err
+	}
+
+	user, err := common.UnmarshalJSON[currentUserResponse](rsp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if user == nil || user.Data.CompanyDomain == "" {
+		return "", nil, ErrMissingCompanyDomain
+	}
+
+	return user.Data.CompanyDomain, rsp, nil
+}
@@ -0,0 +1,82 @@
+package pipedrive
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+)
+
+func TestGetPostAuthInfo(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		server       mockserver.Switch
+		expected     string
+		expectedErrs []error
+	}{
+		{
+			name: "Company domain of the user",
+			server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.Path("/v1/users/me"),
+					Then: mockserver.ResponseString(http.StatusOK,
+						`{"success":true,"data":{"id":1,"company_id":7,"company_domain":"acme"}}`),
+				}},
+			},
+			expected: "acme",
+		},
+		{
+			name: "Profile without company",
+			server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.Path("/v1/users/me"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"success":true,"data":{"id":1}}`),
+				}},
+			},
+			expectedErrs: []error{ErrDiscoveryFailure, ErrMissingCompanyDomain},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tt.server.Server()
+			defer server.Close()
+
+			connector, err := constructTestConnector(server.URL, providers.ModulePipedriveCRM)
+			if err != nil {
+				t.Fatalf("%s: failed to setup connector: %v", tt.name, err)
+			}
+
+			output, err := connector.GetPostAuthInfo(t.Context())
+
+			for _, expectedErr := range tt.expectedErrs {
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("%s: expected error (%v), got (%v)", tt.name, expectedErr, err)
+				}
+			}
+
+			if len(tt.expectedErrs) != 0 {
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s: expected no errors, got: (%v)", tt.name, err)
+			}
+
+			expected := AuthMetadataVars{CompanyDomain: tt.expected}.AsMap()
+			if !reflect.DeepEqual(output.CatalogVars, expected) || output.ProviderWorkspaceRef != tt.expected {
+				t.Fatalf("%s: unexpected output (%v, %q)", tt.name, *output.CatalogVars, output.ProviderWorkspaceRef)
+			}
+		})
+	}
+}
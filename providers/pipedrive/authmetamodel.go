@@ -0,0 +1,24 @@
+package pipedrive
+
+const companyDomainKey = "companyDomain"
+
+// AuthMetadataVars is a complete list of authentication metadata associated with connector.
+// This model serves as a documentation of map[string]string contents.
+type AuthMetadataVars struct {
+	// CompanyDomain is the subdomain of the company account, ex: "acme" for acme.pipedrive.com.
+	CompanyDomain string
+}
+
+// NewAuthMetadataVars parses map into the model.
+func NewAuthMetadataVars(dictionary map[string]string) *AuthMetadataVars {
+	return &AuthMetadataVars{
+		CompanyDomain: dictionary[companyDomainKey],
+	}
+}
+
+// AsMap converts model back to the map.
+func (v AuthMetadataVars) AsMap() *map[string]string {
+	return &map[string]string{
+		companyDomainKey: v.CompanyDomain,
+	}
+}
@@ -79,6 +79,15 @@ func (c *Connector) Read(ctx context.Context, params common.ReadParams) (*common
 }
 
 func (c *Connector) Write(ctx context.Context, params common.WriteParams) (*common.WriteResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	params, err := applyAssociations(params)
+	if err != nil {
+		return nil, err
+	}
+
 	if c.crmAdapter != nil {
 		return c.crmAdapter.Write(ctx, params)
 	}
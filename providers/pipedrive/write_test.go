@@ -131,6 +131,55 @@ func TestWrite(t *testing.T) { // nolint:funlen,gocognit,cyclop
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Associations are written as lookup fields",
+			Input: common.WriteParams{
+				ObjectName: "activities",
+				RecordId:   "1",
+				RecordData: map[string]any{
+					"done": "1",
+				},
+				Associations: []common.AssociationInput{{
+					TargetObject: "organizations",
+					TargetId:     "13313052",
+				}, {
+					TargetObject: "persons",
+					TargetId:     "7",
+					Operation:    common.AssociationOperationDissociate,
+				}},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPUT(),
+					mockcond.Path("/v1/activities/1"),
+					mockcond.Body(`{"done":"1","org_id":13313052,"person_id":null}`),
+				},
+				Then: mockserver.Response(http.StatusOK, updateActivityResponse),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "1",
+				Data: map[string]any{
+					"id": float64(1),
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Unknown lookup field",
+			Input: common.WriteParams{
+				ObjectName: "activities",
+				RecordData: map[string]any{"subject": "Call"},
+				Associations: []common.AssociationInput{{
+					TargetObject: "products",
+					TargetId:     "5",
+				}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedAssociation},
+		},
 	}
 
 	for _, tt := range tests {
@@ -64,3 +64,7 @@ func constructor(base *components.Connector) (*Connector, error) {
 
 	return connector, nil
 }
+
+func (c *Connector) IdempotencyMode() common.IdempotencyMode {
+	return common.IdempotencyModeNative
+}
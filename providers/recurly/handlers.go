@@ -11,6 +11,10 @@ import (
 	"github.com/amp-labs/connectors/internal/jsonquery"
 )
 
+// Recurly replays the original response for POST requests repeating the key within 24 hours.
+// https://recurly.com/developers/api/v2021-02-25/index.html#section/Getting-Started/Idempotency
+const idempotencyKeyHeader = "Idempotency-Key"
+
 var (
 	ApiVersionHeader = "application/vnd.recurly.v2021-02-25+json" //nolint:gochecknoglobals
 	limit            = "200"                                      //nolint:gochecknoglobals
@@ -87,6 +91,10 @@ func (c *Connector) buildWriteRequest(ctx context.Context, params common.WritePa
 	req.Header.Set("Accept", ApiVersionHeader)
 	req.Header.Set("Content-Type", "application/json")
 
+	for _, header := range common.IdempotencyHeader(idempotencyKeyHeader, params.IdempotencyKey) {
+		header.ApplyToRequest(req)
+	}
+
 	return req, nil
 }
 
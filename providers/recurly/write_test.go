@@ -74,6 +74,33 @@ func TestWrite(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Idempotency key is forwarded",
+			Input: common.WriteParams{
+				ObjectName:     "subscriptions",
+				RecordData:     map[string]any{"plan_code": "basic_plan"},
+				IdempotencyKey: "4a8e2b6c-retry-safe",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/subscriptions"),
+					mockcond.Header(http.Header{"Idempotency-Key": []string{"4a8e2b6c-retry-safe"}}),
+				},
+				Then: mockserver.Response(http.StatusCreated, responseCreateSubscription),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetWrite,
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "ierqn34o3hoife",
+				Data: map[string]any{
+					"id":        "ierqn34o3hoife",
+					"plan_code": "basic_plan",
+				},
+			},
+			ExpectedErrs: nil,
+		},
 		{
 			Name: "Successfully Update subscription",
 			Input: common.WriteParams{
@@ -61,6 +61,16 @@ func init() { // nolint:funlen
 							ObjectRecordLimits: nil,
 							Supported:          true,
 						},
+						Upsert: BatchWriteSupportConfig{
+							DefaultRecordLimit: goutils.Pointer(200), // nolint:mnd
+							ObjectRecordLimits: nil,
+							Supported:          true,
+						},
+						Delete: BatchWriteSupportConfig{
+							DefaultRecordLimit: goutils.Pointer(200), // nolint:mnd
+							ObjectRecordLimits: nil,
+							Supported:          true,
+						},
 					},
 					BulkWrite: BulkWriteSupport{
 						Insert: false,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/goutils"
 	"github.com/amp-labs/connectors/test/utils/mockutils"
 	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
 	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
@@ -362,3 +363,161 @@ func TestBatchUpdate(t *testing.T) { // nolint:funlen,gocognit,cyclop,maintidx
 		})
 	}
 }
+
+func TestBatchUpsert(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	upsertPayload := testutils.DataFromFile(t, "batch/upsert/contacts/payload.json")
+	responseUpsertPartial := testutils.DataFromFile(t, "batch/upsert/contacts/partial.json")
+
+	type record = common.Record
+
+	upsertRecords := common.BatchItems{{
+		Record: record{
+			"Email":     "siena.dyer@example.com",
+			"FirstName": "Siena",
+		},
+	}, {
+		Record: record{
+			"Email":     "markus.blevins@example.com",
+			"FirstName": "Markus",
+		},
+	}}
+
+	tests := []testroutines.BatchWrite{
+		{
+			Name: "External id field is required",
+			Input: &common.BatchWriteParam{
+				ObjectName: "Contact",
+				Type:       common.BatchWriteTypeUpsert,
+				Batch:      upsertRecords,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingExternalIdField},
+		},
+		{
+			Name: "Partial upsert matched by external id",
+			Input: &common.BatchWriteParam{
+				ObjectName:      "Contact",
+				Type:            common.BatchWriteTypeUpsert,
+				ExternalIdField: "Email",
+				Batch:           upsertRecords,
+				AllOrNone:       goutils.Pointer(false),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPATCH(),
+					mockcond.Path("/services/data/v60.0/composite/sobjects/Contact/Email"),
+					mockcond.BodyBytes(upsertPayload),
+				},
+				Then: mockserver.Response(http.StatusOK, responseUpsertPartial),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetBatchWrite,
+			Expected: &common.BatchWriteResult{
+				Status: common.BatchStatusPartial,
+				Errors: []any{},
+				Results: []common.WriteResult{{
+					Success:  true,
+					RecordId: "003ak00000jvIfpAAE",
+					Errors:   nil,
+					Data:     nil,
+				}, {
+					Success:  false,
+					RecordId: "",
+					Errors: []any{mockutils.JSONErrorWrapper(`{
+						"statusCode": "DUPLICATE_EXTERNAL_ID",
+						"message": "Email: more than one record found for external id field: [003ak00000jvIfqAAE, 003ak00000jvIfrAAE]",
+						"fields": []
+					}`)},
+					Data: nil,
+				}},
+				SuccessCount: 1,
+				FailureCount: 1,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.BatchWriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestBatchDelete(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responseDeletePartial := testutils.DataFromFile(t, "batch/delete/contacts/partial.json")
+
+	tests := []testroutines.BatchWrite{
+		{
+			Name: "Record identifiers are required",
+			Input: &common.BatchWriteParam{
+				ObjectName: "Contact",
+				Type:       common.BatchWriteTypeDelete,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingRecordID},
+		},
+		{
+			Name: "Partial delete reports identifiers of failed records",
+			Input: &common.BatchWriteParam{
+				ObjectName: "Contact",
+				Type:       common.BatchWriteTypeDelete,
+				RecordIds:  []string{"003ak00000jvIfpAAE", "003ak00000jvIfqAAE"},
+				AllOrNone:  goutils.Pointer(false),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodDELETE(),
+					mockcond.Path("/services/data/v60.0/composite/sobjects"),
+					mockcond.QueryParam("ids", "003ak00000jvIfpAAE,003ak00000jvIfqAAE"),
+					mockcond.QueryParam("allOrNone", "false"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseDeletePartial),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetBatchWrite,
+			Expected: &common.BatchWriteResult{
+				Status: common.BatchStatusPartial,
+				Errors: []any{},
+				Results: []common.WriteResult{{
+					Success:  true,
+					RecordId: "003ak00000jvIfpAAE",
+					Errors:   nil,
+					Data:     nil,
+				}, {
+					Success:  false,
+					RecordId: "003ak00000jvIfqAAE",
+					Errors: []any{mockutils.JSONErrorWrapper(`{
+						"statusCode": "ENTITY_IS_DELETED",
+						"message": "entity is deleted",
+						"fields": []
+					}`)},
+					Data: nil,
+				}},
+				SuccessCount: 1,
+				FailureCount: 1,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.BatchWriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
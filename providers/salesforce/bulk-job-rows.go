@@ -0,0 +1,207 @@
+package salesforce
+
+import (
+	"encoding/csv"
+	"errors"
+	"hash/fnv"
+	"io"
+	"iter"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// bulkJobRows is the submitted data of a bulk job in the form uploaded to Salesforce.
+// Rows are produced one at a time, the data is never held in memory as a whole.
+type bulkJobRows struct {
+	header []string
+	// empty tells that there are no rows after the header.
+	empty bool
+	// each passes rows to the function in the order of submission.
+	each func(yield func(row []string) error) error
+}
+
+// newBulkJobRows reads either the records or the CSV, Salesforce CSV is copied line by line.
+func newBulkJobRows(records iter.Seq[common.Record], csvData io.Reader) (*bulkJobRows, error) {
+	if csvData != nil {
+		return newBulkJobCSVRows(csvData)
+	}
+
+	return newBulkJobRecordRows(records), nil
+}
+
+// newBulkJobCSVRows reads the header and looks ahead at the first row.
+func newBulkJobCSVRows(reader io.Reader) (*bulkJobRows, error) {
+	lines := csv.NewReader(reader)
+
+	header, err := lines.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, common.ErrMissingCSVData
+		}
+
+		return nil, errors.Join(ErrReadToByteFailed, err)
+	}
+
+	first, err := lines.Read()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Join(ErrReadToByteFailed, err)
+	}
+
+	return &bulkJobRows{
+		header: header,
+		empty:  first == nil,
+		each: func(yield func(row []string) error) error {
+			for row := first; row != nil; {
+				if err := yield(row); err != nil {
+					return err
+				}
+
+				row, err = lines.Read()
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+
+					return errors.Join(ErrReadToByteFailed, err)
+				}
+			}
+
+			return nil
+		},
+	}, nil
+}
+
+// newBulkJobRecordRows converts records into CSV rows, columns are ordered by name.
+// Records are iterated twice, first to collect the columns, then to produce the rows.
+func newBulkJobRecordRows(records iter.Seq[common.Record]) *bulkJobRows {
+	columns := make(map[string]bool)
+	empty := true
+
+	for record := range records {
+		empty = false
+
+		for field := range record {
+			columns[field] = true
+		}
+	}
+
+	header := slices.Sorted(maps.Keys(columns))
+
+	return &bulkJobRows{
+		header: header,
+		empty:  empty,
+		each: func(yield func(row []string) error) error {
+			row := make([]string, len(header))
+
+			for record := range records {
+				for position, field := range header {
+					row[position] = ""
+
+					if value, present := record[field]; present {
+						row[position] = formatCSVValue(value)
+					}
+				}
+
+				if err := yield(row); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// writeTo encodes the header and rows as CSV.
+func (r *bulkJobRows) writeTo(writer io.Writer) error {
+	data := csv.NewWriter(writer)
+
+	if err := data.Write(r.header); err != nil {
+		return err
+	}
+
+	if err := r.each(data.Write); err != nil {
+		return err
+	}
+
+	data.Flush()
+
+	return data.Error()
+}
+
+// bulkRowMatcher traces result rows back to the submitted rows by their values.
+// Identical rows are given indices in the order of submission, every index is returned at most once.
+type bulkRowMatcher struct {
+	columns []string
+	rows    map[string][]int
+}
+
+// newBulkRowMatcher reads the submitted data once, without it no row is matched.
+func newBulkRowMatcher(params common.BulkJobResultsParams) (*bulkRowMatcher, error) {
+	if params.Records == nil && params.CSVData == nil {
+		return &bulkRowMatcher{}, nil
+	}
+
+	submitted, err := newBulkJobRows(params.Records, params.CSVData)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := &bulkRowMatcher{
+		columns: submitted.header,
+		rows:    make(map[string][]int),
+	}
+
+	index := 0
+
+	err = submitted.each(func(row []string) error {
+		digest := rowDigest(row)
+		matcher.rows[digest] = append(matcher.rows[digest], index)
+		index++
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matcher, nil
+}
+
+// match returns the index of the submitted row with the same values.
+func (m *bulkRowMatcher) match(values map[string]string) int {
+	if m.rows == nil {
+		return common.BulkJobRowIndexUnknown
+	}
+
+	row := make([]string, len(m.columns))
+	for position, column := range m.columns {
+		row[position] = values[column]
+	}
+
+	digest := rowDigest(row)
+
+	candidates := m.rows[digest]
+	if len(candidates) == 0 {
+		return common.BulkJobRowIndexUnknown
+	}
+
+	m.rows[digest] = candidates[1:]
+
+	return candidates[0]
+}
+
+func rowDigest(row []string) string {
+	hash := fnv.New64a()
+
+	for _, value := range row {
+		// Writes to the hash never fail.
+		_, _ = hash.Write([]byte(value))
+		_, _ = hash.Write([]byte{0})
+	}
+
+	return strconv.FormatUint(hash.Sum64(), 16)
+}
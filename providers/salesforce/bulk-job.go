@@ -0,0 +1,327 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+var _ connectors.BulkJobConnector = &Connector{}
+
+// sfCreatedFieldName is present in successful results, tells if the record was inserted.
+const sfCreatedFieldName = "sf__Created"
+
+// Salesforce CSV treats empty value as "leave unchanged", "#N/A" is used to clear the field.
+// https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/datafiles_csv_valid_record_rows.htm
+const csvNullValue = "#N/A"
+
+var bulkJobOperations = map[common.BulkOperation]BulkOperationMode{ // nolint:gochecknoglobals
+	common.BulkOperationInsert: "insert",
+	common.BulkOperationUpdate: "update",
+	common.BulkOperationUpsert: UpsertMode,
+	common.BulkOperationDelete: DeleteMode,
+}
+
+var bulkJobStates = map[string]common.BulkJobState{ // nolint:gochecknoglobals
+	"Open":                 common.BulkJobStatePending,
+	JobStateUploadComplete: common.BulkJobStatePending,
+	JobStateInProgress:     common.BulkJobStateInProgress,
+	JobStateComplete:       common.BulkJobStateComplete,
+	JobStateFailed:         common.BulkJobStateFailed,
+	JobStateAborted:        common.BulkJobStateAborted,
+}
+
+// SubmitBulkJob implements connectors.BulkJobConnector using Bulk API 2.0 ingest jobs.
+// Records are converted into CSV, columns are ordered by name.
+// Rows are encoded while they are uploaded, the CSV is never held in memory as a whole.
+//
+// Salesforce doesn't keep the order of rows in the job results,
+// see GetBulkJobResults for how results are traced back to rows.
+// https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/create_job.htm
+func (c *Connector) SubmitBulkJob(ctx context.Context, params common.BulkJobParams) (*common.BulkJob, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	rows, err := newBulkJobRows(params.Records, params.CSVData)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows.empty {
+		return nil, common.ErrMissingBulkData
+	}
+
+	body := map[string]any{
+		"object":      params.ObjectName,
+		"operation":   bulkJobOperations[params.Operation],
+		"contentType": "CSV",
+		"lineEnding":  "LF",
+	}
+
+	if params.Operation == common.BulkOperationUpsert {
+		body["externalIdFieldName"] = params.ExternalIdField
+	}
+
+	upload, encoder := io.Pipe()
+	// Stops the encoder if the upload ends early, ex: the job could not be created.
+	defer upload.Close()
+
+	go func() {
+		encoder.CloseWithError(rows.writeTo(encoder))
+	}()
+
+	result, err := c.bulkOperation(ctx, BulkOperationParams{
+		ObjectName: params.ObjectName,
+		CSVData:    upload,
+	}, body)
+	if err != nil {
+		return nil, fmt.Errorf("bulk job submission failed: %w", err)
+	}
+
+	return &common.BulkJob{
+		ID:         result.JobId,
+		ObjectName: params.ObjectName,
+		Operation:  params.Operation,
+		State:      convertBulkJobState(result.State),
+	}, nil
+}
+
+// GetBulkJob implements connectors.BulkJobConnector.
+func (c *Connector) GetBulkJob(ctx context.Context, jobID string) (*common.BulkJob, error) {
+	info, err := c.GetJobInfo(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBulkJob(info), nil
+}
+
+// ListBulkJobs implements connectors.BulkJobConnector.
+// Salesforce retains jobs in a terminal state for 7 days.
+func (c *Connector) ListBulkJobs(ctx context.Context) ([]common.BulkJob, error) {
+	infos, err := c.ListIngestJobsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]common.BulkJob, len(infos))
+	for index := range infos {
+		jobs[index] = *newBulkJob(&infos[index])
+	}
+
+	return jobs, nil
+}
+
+// AbortBulkJob implements connectors.BulkJobConnector.
+// https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/close_job.htm
+func (c *Connector) AbortBulkJob(ctx context.Context, jobID string) (*common.BulkJob, error) {
+	location, err := c.getRestApiURL("jobs/ingest", jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Patch(ctx, location.String(), map[string]any{
+		"state": JobStateAborted,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to abort bulk job '%s': %w", jobID, errors.Join(ErrUpdateJob, err))
+	}
+
+	info, err := common.UnmarshalJSON[GetJobInfoResult](rsp)
+	if err != nil {
+		return nil, errors.Join(common.ErrParseError, err)
+	}
+
+	return newBulkJob(info), nil
+}
+
+// GetBulkJobResults implements connectors.BulkJobConnector.
+// Successful rows are followed by failed rows, then by rows which were never processed,
+// ex: because the job was aborted. Every result CSV is read in a single pass.
+//
+// Rows have BulkJobRowIndexUnknown index, unless the submitted data is given with the parameters.
+// https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/get_job_successful_results.htm
+// https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/get_job_failed_results.htm
+// https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/get_job_unprocessed_results.htm
+func (c *Connector) GetBulkJobResults(
+	ctx context.Context, params common.BulkJobResultsParams, handler common.BulkJobResultHandler,
+) error {
+	if err := params.ValidateParams(); err != nil {
+		return err
+	}
+
+	jobID := params.JobID
+
+	info, err := c.GetJobInfo(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	if !convertBulkJobState(info.State).IsTerminal() {
+		return fmt.Errorf("%w: job '%s' is in state %s", common.ErrBulkJobNotFinished, jobID, info.State)
+	}
+
+	matcher, err := newBulkRowMatcher(params)
+	if err != nil {
+		return err
+	}
+
+	results := []struct {
+		fetch   func(ctx context.Context, jobID string) (*http.Response, error)
+		outcome bulkRowOutcome
+	}{
+		{fetch: c.GetSuccessfulJobResults, outcome: bulkRowSucceeded},
+		{fetch: c.getFailedJobResults, outcome: bulkRowFailed},
+		{fetch: c.getUnprocessedJobRecords, outcome: bulkRowUnprocessed},
+	}
+
+	for _, result := range results {
+		rsp, err := result.fetch(ctx, jobID)
+		if err != nil {
+			return err
+		}
+
+		if err = streamResultRows(rsp, matcher, result.outcome, handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newBulkJob(info *GetJobInfoResult) *common.BulkJob {
+	operation := common.BulkOperation(info.Operation)
+
+	for neutral, mode := range bulkJobOperations {
+		if mode == info.Operation {
+			operation = neutral
+		}
+	}
+
+	return &common.BulkJob{
+		ID:               info.Id,
+		ObjectName:       info.Object,
+		Operation:        operation,
+		State:            convertBulkJobState(info.State),
+		RecordsProcessed: int(info.NumberRecordsProcessed),
+		RecordsFailed:    int(info.NumberRecordsFailed),
+		ErrorMessage:     info.ErrorMessage,
+	}
+}
+
+func convertBulkJobState(state string) common.BulkJobState {
+	if converted, ok := bulkJobStates[state]; ok {
+		return converted
+	}
+
+	return common.BulkJobState(state)
+}
+
+func formatCSVValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return csvNullValue
+	case string:
+		return value
+	case bool:
+		return strconv.FormatBool(value)
+	case time.Time:
+		return value.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// bulkRowOutcome tells which result CSV the row comes from.
+type bulkRowOutcome int
+
+const (
+	bulkRowSucceeded bulkRowOutcome = iota
+	bulkRowFailed
+	bulkRowUnprocessed
+)
+
+// streamResultRows reads result CSV and passes every row to the handler.
+func streamResultRows(
+	rsp *http.Response, matcher *bulkRowMatcher, outcome bulkRowOutcome, handler common.BulkJobResultHandler,
+) error {
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: job results returned status %d", common.ErrRequestFailed, rsp.StatusCode)
+	}
+
+	reader := csv.NewReader(rsp.Body)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			// No rows.
+			return nil
+		}
+
+		return errors.Join(common.ErrParseError, err)
+	}
+
+	for {
+		line, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return errors.Join(common.ErrParseError, err)
+		}
+
+		if err = handler(newBulkJobResultRow(header, line, matcher, outcome)); err != nil {
+			return err
+		}
+	}
+}
+
+func newBulkJobResultRow(
+	header, line []string, matcher *bulkRowMatcher, outcome bulkRowOutcome,
+) common.BulkJobResultRow {
+	row := common.BulkJobResultRow{
+		Success: outcome == bulkRowSucceeded,
+		Fields:  make(map[string]any),
+	}
+
+	if outcome == bulkRowUnprocessed {
+		row.Errors = []any{common.ErrBatchUnprocessedRecord}
+	}
+
+	values := make(map[string]string)
+
+	for position, column := range header {
+		if position >= len(line) {
+			break
+		}
+
+		switch column {
+		case sfIdFieldName:
+			row.RecordId = line[position]
+		case sfErrorFieldName:
+			row.Errors = []any{line[position]}
+		case sfCreatedFieldName:
+			// Not part of submitted data.
+		default:
+			values[column] = line[position]
+			row.Fields[column] = line[position]
+		}
+	}
+
+	row.Index = matcher.match(values)
+
+	return row
+}
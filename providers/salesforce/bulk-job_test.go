@@ -0,0 +1,265 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"gotest.tools/v3/assert"
+)
+
+func TestBulkJob(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseCreateJob := testutils.DataFromFile(t, "bulk/write/launch-job-opportunity.json")
+	responseUpdateJob := testutils.DataFromFile(t, "bulk/write/update-job-opportunity.json")
+	responseJobInfo := testutils.DataFromFile(t, "bulk/info/success.json")
+
+	// Results come in no particular order, and only echo submitted columns.
+	successfulResults := "\"sf__Id\",\"sf__Created\",Name,external_id__c\n" +
+		"\"006ak000004GkB2AAK\",\"true\",\"Globex\",\"ext-2\"\n" +
+		"\"006ak000004GkB1AAK\",\"false\",\"Acme\",\"ext-1\"\n"
+	failedResults := "\"sf__Id\",\"sf__Error\",Name,external_id__c\n" +
+		"\"\",\"REQUIRED_FIELD_MISSING:Required fields are missing: [Name]:Name --\",\"#N/A\",\"ext-3\"\n"
+	unprocessedRecords := "Name,external_id__c\n" +
+		"Initech,ext-4\n"
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: []mockserver.Case{{
+			If: mockcond.And{
+				mockcond.MethodPOST(),
+				mockcond.Path("/services/data/v60.0/jobs/ingest"),
+				mockcond.Body(`{
+					"contentType":"CSV",
+					"externalIdFieldName":"external_id__c",
+					"lineEnding":"LF",
+					"object":"Opportunity",
+					"operation":"upsert"
+				}`),
+			},
+			Then: mockserver.Response(http.StatusOK, responseCreateJob),
+		}, {
+			If: mockcond.And{
+				mockcond.MethodPUT(),
+				mockcond.Path("/services/data/v60.0/jobs/ingest/750ak000009BWKLAA4/batches"),
+				mockcond.Body("Name,external_id__c\nAcme,ext-1\nGlobex,ext-2\n#N/A,ext-3\nInitech,ext-4\n"),
+			},
+			Then: mockserver.Response(http.StatusCreated, []byte{}),
+		}, {
+			If: mockcond.And{
+				mockcond.MethodPATCH(),
+				mockcond.Path("/services/data/v60.0/jobs/ingest/750ak000009BWKLAA4"),
+				mockcond.Body(`{"state":"UploadComplete"}`),
+			},
+			Then: mockserver.Response(http.StatusOK, responseUpdateJob),
+		}, {
+			If: mockcond.And{
+				mockcond.MethodGET(),
+				mockcond.Path("/services/data/v60.0/jobs/ingest/750ak000009BWKLAA4"),
+			},
+			Then: mockserver.Response(http.StatusOK, responseJobInfo),
+		}, {
+			If:   mockcond.Path("/services/data/v60.0/jobs/ingest/750ak000009BWKLAA4/successfulResults"),
+			Then: mockserver.ResponseString(http.StatusOK, successfulResults),
+		}, {
+			If:   mockcond.Path("/services/data/v60.0/jobs/ingest/750ak000009BWKLAA4/failedResults"),
+			Then: mockserver.ResponseString(http.StatusOK, failedResults),
+		}, {
+			If:   mockcond.Path("/services/data/v60.0/jobs/ingest/750ak000009BWKLAA4/unprocessedrecords"),
+			Then: mockserver.ResponseString(http.StatusOK, unprocessedRecords),
+		}},
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	ctx := context.Background()
+
+	records := slices.Values([]common.Record{
+		{"Name": "Acme", "external_id__c": "ext-1"},
+		{"Name": "Globex", "external_id__c": "ext-2"},
+		{"Name": nil, "external_id__c": "ext-3"},
+		{"Name": "Initech", "external_id__c": "ext-4"},
+	})
+
+	job, err := connector.SubmitBulkJob(ctx, common.BulkJobParams{
+		ObjectName:      "Opportunity",
+		Operation:       common.BulkOperationUpsert,
+		ExternalIdField: "external_id__c",
+		Records:         records,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, job.ID, "750ak000009BWKLAA4")
+	assert.Equal(t, job.State, common.BulkJobStatePending)
+
+	// Results can be matched by another connector instance, given the same submitted data.
+	connector, err = constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	job, err = connector.GetBulkJob(ctx, job.ID)
+	assert.NilError(t, err)
+	assert.Equal(t, job.State, common.BulkJobStateComplete)
+	assert.Equal(t, job.RecordsProcessed, 3000)
+
+	var rows []common.BulkJobResultRow
+
+	params := common.BulkJobResultsParams{JobID: job.ID, Records: records}
+
+	err = connector.GetBulkJobResults(ctx, params, func(row common.BulkJobResultRow) error {
+		rows = append(rows, row)
+
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(rows), 4)
+	assert.DeepEqual(t, rows[:3], []common.BulkJobResultRow{{
+		Index:    1,
+		Success:  true,
+		RecordId: "006ak000004GkB2AAK",
+		Fields:   map[string]any{"Name": "Globex", "external_id__c": "ext-2"},
+	}, {
+		Index:    0,
+		Success:  true,
+		RecordId: "006ak000004GkB1AAK",
+		Fields:   map[string]any{"Name": "Acme", "external_id__c": "ext-1"},
+	}, {
+		Index:    2,
+		Success:  false,
+		RecordId: "",
+		Fields:   map[string]any{"Name": "#N/A", "external_id__c": "ext-3"},
+		Errors:   []any{"REQUIRED_FIELD_MISSING:Required fields are missing: [Name]:Name --"},
+	}})
+
+	// Rows which were never processed come last.
+	unprocessed := rows[len(rows)-1]
+	assert.Equal(t, unprocessed.Index, 3)
+	assert.Equal(t, unprocessed.Success, false)
+	assert.DeepEqual(t, unprocessed.Fields, map[string]any{"Name": "Initech", "external_id__c": "ext-4"})
+	assert.Equal(t, len(unprocessed.Errors), 1)
+	assert.Equal(t, unprocessed.Errors[0], common.ErrBatchUnprocessedRecord)
+
+	// Rows cannot be matched without the submitted data.
+	err = connector.GetBulkJobResults(ctx, common.BulkJobResultsParams{JobID: job.ID},
+		func(row common.BulkJobResultRow) error {
+			assert.Equal(t, row.Index, common.BulkJobRowIndexUnknown)
+
+			return nil
+		})
+	assert.NilError(t, err)
+
+	// Handler error stops iteration.
+	errStop := errors.New("stop")
+	err = connector.GetBulkJobResults(ctx, params, func(row common.BulkJobResultRow) error {
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+}
+
+func TestBulkJobResultsNotFinished(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If:    mockcond.Path("/services/data/v60.0/jobs/ingest/750ak000009BWKLAA4"),
+		Then:  mockserver.Response(http.StatusOK, testutils.DataFromFile(t, "bulk/info/in-progress.json")),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	err = connector.GetBulkJobResults(context.Background(),
+		common.BulkJobResultsParams{JobID: "750ak000009BWKLAA4"},
+		func(row common.BulkJobResultRow) error {
+			return nil
+		})
+	assert.ErrorIs(t, err, common.ErrBulkJobNotFinished)
+}
+
+func TestAbortBulkJob(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodPATCH(),
+			mockcond.Path("/services/data/v60.0/jobs/ingest/750ak000009BWKLAA4"),
+			mockcond.Body(`{"state":"Aborted"}`),
+		},
+		Then: mockserver.ResponseString(http.StatusOK, `{
+			"id":"750ak000009BWKLAA4",
+			"operation":"delete",
+			"object":"Opportunity",
+			"state":"Aborted"
+		}`),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	assert.NilError(t, err)
+
+	job, err := connector.AbortBulkJob(context.Background(), "750ak000009BWKLAA4")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, job, &common.BulkJob{
+		ID:         "750ak000009BWKLAA4",
+		ObjectName: "Opportunity",
+		Operation:  common.BulkOperationDelete,
+		State:      common.BulkJobStateAborted,
+	})
+}
+
+func TestSubmitBulkJobValidation(t *testing.T) {
+	t.Parallel()
+
+	connector, err := constructTestConnector(mockserver.Dummy().URL)
+	assert.NilError(t, err)
+
+	_, err = connector.SubmitBulkJob(context.Background(), common.BulkJobParams{
+		ObjectName: "Opportunity",
+		Operation:  common.BulkOperationUpsert,
+		Records:    slices.Values([]common.Record{{"Name": "Acme"}}),
+	})
+	assert.ErrorIs(t, err, common.ErrMissingExternalIdField)
+
+	_, err = connector.SubmitBulkJob(context.Background(), common.BulkJobParams{
+		ObjectName: "Opportunity",
+		Operation:  common.BulkOperationInsert,
+	})
+	assert.ErrorIs(t, err, common.ErrMissingBulkData)
+}
+
+func TestBulkJobRowMatching(t *testing.T) {
+	t.Parallel()
+
+	rows, err := newBulkJobRows(nil, strings.NewReader("Name,Phone\nAcme,1\nGlobex,2\nAcme,1\n"))
+	assert.NilError(t, err)
+
+	var data strings.Builder
+	assert.NilError(t, rows.writeTo(&data))
+	assert.Equal(t, data.String(), "Name,Phone\nAcme,1\nGlobex,2\nAcme,1\n")
+
+	// Identical rows are matched in the order of submission, every index is given once.
+	matcher, err := newBulkRowMatcher(common.BulkJobResultsParams{
+		JobID:   "750ak000009BWKLAA4",
+		CSVData: strings.NewReader("Name,Phone\nAcme,1\nGlobex,2\nAcme,1\n"),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, matcher.match(map[string]string{"Name": "Acme", "Phone": "1"}), 0)
+	assert.Equal(t, matcher.match(map[string]string{"Name": "Globex", "Phone": "2"}), 1)
+	assert.Equal(t, matcher.match(map[string]string{"Name": "Acme", "Phone": "1"}), 2)
+	assert.Equal(t, matcher.match(map[string]string{"Name": "Acme", "Phone": "1"}), common.BulkJobRowIndexUnknown)
+
+	// Without the submitted data no row is matched.
+	matcher, err = newBulkRowMatcher(common.BulkJobResultsParams{JobID: "750ak000009BWKLAA4"})
+	assert.NilError(t, err)
+	assert.Equal(t, matcher.match(map[string]string{"Name": "Acme", "Phone": "1"}), common.BulkJobRowIndexUnknown)
+}
@@ -21,7 +21,12 @@ func (c *Connector) BulkRead(ctx context.Context, params common.ReadParams) (*Ge
 		return nil, err
 	}
 
-	soql := makeSOQL(params)
+	relationships, err := c.resolveRelationships(ctx, params.ObjectName, params.AssociatedObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	soql := makeSOQL(params, relationships)
 	// Note: if params.Deleted is set to true query will return only removed items.
 
 	query := soql.String()
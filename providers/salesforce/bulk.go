@@ -136,15 +136,7 @@ func (c *Connector) uploadCSV(ctx context.Context, jobId string, csvData io.Read
 		return nil, err
 	}
 
-	data, err := io.ReadAll(csvData)
-	if err != nil {
-		return nil, fmt.Errorf(
-			"failed to upload CSV to salesforce: %w",
-			errors.Join(ErrReadToByteFailed, err),
-		)
-	}
-
-	return c.Client.PutCSV(ctx, location.String(), data)
+	return c.Client.PutCSVStream(ctx, location.String(), csvData)
 }
 
 // https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/close_job.htm
@@ -159,6 +151,21 @@ func (c *Connector) completeUpload(ctx context.Context, jobId string) (*common.J
 	})
 }
 
+// https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/get_job_unprocessed_results.htm
+func (c *Connector) getUnprocessedJobRecords(ctx context.Context, jobId string) (*http.Response, error) {
+	location, err := c.getRestApiURL(fmt.Sprintf("jobs/ingest/%s/unprocessedrecords", jobId))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := common.MakeJSONGetRequest(ctx, location.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get request: %w", err)
+	}
+
+	return c.Client.HTTPClient.Client.Do(req)
+}
+
 // https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/get_job_failed_results.htm
 func (c *Connector) getFailedJobResults(ctx context.Context, jobId string) (*http.Response, error) {
 	location, err := c.getRestApiURL(fmt.Sprintf("jobs/ingest/%s/failedResults", jobId))
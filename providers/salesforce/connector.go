@@ -43,6 +43,9 @@ type Connector struct {
 	// pardotAdapter handles the Salesforce Account Engagement (Pardot) module.
 	// It provides dedicated support for Pardot-specific endpoints and metadata.
 	pardotAdapter *pardot.Adapter
+
+	// describeCache keeps describe data used to resolve relationships of associated objects.
+	describeCache *describeCache
 }
 
 // NewConnector returns a new Salesforce connector.
@@ -59,7 +62,8 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		Client: &common.JSONHTTPClient{
 			HTTPClient: httpClient,
 		},
-		moduleID: params.Module.Selection.ID,
+		moduleID:      params.Module.Selection.ID,
+		describeCache: newDescribeCache(),
 	}
 
 	conn.providerInfo, err = providers.ReadInfo(conn.Provider(), &params.Workspace)
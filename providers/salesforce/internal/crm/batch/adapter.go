@@ -2,6 +2,8 @@ package batch
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/urlbuilder"
@@ -16,7 +18,7 @@ const (
 	restAPISuffix = "/services/data/" + version
 )
 
-// Adapter handles batched record operations (create/update) against Salesforce's REST API.
+// Adapter handles batched record operations (create/update/upsert/delete) against Salesforce's REST API.
 // It abstracts endpoint construction, versioning, and JSON response handling for the Batch feature.
 type Adapter struct {
 	Client     *common.JSONHTTPClient
@@ -66,3 +68,28 @@ func (a *Adapter) getCreateURL() (*urlbuilder.URL, error) {
 func (a *Adapter) getUpdateURL() (*urlbuilder.URL, error) {
 	return urlbuilder.New(a.getModuleURL(), restAPISuffix, "/composite/sobjects")
 }
+
+// getUpsertURL builds the endpoint for upserting multiple records of one object type matched by external ID.
+//
+// nolint:lll
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_sobjects_collections_upsert.htm
+func (a *Adapter) getUpsertURL(objectName, externalIdField string) (*urlbuilder.URL, error) {
+	return urlbuilder.New(a.getModuleURL(), restAPISuffix, "/composite/sobjects", objectName, externalIdField)
+}
+
+// getDeleteURL builds the endpoint for deleting multiple records listed by identifiers.
+//
+// nolint:lll
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_sobjects_collections_delete.htm
+func (a *Adapter) getDeleteURL(identifiers []string, allOrNone bool) (*urlbuilder.URL, error) {
+	url, err := urlbuilder.New(a.getModuleURL(), restAPISuffix, "/composite/sobjects")
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("ids", strings.Join(identifiers, ","))
+	url.WithQueryParam("allOrNone", strconv.FormatBool(allOrNone))
+	url.AddEncodingExceptions(map[string]string{"%2C": ","})
+
+	return url, nil
+}
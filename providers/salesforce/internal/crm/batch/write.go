@@ -10,18 +10,21 @@ import (
 	"github.com/amp-labs/connectors/internal/codec"
 	"github.com/amp-labs/connectors/internal/datautils"
 	"github.com/amp-labs/connectors/internal/goutils"
+	"github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
 )
 
-// BatchWrite executes a Salesforce composite create or update request.
+// BatchWrite executes a Salesforce composite create, update, upsert or delete request.
 // It validates the input, builds the appropriate payload, sends the API call,
 // and parses the response into a BatchWriteResult.
 //
-// The payload formats for Create and Update endpoints are nearly identical.
-// The only notable difference—unused in this implementation—is the optional
-// "allOrNone" flag supported by the Update API (default is false).
+// The payload formats for Create, Update and Upsert endpoints are nearly identical.
+// Upsert names the object and the external ID field in the URL instead.
+// The "allOrNone" flag is taken from params and defaults to true.
 // nolint:lll
 // See: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_sobjects_collections_update.htm
 //
+// Delete has no payload, record identifiers are listed in the query.
+//
 // Response schemas differ slightly: Create responses wrap records in an
 // enclosing object, while Update responses return a list at the root level.
 // Each item also varies subtly in shape, represented by distinct Go structs,
@@ -31,6 +34,10 @@ func (a *Adapter) BatchWrite(ctx context.Context, params *common.BatchWriteParam
 		return nil, err
 	}
 
+	if params.IsDelete() {
+		return a.batchDelete(ctx, params)
+	}
+
 	url, err := a.buildBatchWriteURL(params)
 	if err != nil {
 		return nil, err
@@ -42,7 +49,7 @@ func (a *Adapter) BatchWrite(ctx context.Context, params *common.BatchWriteParam
 	}
 
 	write := a.Client.Post
-	if params.IsUpdate() {
+	if params.IsUpdate() || params.IsUpsert() {
 		write = a.Client.Patch
 	}
 
@@ -121,16 +128,80 @@ func (a *Adapter) handleEmptyResponse(
 	return common.NewBatchWriteResult(nil, totalNumRecords, totalNumRecords, nil)
 }
 
+// batchDelete removes records listed by identifiers.
+// Response items are returned in the order of identifiers.
+func (a *Adapter) batchDelete(ctx context.Context, params *common.BatchWriteParam) (*common.BatchWriteResult, error) {
+	url, err := a.getDeleteURL(params.RecordIds, allOrNone(params))
+	if err != nil {
+		return nil, err
+	}
+
+	headers := common.TransformWriteHeaders(params.Headers, common.HeaderModeOverwrite)
+
+	rsp, err := a.Client.Delete(ctx, url.String(), headers...)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := common.UnmarshalJSON[Response](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || len(*response) == 0 {
+		return a.handleEmptyResponse(rsp, len(params.RecordIds))
+	}
+
+	return common.ParseBatchWrite(
+		params.RecordIds,
+		func(index int, _ string) *Item {
+			list := *response
+			if index < 0 || index >= len(list) {
+				return nil
+			}
+
+			return &list[index]
+		},
+		func(identifier string, respItem *Item) (*common.WriteResult, error) {
+			if respItem == nil {
+				result := createUnprocessableItem()
+				result.RecordId = identifier
+
+				return result, nil
+			}
+
+			result, err := respItem.ToWriteResult()
+			if err == nil && result.RecordId == "" {
+				// Failed deletions do not echo the identifier.
+				result.RecordId = identifier
+			}
+
+			return result, err
+		},
+		nil,
+	)
+}
+
 func (a *Adapter) buildBatchWriteURL(params *common.BatchWriteParam) (*urlbuilder.URL, error) {
-	if params.IsCreate() {
+	switch params.Type {
+	case common.BatchWriteTypeCreate:
 		return a.getCreateURL()
+	case common.BatchWriteTypeUpdate:
+		return a.getUpdateURL()
+	case common.BatchWriteTypeUpsert:
+		return a.getUpsertURL(params.ObjectName.String(), params.ExternalIdField)
+	default:
+		return nil, common.ErrUnsupportedBatchWriteType
 	}
+}
 
-	if params.IsUpdate() {
-		return a.getUpdateURL()
+// allOrNone rolls back the whole batch on any failure unless the caller asks for partial success.
+func allOrNone(params *common.BatchWriteParam) bool {
+	if params.AllOrNone == nil {
+		return true
 	}
 
-	return nil, common.ErrUnsupportedBatchWriteType
+	return *params.AllOrNone
 }
 
 func buildBatchWritePayload(params *common.BatchWriteParam) (*Payload, error) {
@@ -141,6 +212,11 @@ func buildBatchWritePayload(params *common.BatchWriteParam) (*Payload, error) {
 
 	items := make([]PayloadItem, len(records))
 	for index, record := range records {
+		record, err = core.ApplyLookupAssociations(record, params.Batch[index].Associations)
+		if err != nil {
+			return nil, err
+		}
+
 		items[index] = PayloadItem{
 			Record: record,
 			Extension: RecordExtension{
@@ -153,7 +229,7 @@ func buildBatchWritePayload(params *common.BatchWriteParam) (*Payload, error) {
 
 	return &Payload{
 		Records:   items,
-		AllOrNone: goutils.Pointer(true),
+		AllOrNone: goutils.Pointer(allOrNone(params)),
 	}, nil
 }
 
@@ -163,7 +239,7 @@ func buildBatchWritePayload(params *common.BatchWriteParam) (*Payload, error) {
 type Payload struct {
 	Records []PayloadItem `json:"records"`
 
-	// AllOrNone is accepted by Create and Update endpoint for output with partial success.
+	// AllOrNone is accepted by Create, Update and Upsert endpoints for output with partial success.
 	AllOrNone *bool `json:"allOrNone"`
 }
 
@@ -188,6 +264,8 @@ type Item struct {
 	Success bool        `json:"success"`
 	ID      string      `json:"id,omitempty"`
 	Errors  []ItemError `json:"errors"`
+	// Created is returned by upsert telling whether the record was inserted rather than updated.
+	Created *bool `json:"created,omitempty"`
 
 	// These properties can come up during 400 BadRequest.
 	// Ex: no records sent to the endpoint.
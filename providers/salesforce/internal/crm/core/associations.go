@@ -0,0 +1,23 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// ApplyLookupAssociations sets lookup fields, Salesforce links records by storing the ID of the target.
+// The lookup field, ex: "AccountId", is given as the association type.
+// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/field_types.htm#i1435616
+func ApplyLookupAssociations(record common.Record, associations []common.AssociationInput) (common.Record, error) {
+	return common.ApplyLookupAssociations(record, associations, resolveLookupField)
+}
+
+func resolveLookupField(association common.AssociationInput) (string, any, error) {
+	if association.AssociationType == "" {
+		return "", nil, fmt.Errorf("%w: lookup field must be given as association type",
+			common.ErrInvalidAssociationInput)
+	}
+
+	return association.AssociationType, association.TargetId, nil
+}
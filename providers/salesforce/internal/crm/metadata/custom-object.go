@@ -0,0 +1,154 @@
+package metadata
+
+import (
+	"context"
+	"encoding/xml"
+	"maps"
+	"slices"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
+)
+
+const (
+	metadataTypeCustomObject = "CustomObject"
+
+	// Salesforce names the primary field of every object "Name", it can only be labeled.
+	primaryFieldName = "Name"
+)
+
+type UpsertCustomObjectsPayload UpsertMetadataPayload[MetadataCustomObject]
+
+// NewCustomObjectsPayload converts object definitions into metadata components.
+// Attributes Salesforce cannot honour are returned as warnings for each object.
+func NewCustomObjectsPayload(
+	params *common.UpsertMetadataParams,
+) (*UpsertCustomObjectsPayload, map[string][]string) {
+	objects := make([]MetadataCustomObject, 0, len(params.Objects))
+	warnings := make(map[string][]string)
+
+	for _, objectName := range slices.Sorted(maps.Keys(params.Objects)) {
+		definition := params.Objects[objectName]
+
+		object, objectWarnings := newMetadataCustomObject(objectName, definition)
+		objects = append(objects, *object)
+
+		if len(objectWarnings) != 0 {
+			warnings[objectName] = objectWarnings
+		}
+	}
+
+	return &UpsertCustomObjectsPayload{
+		MetadataList: objects,
+	}, warnings
+}
+
+// MetadataCustomObject fields can be found here:
+// https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/customobject.htm
+type MetadataCustomObject struct {
+	XMLName               xml.Name `xml:"metadata"`
+	AttributeMetadataType string   `xml:"xsi:type,attr"`
+
+	FullName    string    `xml:"fullName"`
+	Label       string    `xml:"label"`
+	PluralLabel string    `xml:"pluralLabel"`
+	Description string    `xml:"description,omitempty"`
+	NameField   NameField `xml:"nameField"`
+
+	// DeploymentStatus must be "Deployed" for the object to be usable via API.
+	DeploymentStatus string `xml:"deploymentStatus"`
+	SharingModel     string `xml:"sharingModel"`
+}
+
+// NameField is the primary field of the custom object, it titles records in the UI.
+type NameField struct {
+	Label string `xml:"label"`
+	Type  string `xml:"type"`
+}
+
+func newMetadataCustomObject(
+	objectName string, definition common.ObjectDefinition,
+) (*MetadataCustomObject, []string) {
+	var warnings []string
+
+	pluralLabel := definition.PluralDisplayName
+	if pluralLabel == "" {
+		pluralLabel = naming.NewSingularString(definition.DisplayName).Plural().String()
+	}
+
+	primaryField := definition.PrimaryDisplayField
+
+	nameLabel := primaryField.DisplayName
+	if nameLabel == "" {
+		nameLabel = definition.DisplayName + " Name"
+	}
+
+	if primaryField.FieldName != "" && primaryField.FieldName != primaryFieldName {
+		warnings = append(warnings, "primary display field is always named "+primaryFieldName)
+	}
+
+	if primaryField.ValueType != "" && primaryField.ValueType != common.FieldTypeString {
+		warnings = append(warnings, "primary display field is always of type "+fieldTypeText)
+	}
+
+	return &MetadataCustomObject{
+		AttributeMetadataType: metadataTypeCustomObject,
+		FullName:              objectName,
+		Label:                 definition.DisplayName,
+		PluralLabel:           pluralLabel,
+		Description:           definition.Description,
+		NameField: NameField{
+			Label: nameLabel,
+			Type:  fieldTypeText,
+		},
+		DeploymentStatus: "Deployed",
+		SharingModel:     "ReadWrite",
+	}, warnings
+}
+
+// upsertCustomObjects creates or updates custom objects.
+// Returns nil if no objects were requested.
+func (a *Adapter) upsertCustomObjects(
+	ctx context.Context, params *common.UpsertMetadataParams,
+) (map[string]common.ObjectUpsertResult, error) {
+	if len(params.Objects) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+
+	payload, warnings := NewCustomObjectsPayload(params)
+
+	response, err := performMetadataAPICall[UpsertMetadataResponse](ctx, a, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := transformResponseToResult(response)
+	if err != nil {
+		return nil, err
+	}
+
+	for objectName, object := range result.Objects {
+		object.Warnings = warnings[objectName]
+		result.Objects[objectName] = object
+	}
+
+	return result.Objects, nil
+}
+
+// newObjectPermissions grants the integration full access to records of custom objects.
+// Like optional fields, new custom objects are not accessible to any user by default.
+func newObjectPermissions(objects map[string]common.ObjectUpsertResult) ObjectPermissions {
+	permissions := make(ObjectPermissions)
+
+	for objectName := range objects {
+		permissions[objectName] = ObjectPermission{
+			AllowCreate: true,
+			AllowDelete: true,
+			AllowEdit:   true,
+			AllowRead:   true,
+			Object:      objectName,
+		}
+	}
+
+	return permissions
+}
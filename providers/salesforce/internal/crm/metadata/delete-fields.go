@@ -0,0 +1,151 @@
+package metadata
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// readMetadata and deleteMetadata accept at most 10 components per call.
+const maxComponentsPerCall = 10
+
+// DeleteMetadataPayload represents the request body for the Salesforce Metadata API `deleteMetadata` operation.
+// https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/meta_deleteMetadata.htm
+type DeleteMetadataPayload struct {
+	XMLName xml.Name `xml:"deleteMetadata"`
+
+	Type      string   `xml:"type"`
+	FullNames []string `xml:"fullNames"`
+}
+
+// deleteCustomFields removes custom fields and records the outcome in the result.
+// Fields that don't exist are reported with the action none.
+func (a *Adapter) deleteCustomFields(
+	ctx context.Context, deleteFields map[string][]string, result *common.UpsertMetadataResult,
+) error {
+	fullNames := customFieldFullNames(deleteFields)
+	if len(fullNames) == 0 {
+		return nil
+	}
+
+	// Deleting unknown fields would fail the whole call, which is "all or none".
+	existing, err := a.fetchExistingComponents(ctx, metadataTypeCustomField, fullNames)
+	if err != nil {
+		return err
+	}
+
+	errorMessages := datautils.NewStringSet()
+
+	names := existing.List()
+	slices.Sort(names)
+
+	for chunk := range slices.Chunk(names, maxComponentsPerCall) {
+		response, err := performMetadataAPICall[DeleteMetadataResponse](ctx, a, &DeleteMetadataPayload{
+			Type:      metadataTypeCustomField,
+			FullNames: chunk,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, deleted := range response.Response.Results {
+			for _, errorObj := range deleted.Errors {
+				errorMessages.AddOne(errorObj.Message)
+			}
+		}
+	}
+
+	if err = errorFromMessages(errorMessages); err != nil {
+		return err
+	}
+
+	for _, fullName := range fullNames {
+		action := common.UpsertMetadataActionNone
+		if existing.Has(fullName) {
+			action = common.UpsertMetadataActionDelete
+		}
+
+		setFieldResult(result, fullName, action)
+	}
+
+	return nil
+}
+
+// fetchExistingComponents returns full names of metadata components present in Salesforce.
+func (a *Adapter) fetchExistingComponents(
+	ctx context.Context, metadataType string, fullNames []string,
+) (datautils.StringSet, error) {
+	existing := datautils.NewStringSet()
+
+	for chunk := range slices.Chunk(fullNames, maxComponentsPerCall) {
+		response, err := performMetadataAPICall[MetadataRecordsResponse](ctx, a, &ReadMetadataPayload{
+			Type:      metadataType,
+			FullNames: chunk,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range response.Response.Results {
+			for _, record := range result.Records {
+				if !record.IsNil && record.FullName != "" {
+					existing.AddOne(record.FullName)
+				}
+			}
+		}
+	}
+
+	return existing, nil
+}
+
+func setFieldResult(result *common.UpsertMetadataResult, fullName string, action common.UpsertMetadataAction) {
+	objectName, fieldName, ok := splitCustomFieldFullName(fullName)
+	if !ok {
+		return
+	}
+
+	if result.Fields == nil {
+		result.Fields = make(map[string]map[string]common.FieldUpsertResult)
+	}
+
+	if result.Fields[objectName] == nil {
+		result.Fields[objectName] = make(map[string]common.FieldUpsertResult)
+	}
+
+	result.Fields[objectName][fieldName] = common.FieldUpsertResult{
+		FieldName: fieldName,
+		Action:    action,
+	}
+}
+
+// customFieldFullNames lists `ObjectName.FieldName` identifiers sorted alphabetically.
+func customFieldFullNames(fields map[string][]string) []string {
+	fullNames := make([]string, 0)
+
+	for _, objectName := range slices.Sorted(maps.Keys(fields)) {
+		for _, fieldName := range fields[objectName] {
+			fullNames = append(fullNames, customFieldFullName(objectName, fieldName))
+		}
+	}
+
+	return fullNames
+}
+
+func customFieldFullName(objectName, fieldName string) string {
+	return fmt.Sprintf("%v.%v", objectName, fieldName)
+}
+
+func splitCustomFieldFullName(fullName string) (objectName, fieldName string, ok bool) {
+	parts := strings.Split(fullName, ".")
+	if len(parts) != 2 { // nolint:mnd
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,111 @@
+package metadata
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// planUpsertMetadata resolves what UpsertMetadata would do without changing Salesforce.
+// Definitions are converted into payloads, so invalid definitions fail the same way as the real call.
+func (a *Adapter) planUpsertMetadata(
+	ctx context.Context, params *common.UpsertMetadataParams,
+) (*common.UpsertMetadataResult, error) {
+	if _, err := NewCustomFieldsPayload(params); err != nil {
+		return nil, err
+	}
+
+	result := &common.UpsertMetadataResult{
+		Success: true,
+		DryRun:  true,
+		Fields:  make(map[string]map[string]common.FieldUpsertResult),
+	}
+
+	objects, err := a.planCustomObjects(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Objects = objects
+
+	fieldNames := make(map[string][]string)
+	for objectName, definitions := range params.Fields {
+		for _, definition := range definitions {
+			fieldNames[objectName] = append(fieldNames[objectName], definition.FieldName)
+		}
+	}
+
+	if err = a.planCustomFields(ctx, fieldNames, result, common.UpsertMetadataActionUpdate,
+		common.UpsertMetadataActionCreate); err != nil {
+		return nil, err
+	}
+
+	if err = a.planCustomFields(ctx, params.DeleteFields, result, common.UpsertMetadataActionDelete,
+		common.UpsertMetadataActionNone); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (a *Adapter) planCustomObjects(
+	ctx context.Context, params *common.UpsertMetadataParams,
+) (map[string]common.ObjectUpsertResult, error) {
+	if len(params.Objects) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+
+	objectNames := slices.Sorted(maps.Keys(params.Objects))
+
+	existing, err := a.fetchExistingComponents(ctx, metadataTypeCustomObject, objectNames)
+	if err != nil {
+		return nil, err
+	}
+
+	_, warnings := NewCustomObjectsPayload(params)
+	objects := make(map[string]common.ObjectUpsertResult, len(objectNames))
+
+	for _, objectName := range objectNames {
+		action := common.UpsertMetadataActionCreate
+		if existing.Has(objectName) {
+			action = common.UpsertMetadataActionUpdate
+		}
+
+		objects[objectName] = common.ObjectUpsertResult{
+			ObjectName: objectName,
+			Action:     action,
+			Warnings:   warnings[objectName],
+		}
+	}
+
+	return objects, nil
+}
+
+// planCustomFields records the action depending on whether the field is already present in Salesforce.
+func (a *Adapter) planCustomFields(
+	ctx context.Context, fields map[string][]string, result *common.UpsertMetadataResult,
+	whenExists, whenMissing common.UpsertMetadataAction,
+) error {
+	fullNames := customFieldFullNames(fields)
+	if len(fullNames) == 0 {
+		return nil
+	}
+
+	existing, err := a.fetchExistingComponents(ctx, metadataTypeCustomField, fullNames)
+	if err != nil {
+		return err
+	}
+
+	for _, fullName := range fullNames {
+		action := whenMissing
+		if existing.Has(fullName) {
+			action = whenExists
+		}
+
+		setFieldResult(result, fullName, action)
+	}
+
+	return nil
+}
@@ -11,91 +11,127 @@ import (
 
 var ErrPermissionSetUpsert = errors.New("metadata: upsert PermissionSet failed")
 
-// UpsertMetadata creates or updates the definition of custom fields in Salesforce.
+// UpsertMetadata creates or updates the definition of custom objects and fields in Salesforce,
+// and deletes custom fields that are no longer needed.
 //
-// This method uses the Salesforce Metadata API to synchronize custom field definitions,
-// and ensures that any *optional* fields (those not automatically visible to users)
-// receive appropriate FieldPermissions through the Ampersand-managed Permission Set.
+// This method uses the Salesforce Metadata API to synchronize custom definitions,
+// and ensures that any *optional* fields and custom objects (those not automatically visible to users)
+// receive appropriate permissions through the Ampersand-managed Permission Set.
 //
 // Reference:
 //
 //	https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/meta_upsertMetadata.htm
 //
 // Behavior summary:
-//  1. Calls upsertCustomFields to create or update field definitions.
-//  2. If optional fields were created, retrieves the existing field permissions.
-//  3. Merges existing and new permissions into a combined permission map.
-//  4. Upserts the Ampersand Permission Set to include new permissions.
-//  5. Ensures the current user is assigned to that Permission Set.
+//  1. Calls upsertCustomObjects to create or update object definitions.
+//  2. Calls upsertCustomFields to create or update field definitions.
+//  3. Calls deleteCustomFields to remove fields, unknown fields are skipped.
+//  4. Stops unless optional fields or objects were upserted.
+//  5. Retrieves the existing permissions.
+//  6. Merges existing and new permissions into combined permission maps.
+//  7. Upserts the Ampersand Permission Set to include new permissions.
+//  8. Retrieves the Permission Set ID and the current user ID.
+//  9. Ensures the current user is assigned to that Permission Set.
 //
-// Optional fields in Salesforce are *not visible to any profile or user by default*.
-// This function guarantees those fields are accessible after creation.
-func (a *Adapter) UpsertMetadata(
+// Optional fields and custom objects in Salesforce are *not visible to any profile or user by default*.
+// This function guarantees those are accessible after creation.
+//
+// With DryRun the current Salesforce schema is read to report planned actions, nothing is modified.
+func (a *Adapter) UpsertMetadata( // nolint:cyclop
 	ctx context.Context, params *common.UpsertMetadataParams,
 ) (*common.UpsertMetadataResult, error) {
 	if err := params.ValidateParams(); err != nil {
 		return nil, err
 	}
 
+	if params.DryRun {
+		return a.planUpsertMetadata(ctx, params)
+	}
+
 	// ---
 	// The comments below starting with [Current state] indicate the Salesforce data state
 	// and the side effects of each step at that *exit point*.
 	// Rerunning UpsertMetadata will safely resume progress.
 	// ---
 
-	// Step 1: Create or update all custom fields.
+	// Step 1: Create or update custom objects, fields may be defined on them.
+	objects, err := a.upsertCustomObjects(ctx, params)
+	if err != nil {
+		return nil, err // [Current state]: Nothing changed.
+	}
+
+	// Step 2: Create or update all custom fields.
 	result, optionalFields, err := a.upsertCustomFields(ctx, params)
 	if err != nil {
-		return nil, err // [Current state]: Fields upserted, but optional ones remain invisible.
+		return nil, err // [Current state]: Objects upserted, but remain invisible.
+	}
+
+	result.Objects = objects
+
+	// Step 3: Delete custom fields.
+	if err = a.deleteCustomFields(ctx, params.DeleteFields, result); err != nil {
+		return nil, err // [Current state]: Objects and fields upserted, but optional ones remain invisible.
 	}
 
-	// Step 2: If there are no optional fields, no permission updates are needed.
-	if len(optionalFields) == 0 {
+	// Step 4: If there are no optional fields or objects, no permission updates are needed.
+	objectPermissions := newObjectPermissions(objects)
+	if len(optionalFields) == 0 && len(objectPermissions) == 0 {
 		return result, nil
 	}
 
-	// Step 3: Fetch the existing FieldPermissions defined in the Ampersand Permission Set.
-	existingFieldPermissions, err := a.fetchFieldPermissions(ctx)
+	// Step 5: Fetch the existing permissions defined in the Ampersand Permission Set.
+	existingFieldPermissions, existingObjectPermissions, err := a.fetchPermissions(ctx)
 	if err != nil {
-		return nil, err // [Current state]: Fields upserted, but optional ones remain invisible.
+		return nil, err // [Current state]: Objects and fields upserted, but optional ones remain invisible.
 	}
 
-	// Step 4: Merge new optional field permissions with the existing set.
-	// This ensures existing permissions are preserved and new fields are appended.
-	combinedPermissions := datautils.MergeMaps(
+	// Step 6: Merge new permissions with the existing set.
+	// This ensures existing permissions are preserved and new ones are appended.
+	combinedFieldPermissions := datautils.MergeMaps(
 		existingFieldPermissions,
 		optionalFields,
 	)
+	combinedObjectPermissions := datautils.MergeMaps(
+		existingObjectPermissions,
+		objectPermissions,
+	)
 
-	// Step 5: Upsert the Ampersand Permission Set with the combined permissions.
-	if err = a.upsertPermissionSet(ctx, combinedPermissions); err != nil {
-		return nil, err // [Current state]: Fields upserted, but optional ones remain invisible.
+	// Step 7: Upsert the Ampersand Permission Set with the combined permissions.
+	if err = a.upsertPermissionSet(ctx, combinedFieldPermissions, combinedObjectPermissions); err != nil {
+		return nil, err // [Current state]: Objects and fields upserted, but optional ones remain invisible.
 	}
 
-	// Step 6: Retrieve IDs required to assign the Permission Set to the current user.
+	// Step 8: Retrieve IDs required to assign the Permission Set to the current user.
 	permissionSetID, err := a.fetchPermissionSetID(ctx)
 	if err != nil {
-		return nil, err // [Current state]: Fields upserted and permission set has new + old field permissions.
+		return nil, err // [Current state]: Fields upserted and permission set has new + old permissions.
 	}
 
 	userID, err := a.fetchUserID(ctx)
 	if err != nil {
-		return nil, err // [Current state]: Fields upserted and permission set has new + old field permissions.
+		return nil, err // [Current state]: Fields upserted and permission set has new + old permissions.
 	}
 
-	// Step 7: Assign the Ampersand-managed Permission Set to the current user,
-	// ensuring access to all optional fields that were just created.
+	// Step 9: Assign the Ampersand-managed Permission Set to the current user,
+	// ensuring access to all optional fields and objects that were just created.
 	if err = a.assignPermissionSetToUser(ctx, userID, permissionSetID); err != nil {
-		return nil, err // [Current state]: Fields upserted and permission set has new + old field permissions.
+		return nil, err // [Current state]: Fields upserted and permission set has new + old permissions.
 	}
 
-	// [Current state]: Fields upserted, permission set updated, and current user is assigned to the permission set.
+	// [Current state]: Metadata upserted, permission set updated, and current user is assigned to the permission set.
 	return result, nil
 }
 
 func (a *Adapter) upsertCustomFields(
 	ctx context.Context, params *common.UpsertMetadataParams,
 ) (*common.UpsertMetadataResult, FieldPermissions, error) {
+	if len(params.Fields) == 0 {
+		return &common.UpsertMetadataResult{
+			Success: true,
+			Fields:  make(map[string]map[string]common.FieldUpsertResult),
+		}, nil, nil
+	}
+
 	payload, err := NewCustomFieldsPayload(params)
 	if err != nil {
 		return nil, nil, err
@@ -114,19 +150,21 @@ func (a *Adapter) upsertCustomFields(
 	return result, payload.getOptionalFields(), nil
 }
 
-func (a *Adapter) fetchFieldPermissions(ctx context.Context) (FieldPermissions, error) {
+func (a *Adapter) fetchPermissions(ctx context.Context) (FieldPermissions, ObjectPermissions, error) {
 	payload := NewReadPermissionSetPayload()
 
 	permissionSetBody, err := performMetadataAPICall[PermissionSetResponse](ctx, a, payload)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return permissionSetBody.GetFieldPermissions(), nil
+	return permissionSetBody.GetFieldPermissions(), permissionSetBody.GetObjectPermissions(), nil
 }
 
-func (a *Adapter) upsertPermissionSet(ctx context.Context, permissions FieldPermissions) error {
-	payload := NewPermissionSetPayload(permissions)
+func (a *Adapter) upsertPermissionSet(
+	ctx context.Context, fieldPermissions FieldPermissions, objectPermissions ObjectPermissions,
+) error {
+	payload := NewPermissionSetPayload(fieldPermissions, objectPermissions)
 
 	response, err := performMetadataAPICall[UpsertMetadataResponse](ctx, a, payload)
 	if err != nil {
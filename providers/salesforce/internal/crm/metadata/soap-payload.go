@@ -111,7 +111,7 @@ func newMetadataCustomField(
 	result := MetadataCustomField{
 		AttributeMetadataType: metadataTypeCustomField,
 		Type:                  fieldType,
-		FullName:              fmt.Sprintf("%v.%v", objectName, definition.FieldName),
+		FullName:              customFieldFullName(objectName, definition.FieldName),
 		Label:                 definition.DisplayName,
 		Description:           definition.Description,
 		Required:              definition.Required,
@@ -355,20 +355,22 @@ type ReadMetadataPayload struct {
 
 	// Type is the object type. Ex: PermissionSet.
 	Type string `xml:"type"`
-	// FullNames is a name of object instance.
-	FullNames string `xml:"fullNames"`
+	// FullNames are names of object instances, at most 10 per call.
+	FullNames []string `xml:"fullNames"`
 }
 
 func NewReadPermissionSetPayload() *ReadMetadataPayload {
 	return &ReadMetadataPayload{
 		Type:      PermissionSetType,
-		FullNames: DefaultPermissionSetName,
+		FullNames: []string{DefaultPermissionSetName},
 	}
 }
 
 type UpsertPermissionSetPayload UpsertMetadataPayload[MetadataPermissionSet]
 
-func NewPermissionSetPayload(fieldPermissions FieldPermissions) *UpsertPermissionSetPayload {
+func NewPermissionSetPayload(
+	fieldPermissions FieldPermissions, objectPermissions ObjectPermissions,
+) *UpsertPermissionSetPayload {
 	return &UpsertPermissionSetPayload{
 		MetadataList: []MetadataPermissionSet{
 			{
@@ -377,6 +379,7 @@ func NewPermissionSetPayload(fieldPermissions FieldPermissions) *UpsertPermissio
 				Label:                 DefaultPermissionSetLabel,
 				Description:           DefaultPermissionSetDescription,
 				Fields:                datautils.FromMap(fieldPermissions).Values(),
+				Objects:               datautils.FromMap(objectPermissions).Values(),
 			},
 		},
 	}
@@ -395,4 +398,6 @@ type MetadataPermissionSet struct {
 
 	// Fields
 	Fields []FieldPermission `xml:"fieldPermissions"`
+	// Objects
+	Objects []ObjectPermission `xml:"objectPermissions"`
 }
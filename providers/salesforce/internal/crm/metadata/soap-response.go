@@ -30,25 +30,39 @@ func transformResponseToResult(resp *UpsertMetadataResponse) (*common.UpsertMeta
 	errorMessages := datautils.NewStringSet()
 	fields := make(map[string]map[string]common.FieldUpsertResult)
 
+	var objects map[string]common.ObjectUpsertResult
+
 	for _, result := range resp.Response.Results {
 		for _, errorObj := range result.Errors {
 			errorMessages.AddOne(errorObj.Message)
 		}
 
-		parts := strings.Split(result.FullName, ".")
-		if len(parts) != 2 { // nolint:mnd
-			// Format of the full name must be `ObjectName.FieldName`.
-			// Omit this record.
-			continue
-		}
-
-		objectName, fieldName := parts[0], parts[1]
-
 		action := common.UpsertMetadataActionUpdate
 		if result.Created {
 			action = common.UpsertMetadataActionCreate
 		}
 
+		if !strings.Contains(result.FullName, ".") {
+			// Custom objects are named without the dot.
+			if objects == nil {
+				objects = make(map[string]common.ObjectUpsertResult)
+			}
+
+			objects[result.FullName] = common.ObjectUpsertResult{
+				ObjectName: result.FullName,
+				Action:     action,
+			}
+
+			continue
+		}
+
+		objectName, fieldName, ok := splitCustomFieldFullName(result.FullName)
+		if !ok {
+			// Format of the full name must be `ObjectName.FieldName`.
+			// Omit this record.
+			continue
+		}
+
 		fieldsMap, ok := fields[objectName]
 		if !ok {
 			fields[objectName] = make(map[string]common.FieldUpsertResult)
@@ -61,20 +75,29 @@ func transformResponseToResult(resp *UpsertMetadataResponse) (*common.UpsertMeta
 		}
 	}
 
-	if len(errorMessages) != 0 {
-		// Only unique errors should be surfaced.
-		messages := errorMessages.List()
-		sort.Strings(messages)
-
-		return nil, fmt.Errorf("%w: %v", common.ErrBadRequest, strings.Join(messages, "; "))
+	if err := errorFromMessages(errorMessages); err != nil {
+		return nil, err
 	}
 
 	return &common.UpsertMetadataResult{
 		Success: true,
+		Objects: objects,
 		Fields:  fields,
 	}, nil
 }
 
+func errorFromMessages(errorMessages datautils.StringSet) error {
+	if len(errorMessages) == 0 {
+		return nil
+	}
+
+	// Only unique errors should be surfaced.
+	messages := errorMessages.List()
+	sort.Strings(messages)
+
+	return fmt.Errorf("%w: %v", common.ErrBadRequest, strings.Join(messages, "; "))
+}
+
 type UpsertMetadataResponse struct {
 	Response struct {
 		Results []UpsertMetadataResult `xml:"result"`
@@ -141,11 +164,33 @@ func (r PermissionSetResponse) GetFieldPermissions() FieldPermissions {
 	return fieldPermissions
 }
 
+// GetObjectPermissions returns all ObjectPermissions of the default Ampersand-managed permission set.
+func (r PermissionSetResponse) GetObjectPermissions() ObjectPermissions {
+	objectPermissions := make(ObjectPermissions)
+
+	for _, result := range r.Response.Results {
+		for _, record := range result.Records {
+			if record.IsNil || record.XSIType != PermissionSetType {
+				continue
+			}
+
+			if record.FullName == DefaultPermissionSetName {
+				for _, permission := range record.ObjectPermissions {
+					objectPermissions[permission.Object] = permission
+				}
+			}
+		}
+	}
+
+	return objectPermissions
+}
+
 type PermissionSet struct {
-	XSIType          string            `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
-	IsNil            bool              `xml:"http://www.w3.org/2001/XMLSchema-instance nil,attr"`
-	FullName         string            `xml:"fullName"`
-	FieldPermissions []FieldPermission `xml:"fieldPermissions"`
+	XSIType           string             `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+	IsNil             bool               `xml:"http://www.w3.org/2001/XMLSchema-instance nil,attr"`
+	FullName          string             `xml:"fullName"`
+	FieldPermissions  []FieldPermission  `xml:"fieldPermissions"`
+	ObjectPermissions []ObjectPermission `xml:"objectPermissions"`
 }
 
 type FieldPermissions map[string]FieldPermission
@@ -158,3 +203,32 @@ type FieldPermission struct {
 	Readable bool   `xml:"readable"`
 	Editable bool   `xml:"editable"`
 }
+
+type ObjectPermissions map[string]ObjectPermission
+
+// ObjectPermission represents the access rights for records of a single object.
+// Like FieldPermission it is used both in request payloads and in responses.
+type ObjectPermission struct {
+	AllowCreate      bool   `xml:"allowCreate"`
+	AllowDelete      bool   `xml:"allowDelete"`
+	AllowEdit        bool   `xml:"allowEdit"`
+	AllowRead        bool   `xml:"allowRead"`
+	ModifyAllRecords bool   `xml:"modifyAllRecords"`
+	Object           string `xml:"object"`
+	ViewAllRecords   bool   `xml:"viewAllRecords"`
+}
+
+type DeleteMetadataResponse struct {
+	Response struct {
+		Results []UpsertMetadataResult `xml:"result"`
+	} `xml:"deleteMetadataResponse"`
+}
+
+// MetadataRecord is the common part of any metadata component returned by readMetadata.
+type MetadataRecord struct {
+	XSIType  string `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+	IsNil    bool   `xml:"http://www.w3.org/2001/XMLSchema-instance nil,attr"`
+	FullName string `xml:"fullName"`
+}
+
+type MetadataRecordsResponse ReadMetadataBody[MetadataRecord]
@@ -140,6 +140,19 @@ type describeSObjectResult struct {
 	Name   string        `json:"name"`
 	Label  string        `json:"label"`
 	Fields []fieldResult `json:"fields" validate:"required"`
+	// ChildRelationships list objects which have a lookup field referencing this object.
+	ChildRelationships []childRelationshipResult `json:"childRelationships"`
+}
+
+// See https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_calls_describesobjects_describesobjectresult.htm#childrelationship.
+//
+//nolint:lll
+type childRelationshipResult struct {
+	ChildSObject string `json:"childSObject"`
+	// Field is the lookup field of the child object.
+	Field string `json:"field"`
+	// RelationshipName is used in subqueries, it is empty when the relationship cannot be queried.
+	RelationshipName string `json:"relationshipName"`
 }
 
 // See https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_calls_describesobjects_describesobjectresult.htm#field.
@@ -155,6 +168,18 @@ type fieldResult struct {
 
 	PicklistValues []picklistValue `json:"picklistValues"`
 
+	// Objects referenced by lookup fields.
+	ReferenceTo []string `json:"referenceTo"`
+	// RelationshipName of lookup fields is used to reach fields of the referenced object, ex: Account.Name.
+	RelationshipName string `json:"relationshipName"`
+	// PolymorphicForeignKey is set for lookup fields referencing several objects, ex: WhoId of Task.
+	PolymorphicForeignKey bool `json:"polymorphicForeignKey"`
+	// Length is the maximum number of characters of string fields, zero for other types.
+	Length int `json:"length"`
+	// Precision and Scale describe numeric fields, zero for other types.
+	Precision int `json:"precision"`
+	Scale     int `json:"scale"`
+
 	Autonumber        *bool `json:"autonumber,omitempty"`
 	Calculated        *bool `json:"calculated,omitempty"`
 	Createable        *bool `json:"createable,omitempty"`
@@ -201,14 +226,24 @@ func (f fieldResult) transformToFieldMetadata() common.FieldMetadata {
 	// Based on type property map value to Ampersand value type.
 	// See https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/field_types.htm
 	switch f.Type {
-	case "string", "textarea", "url", "email", "reference", "id", "phone":
+	case "string", "textarea", "id", "encryptedstring":
 		valueType = common.ValueTypeString
+	case "email":
+		valueType = common.ValueTypeEmail
+	case "url":
+		valueType = common.ValueTypeURL
+	case "phone":
+		valueType = common.ValueTypePhone
+	case "reference":
+		valueType = common.ValueTypeReference
 	case "boolean":
 		valueType = common.ValueTypeBoolean
 	case "int":
 		valueType = common.ValueTypeInt
-	case "double", "currency", "percent":
+	case "double", "percent":
 		valueType = common.ValueTypeFloat
+	case "currency":
+		valueType = common.ValueTypeCurrency
 	case "date":
 		valueType = common.ValueTypeDate
 	case "datetime":
@@ -219,11 +254,14 @@ func (f fieldResult) transformToFieldMetadata() common.FieldMetadata {
 	case "multipicklist":
 		valueType = common.ValueTypeMultiSelect
 		values = f.getFieldValues()
+	case "address", "location":
+		// Compound fields are objects made of several fields.
+		valueType = common.ValueTypeJSON
 	default:
 		valueType = common.ValueTypeOther
 	}
 
-	return common.FieldMetadata{
+	metadata := common.FieldMetadata{
 		DisplayName:  f.DisplayName,
 		ValueType:    valueType,
 		ProviderType: f.Type,
@@ -232,6 +270,25 @@ func (f fieldResult) transformToFieldMetadata() common.FieldMetadata {
 		IsRequired:   f.isRequired(),
 		Values:       values,
 	}
+
+	if valueType == common.ValueTypeReference {
+		metadata.ReferenceTo = f.ReferenceTo
+	}
+
+	// Picklists report length and precision too, constraints are kept only for text and numbers.
+	switch valueType.BaseType() {
+	case common.ValueTypeString:
+		if f.Length > 0 {
+			metadata.MaxLength = goutils.Pointer(f.Length)
+		}
+	case common.ValueTypeFloat, common.ValueTypeInt:
+		if f.Precision > 0 {
+			metadata.Precision = goutils.Pointer(f.Precision)
+			metadata.Scale = goutils.Pointer(f.Scale)
+		}
+	}
+
+	return metadata
 }
 
 func (f fieldResult) getFieldValues() []common.FieldValue {
@@ -61,6 +61,7 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 								ReadOnly:     goutils.Pointer(false),
 								IsCustom:     goutils.Pointer(false),
 								IsRequired:   goutils.Pointer(false),
+								MaxLength:    goutils.Pointer(80),
 							},
 							"preferencesconsentmanagementenabled": {
 								DisplayName:  "ConsentManagementEnabled",
@@ -79,6 +80,8 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 								IsCustom:     goutils.Pointer(false),
 								IsRequired:   goutils.Pointer(false),
 								Values:       nil,
+								Precision:    goutils.Pointer(18),
+								Scale:        goutils.Pointer(15),
 							},
 							"monthlypageviewsused": {
 								DisplayName:  "Monthly Page Views Used",
@@ -124,12 +127,13 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 							},
 							"phone": {
 								DisplayName:  "Phone",
-								ValueType:    "string",
+								ValueType:    common.ValueTypePhone,
 								ProviderType: "phone",
 								ReadOnly:     goutils.Pointer(false),
 								IsCustom:     goutils.Pointer(false),
 								IsRequired:   goutils.Pointer(false),
 								Values:       nil,
+								MaxLength:    goutils.Pointer(40),
 							},
 						},
 						FieldsMap: map[string]string{
@@ -172,6 +176,7 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 								ReadOnly:     goutils.Pointer(true),
 								IsCustom:     goutils.Pointer(false),
 								IsRequired:   goutils.Pointer(false),
+								MaxLength:    goutils.Pointer(18),
 							},
 							"interests__c": {
 								DisplayName:  "Interests",
@@ -193,11 +198,12 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 							},
 							"mailbox__c": {
 								DisplayName:  "MailBox",
-								ValueType:    "string",
+								ValueType:    common.ValueTypeEmail,
 								ProviderType: "email",
 								ReadOnly:     goutils.Pointer(false),
 								IsCustom:     goutils.Pointer(true),
 								IsRequired:   goutils.Pointer(false),
+								MaxLength:    goutils.Pointer(80),
 							},
 						},
 					},
@@ -231,6 +237,7 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 								ReadOnly:     goutils.Pointer(false),
 								IsCustom:     goutils.Pointer(false),
 								IsRequired:   goutils.Pointer(true),
+								MaxLength:    goutils.Pointer(255),
 							},
 							"createddate": {
 								DisplayName:  "Created Date",
@@ -242,19 +249,22 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 							},
 							"createdbyid": {
 								DisplayName:  "Created By ID",
-								ValueType:    "string",
+								ValueType:    common.ValueTypeReference,
 								ProviderType: "reference",
 								ReadOnly:     goutils.Pointer(true),
 								IsCustom:     goutils.Pointer(false),
 								IsRequired:   goutils.Pointer(false),
+								ReferenceTo:  []string{"User"},
+								MaxLength:    goutils.Pointer(18),
 							},
 							"photourl": {
 								DisplayName:  "Photo URL",
-								ValueType:    "string",
+								ValueType:    common.ValueTypeURL,
 								ProviderType: "url",
 								ReadOnly:     goutils.Pointer(true),
 								IsCustom:     goutils.Pointer(false),
 								IsRequired:   goutils.Pointer(false),
+								MaxLength:    goutils.Pointer(255),
 							},
 						},
 					},
@@ -366,6 +376,35 @@ func TestUpsertMetadataCRM(t *testing.T) { // nolint:funlen,gocognit,cyclop
 	responsePermissionSet := testutils.DataFromFile(t, "metadata/write/permission-set.json")
 	responseUserInfo := testutils.DataFromFile(t, "metadata/write/user-info.json")
 	duplicatePermissionAssignment := testutils.DataFromFile(t, "metadata/write/err-duplicate-permission-assignment.json")
+	payloadCreateObject := testutils.DataFromFile(t, "metadata/write/vehicle/create-object-payload.xml")
+	responseCreateObject := testutils.DataFromFile(t, "metadata/write/vehicle/create-object-response.xml")
+	payloadReadObject := testutils.DataFromFile(t, "metadata/write/vehicle/read-object-payload.xml")
+	responseReadObject := testutils.DataFromFile(t, "metadata/write/vehicle/read-object-response.xml")
+	payloadReadFields := testutils.DataFromFile(t, "metadata/write/vehicle/read-fields-payload.xml")
+	responseReadFields := testutils.DataFromFile(t, "metadata/write/vehicle/read-fields-response.xml")
+	payloadDeleteFields := testutils.DataFromFile(t, "metadata/write/vehicle/delete-fields-payload.xml")
+	responseDeleteFields := testutils.DataFromFile(t, "metadata/write/vehicle/delete-fields-response.xml")
+	payloadPermissionsUpsert := testutils.DataFromFile(t, "metadata/write/vehicle/write-permissions-payload.xml")
+
+	vehicleObject := common.ObjectDefinition{
+		DisplayName: "Vehicle",
+		Description: "Company fleet.",
+		PrimaryDisplayField: common.FieldDefinition{
+			FieldName:   "Name",
+			DisplayName: "Plate Number",
+			ValueType:   common.FieldTypeString,
+		},
+	}
+	legacyFieldsDeletion := map[string]common.FieldUpsertResult{
+		"Legacy__c": {
+			FieldName: "Legacy__c",
+			Action:    "delete",
+		},
+		"Unknown__c": {
+			FieldName: "Unknown__c",
+			Action:    "none",
+		},
+	}
 
 	tests := []testroutines.UpsertMetadata{
 		{
@@ -586,6 +625,133 @@ func TestUpsertMetadataCRM(t *testing.T) { // nolint:funlen,gocognit,cyclop
 				},
 			},
 		},
+		{
+			Name: "Dry run reports planned changes without applying them",
+			Input: &common.UpsertMetadataParams{
+				Objects: map[string]common.ObjectDefinition{
+					"Vehicle__c": {
+						DisplayName: "Vehicle",
+						PrimaryDisplayField: common.FieldDefinition{
+							FieldName: "Plate__c",
+						},
+					},
+				},
+				DeleteFields: map[string][]string{
+					"Account": {"Legacy__c", "Unknown__c"},
+				},
+				DryRun: true,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentXML(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.Path("/services/Soap/m/60.0"),
+						mockcond.BodyBytes(payloadReadObject),
+					},
+					Then: mockserver.Response(http.StatusOK, responseReadObject),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/services/Soap/m/60.0"),
+						mockcond.BodyBytes(payloadReadFields),
+					},
+					Then: mockserver.Response(http.StatusOK, responseReadFields),
+				}},
+			}.Server(),
+			Expected: &common.UpsertMetadataResult{
+				Success: true,
+				DryRun:  true,
+				Objects: map[string]common.ObjectUpsertResult{
+					"Vehicle__c": {
+						ObjectName: "Vehicle__c",
+						Action:     "create",
+						Warnings:   []string{"primary display field is always named Name"},
+					},
+				},
+				Fields: map[string]map[string]common.FieldUpsertResult{
+					"Account": legacyFieldsDeletion,
+				},
+			},
+		},
+		{
+			Name: "Create custom object and delete fields",
+			Input: &common.UpsertMetadataParams{
+				Objects: map[string]common.ObjectDefinition{
+					"Vehicle__c": vehicleObject,
+				},
+				DeleteFields: map[string][]string{
+					"Account": {"Legacy__c", "Unknown__c"},
+				},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentXML(),
+				Cases: mockserver.Cases{{
+					If: mockcond.And{
+						mockcond.Path("/services/Soap/m/60.0"),
+						mockcond.BodyBytes(payloadCreateObject),
+					},
+					Then: mockserver.Response(http.StatusOK, responseCreateObject),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/services/Soap/m/60.0"),
+						mockcond.BodyBytes(payloadReadFields),
+					},
+					Then: mockserver.Response(http.StatusOK, responseReadFields),
+				}, {
+					// Only existing fields are deleted.
+					If: mockcond.And{
+						mockcond.Path("/services/Soap/m/60.0"),
+						mockcond.BodyBytes(payloadDeleteFields),
+					},
+					Then: mockserver.Response(http.StatusOK, responseDeleteFields),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/services/Soap/m/60.0"),
+						mockcond.BodyBytes(payloadFieldPermissions),
+					},
+					Then: mockserver.Response(http.StatusOK, responseFieldPermissions),
+				}, {
+					// New object is granted access next to existing field permissions.
+					If: mockcond.And{
+						mockcond.Path("/services/Soap/m/60.0"),
+						mockcond.BodyBytes(payloadPermissionsUpsert),
+					},
+					Then: mockserver.Response(http.StatusOK, responseFieldPermissionsUpsert),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodGET(),
+						mockcond.Path("/services/data/v60.0/query"),
+					},
+					Then: mockserver.ResponseChainedFuncs(
+						mockserver.ContentJSON(),
+						mockserver.Response(http.StatusOK, responsePermissionSet),
+					),
+				}, {
+					If: mockcond.Path("/services/oauth2/userinfo"),
+					Then: mockserver.ResponseChainedFuncs(
+						mockserver.ContentJSON(),
+						mockserver.Response(http.StatusOK, responseUserInfo),
+					),
+				}, {
+					If: mockcond.Path("/services/data/v60.0/sobjects/PermissionSetAssignment"),
+					Then: mockserver.ResponseChainedFuncs(
+						mockserver.ContentJSON(),
+						mockserver.Response(http.StatusBadRequest, duplicatePermissionAssignment),
+					),
+				}},
+			}.Server(),
+			Expected: &common.UpsertMetadataResult{
+				Success: true,
+				Objects: map[string]common.ObjectUpsertResult{
+					"Vehicle__c": {
+						ObjectName: "Vehicle__c",
+						Action:     "create",
+					},
+				},
+				Fields: map[string]map[string]common.FieldUpsertResult{
+					"Account": legacyFieldsDeletion,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,6 +1,8 @@
 package salesforce
 
 import (
+	"strings"
+
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/internal/jsonquery"
 	"github.com/spyzhov/ajson"
@@ -23,7 +25,7 @@ func getNextRecordsURL(node *ajson.Node) (string, error) {
 
 // getSalesforceDataMarshaller returns a marshaller that fills Associations in ReadResultRow for Salesforce.
 func getSalesforceDataMarshaller(
-	config common.ReadParams,
+	config common.ReadParams, relationships map[string]relationship,
 ) func(
 	[]map[string]any,
 	[]string,
@@ -47,7 +49,7 @@ func getSalesforceDataMarshaller(
 				Id:     idStr,
 			}
 
-			associations := extractAssociations(recordMap, config)
+			associations := extractAssociations(recordMap, config, relationships)
 
 			if len(associations) > 0 {
 				data[idx].Associations = associations
@@ -63,25 +65,37 @@ func getSalesforceDataMarshaller(
 //  1. Parent relationships (e.g., Opportunity -> Account via AccountId): We only have the parent field
 //     value (the ID) in the response. We create an association with empty Raw, which triggers the
 //     workflow layer to fetch the full associated record using GetRecordsByIds.
+//     Polymorphic lookups (e.g., Task -> Contact via WhoId) only produce associations
+//     when the referenced record is of the requested type.
 //  2. Junction relationships (e.g., Opportunity -> Contact via OpportunityContactRoles): We query a
 //     child relationship (OpportunityContactRoles) but extract the related object ID (ContactId) to
 //     create associations for the related object (Contact).
 //  3. Child relationships (e.g., Account -> Contacts): The associated records come nested in the
 //     response, so we can extract them directly.
-func extractAssociations(recordMap common.StringMap, config common.ReadParams) map[string][]common.Association {
+//
+// The relationship name is reported as the association type.
+func extractAssociations(
+	recordMap common.StringMap, config common.ReadParams, relationships map[string]relationship,
+) map[string][]common.Association {
 	associations := make(map[string][]common.Association)
 
 	for _, assocObj := range config.AssociatedObjects {
-		var assoc []common.Association
-		if isParentRelationship(config.ObjectName, assocObj) {
-			assoc = extractParentAssociation(recordMap, config.ObjectName, assocObj)
-		} else {
-			relationshipName, relatedIdField, isJunction := getJunctionRelationship(config.ObjectName, assocObj)
-			if isJunction {
-				assoc = extractJunctionAssociation(recordMap, relationshipName, relatedIdField)
-			} else {
-				assoc = extractChildAssociation(recordMap, assocObj)
-			}
+		var (
+			assoc    []common.Association
+			resolved = getRelationship(relationships, assocObj)
+		)
+
+		switch resolved.kind {
+		case relationshipParent:
+			assoc = extractParentAssociation(recordMap, resolved)
+		case relationshipJunction:
+			assoc = extractJunctionAssociation(recordMap, resolved.name, resolved.field)
+		case relationshipChild:
+			assoc = extractChildAssociation(recordMap, resolved.name)
+		}
+
+		for index := range assoc {
+			assoc[index].AssociationType = resolved.name
 		}
 
 		if len(assoc) > 0 {
@@ -93,10 +107,8 @@ func extractAssociations(recordMap common.StringMap, config common.ReadParams) m
 }
 
 // extractParentAssociation extracts a parent relationship association.
-func extractParentAssociation(recordMap common.StringMap, objectName, assocObj string) []common.Association {
-	parentField := getParentFieldName(objectName, assocObj)
-	parentValue, found := recordMap.GetCaseInsensitive(parentField)
-
+func extractParentAssociation(recordMap common.StringMap, assoc relationship) []common.Association {
+	parentValue, found := recordMap.GetCaseInsensitive(assoc.field)
 	if !found {
 		return nil
 	}
@@ -106,6 +118,11 @@ func extractParentAssociation(recordMap common.StringMap, objectName, assocObj s
 		return nil
 	}
 
+	if assoc.targetType != "" && !strings.EqualFold(getReferencedType(recordMap, assoc.name), assoc.targetType) {
+		// Polymorphic lookup references a record of another object.
+		return nil
+	}
+
 	// Create association with empty Raw - workflow layer will fetch it
 	return []common.Association{
 		{
@@ -115,6 +132,25 @@ func extractParentAssociation(recordMap common.StringMap, objectName, assocObj s
 	}
 }
 
+// getReferencedType returns the type of the record referenced by a polymorphic lookup.
+// The response nests it under the relationship name, ex: "Who": {"Type": "Contact"}.
+func getReferencedType(recordMap common.StringMap, relationshipName string) string {
+	referenced, found := recordMap.GetCaseInsensitive(relationshipName)
+	if !found {
+		return ""
+	}
+
+	referencedMap, ok := referenced.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	referencedType, _ := common.ToStringMap(referencedMap).GetCaseInsensitive("Type")
+	typeStr, _ := referencedType.(string)
+
+	return typeStr
+}
+
 // extractChildAssociation extracts a child relationship association.
 // In Salesforce, the associated object is a key in the record map.
 // For example, "Contacts" will be a key in the record map, with an array of associated contacts.
@@ -12,40 +12,6 @@ import (
 
 const defaultSOQLPageSize = 2000
 
-// This helps us identify if we can use a SOQL subquery to get an associated object, since SOQL subqueries
-// only work for child objects.
-func getParentFieldMap() map[string]map[string]string {
-	return map[string]map[string]string{
-		"opportunity": {
-			"accounts": "AccountId",
-		},
-	}
-}
-
-func isParentRelationship(objectName, associatedObject string) bool {
-	parentFieldMap := getParentFieldMap()
-
-	objMap, ok := parentFieldMap[strings.ToLower(objectName)]
-	if !ok {
-		return false
-	}
-
-	_, ok = objMap[strings.ToLower(associatedObject)]
-
-	return ok
-}
-
-func getParentFieldName(objectName, associatedObject string) string {
-	parentFieldMap := getParentFieldMap()
-
-	objMap, ok := parentFieldMap[strings.ToLower(objectName)]
-	if !ok {
-		return ""
-	}
-
-	return objMap[strings.ToLower(associatedObject)]
-}
-
 // containsField checks if a field exists in the fields list (case-insensitive).
 // e.g. containsField(["Id", "Name", "AccountId"], "accountid") -> true.
 func containsField(fields []string, fieldName string) bool {
@@ -59,45 +25,6 @@ func containsField(fields []string, fieldName string) bool {
 	return false
 }
 
-// junctionRelationshipMapping defines the relationship name and related ID field for junction relationships.
-type junctionRelationshipMapping struct {
-	RelationshipName string // e.g., "OpportunityContactRoles"
-	RelatedIdField   string // e.g., "ContactId"
-}
-
-// getJunctionRelationshipMap returns the junction relationship map.
-// This is used for junction objects where we query a child relationship but extract a related object ID.
-// e.g., For Opportunity -> contacts, we query OpportunityContactRoles but extract ContactId.
-func getJunctionRelationshipMap() map[string]map[string]junctionRelationshipMapping {
-	return map[string]map[string]junctionRelationshipMapping{
-		"opportunity": {
-			"contacts": {
-				RelationshipName: "OpportunityContactRoles",
-				RelatedIdField:   "ContactId",
-			},
-		},
-	}
-}
-
-// getJunctionRelationship returns the relationship name and related ID field for junction relationships.
-// Returns ok=false if this is not a junction relationship.
-func getJunctionRelationship(objectName, associatedObject string) (relationshipName, relatedIdField string, ok bool) {
-	junctionRelationshipMap := getJunctionRelationshipMap()
-
-	objMap, found := junctionRelationshipMap[strings.ToLower(objectName)]
-
-	if !found {
-		return "", "", false
-	}
-
-	mapping, found := objMap[strings.ToLower(associatedObject)]
-	if !found {
-		return "", "", false
-	}
-
-	return mapping.RelationshipName, mapping.RelatedIdField, true
-}
-
 // Read reads data from Salesforce. By default, it will read all rows (backfill). However, if Since is set,
 // it will read only rows that have been updated since the specified time.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
@@ -109,7 +36,12 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		return c.pardotAdapter.Read(ctx, config)
 	}
 
-	url, err := c.buildReadURL(config)
+	relationships, err := c.resolveRelationships(ctx, config.ObjectName, config.AssociatedObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := c.buildReadURL(config, relationships)
 	if err != nil {
 		return nil, err
 	}
@@ -123,12 +55,14 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		rsp,
 		getRecords,
 		getNextRecordsURL,
-		getSalesforceDataMarshaller(config),
+		getSalesforceDataMarshaller(config, relationships),
 		config.Fields,
 	)
 }
 
-func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, error) {
+func (c *Connector) buildReadURL(
+	config common.ReadParams, relationships map[string]relationship,
+) (*urlbuilder.URL, error) {
 	if len(config.NextPage) != 0 {
 		// If NextPage is set, then we're reading the next page of results.
 		// All that matters is the NextPage URL, the fields are ignored.
@@ -142,14 +76,15 @@ func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, err
 		return nil, err
 	}
 
-	url.WithQueryParam("q", makeSOQL(config).String())
+	url.WithQueryParam("q", makeSOQL(config, relationships).String())
 
 	return url, nil
 }
 
 // makeSOQL returns the SOQL query for the desired read operation.
-func makeSOQL(config common.ReadParams) *core.SOQLBuilder {
-	fields := addAssociationFields(config)
+// Associated objects missing from relationships are queried as child relationships.
+func makeSOQL(config common.ReadParams, relationships map[string]relationship) *core.SOQLBuilder {
+	fields := addAssociationFields(config, relationships)
 	soql := (&core.SOQLBuilder{}).SelectFields(fields).From(config.ObjectName)
 	addWhereClauses(soql, config)
 
@@ -157,50 +92,48 @@ func makeSOQL(config common.ReadParams) *core.SOQLBuilder {
 }
 
 // addAssociationFields adds fields for associated objects to the fields list.
-func addAssociationFields(config common.ReadParams) []string {
+func addAssociationFields(config common.ReadParams, relationships map[string]relationship) []string {
 	fields := config.Fields.List()
 
-	if config.AssociatedObjects == nil {
-		return fields
-	}
-
 	for _, obj := range config.AssociatedObjects {
-		fields = addFieldForAssociation(fields, config.ObjectName, obj)
+		fields = addFieldForAssociation(fields, getRelationship(relationships, obj))
 	}
 
 	return fields
 }
 
+func getRelationship(relationships map[string]relationship, associatedObject string) relationship {
+	if resolved, ok := relationships[associatedObject]; ok {
+		return resolved
+	}
+
+	return childRelationship(associatedObject)
+}
+
 // addFieldForAssociation adds a field or subquery for an associated object.
-func addFieldForAssociation(fields []string, objectName, assocObj string) []string {
-	// Some objects cannot be queried using a subquery, such as when the associated object is a parent object.
-	// In that case, we fetch the associated object's ID as a field, and fetch the full object in the q
-	if isParentRelationship(objectName, assocObj) {
-		parentField := getParentFieldName(objectName, assocObj)
-		if parentField != "" && !containsField(fields, parentField) {
-			fields = append(fields, parentField)
+func addFieldForAssociation(fields []string, assoc relationship) []string {
+	switch assoc.kind {
+	case relationshipParent:
+		// Parent objects cannot be queried using a subquery.
+		// In that case, we fetch the associated object's ID as a field.
+		if !containsField(fields, assoc.field) {
+			fields = append(fields, assoc.field)
 		}
 
-		return fields
-	}
-
-	// Check for junction relationship (child relationship that maps to a different object)
-	relationshipName, _, isJunction := getJunctionRelationship(objectName, assocObj)
-	if isJunction {
-		// Use the mapped relationship name for SOQL subquery
-		// e.g., (SELECT FIELDS(STANDARD) FROM OpportunityContactRoles)
-		fields = append(fields, "(SELECT FIELDS(STANDARD) FROM "+relationshipName+")")
+		// Polymorphic lookups tell the type of the referenced record, ex: Who.Type.
+		if typeField := assoc.name + ".Type"; assoc.targetType != "" && !containsField(fields, typeField) {
+			fields = append(fields, typeField)
+		}
 
+		return fields
+	case relationshipJunction, relationshipChild:
+		// Generates subqueries like: (SELECT FIELDS(STANDARD) FROM Contacts)
+		// Just standard fields for now, because salesforce errors out > 200 fields on an object.
+		// Source: https://www.infallibletechie.com/2023/04/parent-child-records-in-salesforce-soql-using-rest-api.html
+		return append(fields, "(SELECT FIELDS(STANDARD) FROM "+assoc.name+")")
+	default:
 		return fields
 	}
-
-	// Standard child relationship
-	// Generates subqueries like: (SELECT FIELDS(STANDARD) FROM Contacts)
-	// Just standard fields for now, because salesforce errors out > 200 fields on an object.
-	// Source: https://www.infallibletechie.com/2023/04/parent-child-records-in-salesforce-soql-using-rest-api.html
-	fields = append(fields, "(SELECT FIELDS(STANDARD) FROM "+assocObj+")")
-
-	return fields
 }
 
 // addWhereClauses adds WHERE clauses to the SOQL query based on the config.
@@ -27,6 +27,9 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 	responseListContacts := testutils.DataFromFile(t, "read-list-contacts.json")
 	responseOpportunityWithAccount := testutils.DataFromFile(t, "read-opportunity-with-account.json")
 	responseOpportunityWithContacts := testutils.DataFromFile(t, "read-opportunity-with-contacts.json")
+	responseTasksWithWho := testutils.DataFromFile(t, "read-tasks-with-who.json")
+	describeOpportunity := testutils.DataFromFile(t, "describe/opportunity.json")
+	describeTask := testutils.DataFromFile(t, "describe/task.json")
 
 	tests := []testroutines.Read{
 		{
@@ -149,17 +152,23 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 				Fields:            connectors.Fields("Name", "Amount", "StageName"),
 				AssociatedObjects: []string{"accounts"},
 			},
-			Server: mockserver.Conditional{
+			Server: mockserver.Switch{
 				Setup: mockserver.ContentJSON(),
-				If: mockcond.And{
-					mockcond.Path("/services/data/v60.0/query"),
-					mockcond.Permute(
-						// AccountId should be added to the query, order may vary.
-						queryParam("SELECT %v FROM opportunity"),
-						"Name", "Amount", "StageName", "AccountId",
-					),
-				},
-				Then: mockserver.Response(http.StatusOK, responseOpportunityWithAccount),
+				Cases: []mockserver.Case{{
+					// Relationship is resolved from describe data.
+					If:   mockcond.Path("/services/data/v60.0/sobjects/opportunity/describe"),
+					Then: mockserver.Response(http.StatusOK, describeOpportunity),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/services/data/v60.0/query"),
+						mockcond.Permute(
+							// AccountId should be added to the query, order may vary.
+							queryParam("SELECT %v FROM opportunity"),
+							"Name", "Amount", "StageName", "AccountId",
+						),
+					},
+					Then: mockserver.Response(http.StatusOK, responseOpportunityWithAccount),
+				}},
 			}.Server(),
 			Comparator: comparatorSubsetReadWithAssociations,
 			Expected: &common.ReadResult{
@@ -175,8 +184,9 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 						Associations: map[string][]common.Association{
 							"accounts": {
 								{
-									ObjectId: "001ak00000OKNPHAA5",
-									Raw:      nil, // Parent relationships have empty Raw - workflow layer will fetch
+									ObjectId:        "001ak00000OKNPHAA5",
+									AssociationType: "Account",
+									Raw:             nil, // Parent relationships have empty Raw - workflow layer will fetch
 								},
 							},
 						},
@@ -295,6 +305,53 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Read Task with Contacts association via polymorphic lookup - leads are skipped",
+			Input: common.ReadParams{
+				ObjectName:        "Task",
+				Fields:            connectors.Fields("Subject"),
+				AssociatedObjects: []string{"contacts"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.Path("/services/data/v60.0/sobjects/Task/describe"),
+					Then: mockserver.Response(http.StatusOK, describeTask),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/services/data/v60.0/query"),
+						mockcond.Permute(
+							queryParam("SELECT %v FROM Task"),
+							"Subject", "WhoId", "Who.Type",
+						),
+					},
+					Then: mockserver.Response(http.StatusOK, responseTasksWithWho),
+				}},
+			}.Server(),
+			Comparator: comparatorSubsetReadWithAssociations,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Id:     "00Tak00000B1xQ2EAJ",
+					Fields: map[string]any{"subject": "Call"},
+					Associations: map[string][]common.Association{
+						"contacts": {{
+							ObjectId:        "003ak000003dQCGAA2",
+							AssociationType: "Who",
+						}},
+					},
+					Raw: map[string]any{"Id": "00Tak00000B1xQ2EAJ"},
+				}, {
+					Id:           "00Tak00000B1xQ3EAJ",
+					Fields:       map[string]any{"subject": "Email"},
+					Associations: nil,
+					Raw:          map[string]any{"Id": "00Tak00000B1xQ3EAJ"},
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -383,6 +440,10 @@ func validateSingleAssociation(actualAssoc, expectedAssoc common.Association) bo
 		return false
 	}
 
+	if expectedAssoc.AssociationType != "" && actualAssoc.AssociationType != expectedAssoc.AssociationType {
+		return false
+	}
+
 	// Check Raw - if expected is nil, actual should be nil
 	if expectedAssoc.Raw == nil && actualAssoc.Raw != nil {
 		return false
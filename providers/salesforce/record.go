@@ -30,7 +30,12 @@ func (c *Connector) GetRecordsByIds( // nolint:revive
 		return nil, err
 	}
 
-	url, err := c.buildReadByIdentifierURL(config)
+	relationships, err := c.resolveRelationships(ctx, objectName, associations)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := c.buildReadByIdentifierURL(config, relationships)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +49,7 @@ func (c *Connector) GetRecordsByIds( // nolint:revive
 		rsp,
 		getRecords,
 		getNextRecordsURL,
-		getSalesforceDataMarshaller(config.ReadParams),
+		getSalesforceDataMarshaller(config.ReadParams, relationships),
 		config.Fields,
 	)
 	if err != nil {
@@ -60,14 +65,16 @@ type recordsByIDsParams struct {
 	RecordIdentifiers datautils.Set[string]
 }
 
-func (c *Connector) buildReadByIdentifierURL(config recordsByIDsParams) (*urlbuilder.URL, error) {
+func (c *Connector) buildReadByIdentifierURL(
+	config recordsByIDsParams, relationships map[string]relationship,
+) (*urlbuilder.URL, error) {
 	// Requesting record identifiers using SOQL query.
 	url, err := c.getRestApiURL("query")
 	if err != nil {
 		return nil, err
 	}
 
-	query := makeSOQL(config.ReadParams).
+	query := makeSOQL(config.ReadParams, relationships).
 		WithIDs(config.RecordIdentifiers.List()).
 		String()
 
@@ -0,0 +1,243 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
+)
+
+// relationshipKind tells how an associated object is reached from the object being read.
+type relationshipKind int
+
+const (
+	// relationshipChild is queried with a subquery, associated records are nested in the response.
+	relationshipChild relationshipKind = iota
+	// relationshipParent is a lookup field, only the identifier of the associated record is in the response.
+	relationshipParent
+	// relationshipJunction is queried with a subquery of a junction object,
+	// identifiers of associated records are taken from the junction records.
+	relationshipJunction
+)
+
+// relationship describes how to read an associated object along with the object.
+type relationship struct {
+	kind relationshipKind
+	// name is the relationship name.
+	// Child and junction relationships name the subquery, ex: Account has "Contacts".
+	// Parent relationships name the referenced record, ex: Opportunity has "Account", Task has "Who".
+	name string
+	// field holds the identifier of the associated record.
+	// It is the lookup field for parent relationships, ex: "AccountId",
+	// and the field of the junction record for junction relationships, ex: "ContactId".
+	field string
+	// targetType narrows a polymorphic lookup to one object, ex: "Contact" for WhoId of Task.
+	// Empty when every referenced object is accepted.
+	targetType string
+}
+
+// childRelationship is used for objects which cannot be resolved, the name is queried as is.
+func childRelationship(name string) relationship {
+	return relationship{
+		kind: relationshipChild,
+		name: name,
+	}
+}
+
+// junctionRelationshipMapping defines the relationship name and related ID field for junction relationships.
+type junctionRelationshipMapping struct {
+	RelationshipName string // e.g., "OpportunityContactRoles"
+	RelatedIdField   string // e.g., "ContactId"
+}
+
+// getJunctionRelationshipMap returns the junction relationship map.
+// This is used for junction objects where we query a child relationship but extract a related object ID.
+// e.g., For Opportunity -> contacts, we query OpportunityContactRoles but extract ContactId.
+// Describe data doesn't link objects through junctions, therefore they are listed explicitly.
+func getJunctionRelationshipMap() map[string]map[string]junctionRelationshipMapping {
+	return map[string]map[string]junctionRelationshipMapping{
+		"opportunity": {
+			"contacts": {
+				RelationshipName: "OpportunityContactRoles",
+				RelatedIdField:   "ContactId",
+			},
+		},
+	}
+}
+
+// getJunctionRelationship returns the junction relationship of the associated object.
+func getJunctionRelationship(objectName, associatedObject string) (relationship, bool) {
+	objMap, found := getJunctionRelationshipMap()[strings.ToLower(objectName)]
+	if !found {
+		return relationship{}, false
+	}
+
+	mapping, found := objMap[strings.ToLower(associatedObject)]
+	if !found {
+		return relationship{}, false
+	}
+
+	return relationship{
+		kind:  relationshipJunction,
+		name:  mapping.RelationshipName,
+		field: mapping.RelatedIdField,
+	}, true
+}
+
+// resolveRelationship finds how the associated object relates to the described object.
+// The associated object can be named by the relationship, the lookup field or the object itself
+// in singular or plural form, ex: "Contacts", "AccountId", "account", "contacts".
+func (r describeSObjectResult) resolveRelationship(associatedObject string) (relationship, bool) {
+	// Relationship and field names are exact matches.
+	for _, child := range r.ChildRelationships {
+		if child.RelationshipName != "" && strings.EqualFold(child.RelationshipName, associatedObject) {
+			return relationship{kind: relationshipChild, name: child.RelationshipName}, true
+		}
+	}
+
+	for _, field := range r.lookupFields() {
+		if strings.EqualFold(field.RelationshipName, associatedObject) || strings.EqualFold(field.Name, associatedObject) {
+			return relationship{kind: relationshipParent, name: field.RelationshipName, field: field.Name}, true
+		}
+	}
+
+	// Object names may differ in plurality.
+	for _, child := range r.ChildRelationships {
+		if child.RelationshipName != "" && naming.PluralityAndCaseIgnoreEqual(child.ChildSObject, associatedObject) {
+			return relationship{kind: relationshipChild, name: child.RelationshipName}, true
+		}
+	}
+
+	for _, field := range r.lookupFields() {
+		for _, target := range field.ReferenceTo {
+			if !naming.PluralityAndCaseIgnoreEqual(target, associatedObject) {
+				continue
+			}
+
+			resolved := relationship{kind: relationshipParent, name: field.RelationshipName, field: field.Name}
+			if field.PolymorphicForeignKey || len(field.ReferenceTo) > 1 {
+				resolved.targetType = target
+			}
+
+			return resolved, true
+		}
+	}
+
+	return relationship{}, false
+}
+
+func (r describeSObjectResult) lookupFields() []fieldResult {
+	fields := make([]fieldResult, 0)
+
+	for _, field := range r.Fields {
+		if field.Type == "reference" && field.RelationshipName != "" {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// describeCache remembers describe results of objects for the lifetime of the connector.
+// Relationships between objects rarely change, describing them on every read would be wasteful.
+type describeCache struct {
+	mu      sync.Mutex
+	objects map[string]*describeSObjectResult
+}
+
+func newDescribeCache() *describeCache {
+	return &describeCache{
+		objects: make(map[string]*describeSObjectResult),
+	}
+}
+
+func (c *describeCache) get(objectName string) (*describeSObjectResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result, ok := c.objects[strings.ToLower(objectName)]
+
+	return result, ok
+}
+
+func (c *describeCache) set(objectName string, result *describeSObjectResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.objects[strings.ToLower(objectName)] = result
+}
+
+// resolveRelationships returns relationships of associated objects keyed by the requested name.
+// The object is described only when some of associated objects are not junctions.
+// Associated objects missing from describe data are queried as child relationships named as requested.
+func (c *Connector) resolveRelationships(
+	ctx context.Context, objectName string, associatedObjects []string,
+) (map[string]relationship, error) {
+	if len(associatedObjects) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+
+	relationships := make(map[string]relationship, len(associatedObjects))
+
+	var describe *describeSObjectResult
+
+	for _, associatedObject := range associatedObjects {
+		if junction, ok := getJunctionRelationship(objectName, associatedObject); ok {
+			relationships[associatedObject] = junction
+
+			continue
+		}
+
+		if describe == nil {
+			var err error
+
+			describe, err = c.describeObject(ctx, objectName)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resolved, ok := describe.resolveRelationship(associatedObject)
+		if !ok {
+			resolved = childRelationship(associatedObject)
+		}
+
+		relationships[associatedObject] = resolved
+	}
+
+	return relationships, nil
+}
+
+// describeObject returns cached describe data of the object.
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_sobject_describe.htm
+func (c *Connector) describeObject(ctx context.Context, objectName string) (*describeSObjectResult, error) {
+	if result, ok := c.describeCache.get(objectName); ok {
+		return result, nil
+	}
+
+	url, err := c.getRestApiURL("sobjects", objectName, "describe")
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, fmt.Errorf("error describing relationships of %s: %w", objectName, err)
+	}
+
+	result, err := common.UnmarshalJSON[describeSObjectResult](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, fmt.Errorf("%w: describe of %s", common.ErrEmptyJSONHTTPResponse, objectName)
+	}
+
+	c.describeCache.set(objectName, result)
+
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"gotest.tools/v3/assert"
+)
+
+func TestResolveRelationship(t *testing.T) {
+	t.Parallel()
+
+	var opportunity, task describeSObjectResult
+
+	assert.NilError(t, json.Unmarshal(testutils.DataFromFile(t, "describe/opportunity.json"), &opportunity))
+	assert.NilError(t, json.Unmarshal(testutils.DataFromFile(t, "describe/task.json"), &task))
+
+	tests := []struct {
+		name     string
+		describe describeSObjectResult
+		input    string
+		expected relationship
+		found    bool
+	}{
+		{
+			name:     "Parent object in plural form",
+			describe: opportunity,
+			input:    "accounts",
+			expected: relationship{kind: relationshipParent, name: "Account", field: "AccountId"},
+			found:    true,
+		},
+		{
+			name:     "Parent relationship name",
+			describe: opportunity,
+			input:    "owner",
+			expected: relationship{kind: relationshipParent, name: "Owner", field: "OwnerId"},
+			found:    true,
+		},
+		{
+			name:     "Child object in singular form",
+			describe: opportunity,
+			input:    "OpportunityLineItem",
+			expected: relationship{kind: relationshipChild, name: "OpportunityLineItems"},
+			found:    true,
+		},
+		{
+			name:     "Child relationship name",
+			describe: opportunity,
+			input:    "opportunitylineitems",
+			expected: relationship{kind: relationshipChild, name: "OpportunityLineItems"},
+			found:    true,
+		},
+		{
+			name:     "Child relationship without name cannot be queried",
+			describe: opportunity,
+			input:    "OpportunityFeed",
+			found:    false,
+		},
+		{
+			name:     "Polymorphic lookup narrowed to the object",
+			describe: task,
+			input:    "leads",
+			expected: relationship{kind: relationshipParent, name: "Who", field: "WhoId", targetType: "Lead"},
+			found:    true,
+		},
+		{
+			name:     "Polymorphic lookup field accepts every object",
+			describe: task,
+			input:    "WhatId",
+			expected: relationship{kind: relationshipParent, name: "What", field: "WhatId"},
+			found:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, found := tt.describe.resolveRelationship(tt.input)
+			assert.Equal(t, found, tt.found)
+			assert.Equal(t, output, tt.expected)
+		})
+	}
+}
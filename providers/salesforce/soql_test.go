@@ -17,7 +17,7 @@ func TestSoqlBuilderWithIDs(t *testing.T) {
 		// Note: fields doesn't preserve order of elements.
 		// To simplify test only one element is included.
 		Fields: datautils.NewSet("shippingstreet"),
-	})
+	}, nil)
 
 	{
 		// SOQL builder must produce query matching documentation.
@@ -52,6 +52,8 @@ func TestSoqlBuilderWithParentAssociation(t *testing.T) {
 		ObjectName:        "opportunity",
 		Fields:            datautils.NewSet("Name", "Amount"),
 		AssociatedObjects: []string{"accounts"},
+	}, map[string]relationship{
+		"accounts": {kind: relationshipParent, name: "Account", field: "AccountId"},
 	})
 
 	output := soql.String()
@@ -69,11 +71,14 @@ func TestSoqlBuilderWithJunctionAssociation(t *testing.T) {
 
 	// Test that OpportunityContactRoles subquery is added to SOQL when contacts is requested
 	// as association for Opportunity
+	junction, ok := getJunctionRelationship("opportunity", "contacts")
+	assert.Assert(t, ok, "Opportunity has junction relationship with contacts")
+
 	soql := makeSOQL(common.ReadParams{
 		ObjectName:        "opportunity",
 		Fields:            datautils.NewSet("Name", "Amount"),
 		AssociatedObjects: []string{"contacts"},
-	})
+	}, map[string]relationship{"contacts": junction})
 
 	output := soql.String()
 	// OpportunityContactRoles subquery should be included in the SELECT clause
@@ -84,6 +89,23 @@ func TestSoqlBuilderWithJunctionAssociation(t *testing.T) {
 	assert.Assert(t, strings.Contains(output, "FROM opportunity"), "FROM clause should be present")
 }
 
+func TestSoqlBuilderWithPolymorphicAssociation(t *testing.T) {
+	t.Parallel()
+
+	// The type of the referenced record is selected to tell contacts from leads.
+	soql := makeSOQL(common.ReadParams{
+		ObjectName:        "Task",
+		Fields:            datautils.NewSet("Subject"),
+		AssociatedObjects: []string{"contacts"},
+	}, map[string]relationship{
+		"contacts": {kind: relationshipParent, name: "Who", field: "WhoId", targetType: "Contact"},
+	})
+
+	output := soql.String()
+	assert.Assert(t, containsFieldInSOQL(output, "WhoId"), "WhoId should be in SOQL query")
+	assert.Assert(t, containsFieldInSOQL(output, "Who.Type"), "Who.Type should be in SOQL query")
+}
+
 // containsFieldInSOQL checks if a field name appears in the SOQL SELECT clause.
 func containsFieldInSOQL(soql, fieldName string) bool {
 	// Simple check: look for the field name in the SELECT clause
@@ -5,6 +5,7 @@ import (
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/internal/jsonquery"
+	crmcore "github.com/amp-labs/connectors/providers/salesforce/internal/crm/core"
 	"github.com/spyzhov/ajson"
 )
 
@@ -41,7 +42,12 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 
 	headers := common.TransformWriteHeaders(config.Headers, common.HeaderModeOverwrite)
 
-	rsp, err := c.Client.Post(ctx, url.String(), config.RecordData, headers...)
+	recordData, err := applyLookupAssociations(config.RecordData, config.Associations)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Post(ctx, url.String(), recordData, headers...)
 	if err != nil {
 		return nil, err
 	}
@@ -105,3 +111,16 @@ func getErrors(node *ajson.Node) ([]any, error) {
 
 	return objects, nil
 }
+
+func applyLookupAssociations(recordData any, associations []common.AssociationInput) (any, error) {
+	if len(associations) == 0 {
+		return recordData, nil
+	}
+
+	record, err := common.RecordDataToMap(recordData)
+	if err != nil {
+		return nil, err
+	}
+
+	return crmcore.ApplyLookupAssociations(record, associations)
+}
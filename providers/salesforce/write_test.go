@@ -112,6 +112,43 @@ func TestWrite(t *testing.T) { // nolint:funlen,cyclop
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Associations set lookup fields",
+			Input: common.WriteParams{
+				ObjectName: "contact",
+				RecordData: map[string]any{"LastName": "Doe"},
+				Associations: []common.AssociationInput{{
+					TargetObject:    "account",
+					TargetId:        "001ak00000OQTieAAH",
+					AssociationType: "AccountId",
+				}},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Body(`{"LastName":"Doe","AccountId":"001ak00000OQTieAAH"}`),
+				},
+				Then: mockserver.Response(http.StatusOK, responseCreateOK),
+			}.Server(),
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "001ak00000OQTieAAH",
+				Errors:   []any{},
+				Data:     nil,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Association requires lookup field",
+			Input: common.WriteParams{
+				ObjectName:   "contact",
+				RecordData:   map[string]any{"LastName": "Doe"},
+				Associations: []common.AssociationInput{{TargetObject: "account", TargetId: "001ak00000OQTieAAH"}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrInvalidAssociationInput},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,7 +1,13 @@
 package providers
 
+import "github.com/amp-labs/connectors/common"
+
 const Slack Provider = "slack"
 
+// ModuleSlackSCIM is the SCIM 2.0 API managing users and groups of an organization.
+const ModuleSlackSCIM common.ModuleID = "scim"
+
+// nolint:funlen
 func init() {
 	// Slack configuration
 	SetInfo(Slack, ProviderInfo{
@@ -19,6 +25,18 @@ func init() {
 				WorkspaceRefField: "workspace_name",
 			},
 		},
+		DefaultModule: common.ModuleRoot,
+		Modules: &Modules{
+			ModuleSlackSCIM: {
+				BaseURL:     "https://api.slack.com/scim/v2",
+				DisplayName: "Slack SCIM",
+				Support: Support{
+					Read:      true,
+					Subscribe: false,
+					Write:     true,
+				},
+			},
+		},
 		Support: Support{
 			BulkWrite: BulkWriteSupport{
 				Insert: false,
@@ -0,0 +1,39 @@
+package slack
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/scim"
+	"github.com/amp-labs/connectors/providers"
+)
+
+// Connector manages users and groups through the SCIM API of Slack.
+// Operations are delegated to the SCIM adapter.
+type Connector struct {
+	*scim.Adapter
+
+	// Require authenticated client
+	common.RequireAuthenticatedClient
+	common.RequireModule
+}
+
+func NewConnector(params common.ConnectorParams) (*Connector, error) {
+	adapter, err := scim.NewAdapter(providers.Slack, params)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &Connector{
+		Adapter: adapter,
+		RequireModule: common.RequireModule{
+			ExpectedModules: []common.ModuleID{
+				providers.ModuleSlackSCIM,
+			},
+		},
+	}
+
+	if err = common.ValidateParameters(conn, params); err != nil {
+		return nil, err
+	}
+
+	return conn, nil
+}
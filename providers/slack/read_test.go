@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestRead(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseUsers := []byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+		"totalResults": 1,
+		"itemsPerPage": 1,
+		"startIndex": 1,
+		"Resources": [{
+			"id": "W1234567890",
+			"userName": "jane@example.com",
+			"active": true
+		}]
+	}`)
+
+	tests := []testroutines.Read{
+		{
+			Name:         "Read object must be included",
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjects},
+		},
+		{
+			Name: "Users are read from the SCIM API",
+			Input: common.ReadParams{
+				ObjectName: "Users",
+				Fields:     connectors.Fields("userName"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/scim/v2/Users"),
+				Then:  mockserver.Response(http.StatusOK, responseUsers),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetRead,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"username": "jane@example.com",
+					},
+					Raw: map[string]any{
+						"active": true,
+					},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestNewConnectorRequiresSCIMModule(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewConnector(common.ConnectorParams{
+		AuthenticatedClient: mockutils.NewClient(),
+		Module:              common.ModuleRoot,
+	})
+	if err == nil {
+		t.Fatal("expected an error for the root module")
+	}
+}
+
+func constructTestConnector(serverURL string) (*Connector, error) {
+	connector, err := NewConnector(common.ConnectorParams{
+		Module:              providers.ModuleSlackSCIM,
+		AuthenticatedClient: mockutils.NewClient(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	connector.SetUnitTestBaseURL(mockutils.ReplaceURLOrigin(connector.ModuleInfo().BaseURL, serverURL))
+
+	return connector, nil
+}
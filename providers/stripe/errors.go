@@ -1,6 +1,7 @@
 package stripe
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -8,6 +9,16 @@ import (
 	"github.com/amp-labs/connectors/common/interpreter"
 )
 
+var (
+	errInvalidRequestType   = errors.New("invalid request type")
+	errMissingParams        = errors.New("missing required parameters")
+	errUnsupportedEventType = errors.New("unsupported event type")
+	errUnsupportedObject    = errors.New("object doesn't support webhook events")
+
+	ErrMissingSignature = errors.New("missing webhook signature header")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
 var errorFormats = interpreter.NewFormatSwitch( // nolint:gochecknoglobals
 	[]interpreter.FormatTemplate{
 		{
@@ -0,0 +1,318 @@
+package stripe
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/jsonquery"
+	"github.com/spyzhov/ajson"
+)
+
+// Stripe keeps events only for the last 30 days.
+// https://docs.stripe.com/api/events/list
+const eventRetentionPeriod = 30 * 24 * time.Hour
+
+// changeEventSource describes how changes of an object are published to the event log.
+type changeEventSource struct {
+	// typePrefix is the beginning of event names, ex: "customer.subscription" for "customer.subscription.updated".
+	typePrefix string
+	// objectKind is the "object" property of the snapshot attached to the event.
+	// Wildcard "customer.*" also matches events about nested resources, such as "customer.tax_id.created",
+	// those are filtered out by the kind.
+	objectKind string
+}
+
+// changeEventSources lists objects that are read incrementally via the event log.
+// nolint:lll
+// https://docs.stripe.com/api/events/types
+var changeEventSources = map[string]changeEventSource{ // nolint:gochecknoglobals
+	"accounts":               {typePrefix: "account", objectKind: "account"},
+	"charges":                {typePrefix: "charge", objectKind: "charge"},
+	"checkout/sessions":      {typePrefix: "checkout.session", objectKind: "checkout.session"},
+	"coupons":                {typePrefix: "coupon", objectKind: "coupon"},
+	"credit_notes":           {typePrefix: "credit_note", objectKind: "credit_note"},
+	"customers":              {typePrefix: "customer", objectKind: "customer"},
+	"disputes":               {typePrefix: "charge.dispute", objectKind: "dispute"},
+	"invoiceitems":           {typePrefix: "invoiceitem", objectKind: "invoiceitem"},
+	"invoices":               {typePrefix: "invoice", objectKind: "invoice"},
+	"payment_intents":        {typePrefix: "payment_intent", objectKind: "payment_intent"},
+	"payouts":                {typePrefix: "payout", objectKind: "payout"},
+	"plans":                  {typePrefix: "plan", objectKind: "plan"},
+	"prices":                 {typePrefix: "price", objectKind: "price"},
+	"products":               {typePrefix: "product", objectKind: "product"},
+	"promotion_codes":        {typePrefix: "promotion_code", objectKind: "promotion_code"},
+	"refunds":                {typePrefix: "refund", objectKind: "refund"},
+	"setup_intents":          {typePrefix: "setup_intent", objectKind: "setup_intent"},
+	"subscription_schedules": {typePrefix: "subscription_schedule", objectKind: "subscription_schedule"},
+	"subscriptions":          {typePrefix: "customer.subscription", objectKind: "subscription"},
+	"tax_rates":              {typePrefix: "tax_rate", objectKind: "tax_rate"},
+	"topups":                 {typePrefix: "topup", objectKind: "topup"},
+	"transfers":              {typePrefix: "transfer", objectKind: "transfer"},
+}
+
+// eventWindow is a range of event creation times in unix seconds, both ends are inclusive.
+type eventWindow struct {
+	from int64
+	to   int64
+}
+
+// readFromEvents tells if the read can be served from the event log.
+// The first page of an incremental read is eligible when the window is within event retention.
+// Next pages continue the event log when the previous page was read from it.
+func readFromEvents(config common.ReadParams) (changeEventSource, eventWindow, bool) {
+	source, ok := changeEventSources[config.ObjectName]
+	if !ok {
+		return changeEventSource{}, eventWindow{}, false
+	}
+
+	if len(config.NextPage) != 0 {
+		window, ok := parseEventsPage(config.NextPage.String())
+
+		return source, window, ok
+	}
+
+	if config.Since.IsZero() || time.Since(config.Since) >= eventRetentionPeriod {
+		return changeEventSource{}, eventWindow{}, false
+	}
+
+	// The end of the window is fixed, so that next pages describe the same period.
+	until := config.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+
+	return source, eventWindow{from: config.Since.Unix(), to: until.Unix()}, true
+}
+
+// parseEventsPage restores the remaining window from the next page URL produced by readChanges.
+func parseEventsPage(nextPage string) (eventWindow, bool) {
+	link, err := url.Parse(nextPage)
+	if err != nil || !strings.HasSuffix(link.Path, "/events") {
+		return eventWindow{}, false
+	}
+
+	from, err := strconv.ParseInt(link.Query().Get("created[gte]"), 10, 64)
+	if err != nil {
+		return eventWindow{}, false
+	}
+
+	to, err := strconv.ParseInt(link.Query().Get("created[lte]"), 10, 64)
+	if err != nil {
+		return eventWindow{}, false
+	}
+
+	return eventWindow{from: from, to: to}, true
+}
+
+// readChanges replays the event log of the window and returns the latest state of every changed object.
+//
+// Events are listed newest first, while rows must be returned oldest first across pages,
+// otherwise a later page would carry a stale snapshot of an object already returned.
+// Therefore, the window is halved until its events fit a single page, that page is returned,
+// and the next page continues with the rest of the window. Events of a single second are paged through at once.
+// Objects that have "*.deleted" event are reported as deleted rows,
+// fields still describe the last known state, ex: a canceled subscription.
+// Rows are ordered by the time of their latest change, oldest first.
+func (c *Connector) readChanges(
+	ctx context.Context, config common.ReadParams, source changeEventSource, window eventWindow,
+) (*common.ReadResult, error) {
+	changes := newChangeLog(source)
+	current := window
+
+	for {
+		link, err := c.buildEventsURL(source, current)
+		if err != nil {
+			return nil, err
+		}
+
+		hasMore, err := c.replayEvents(ctx, link, changes, current.from == current.to)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hasMore {
+			break
+		}
+
+		// Events don't fit a single page, the older half is read first.
+		changes = newChangeLog(source)
+		current.to = current.from + (current.to-current.from)/2
+	}
+
+	rows, err := changes.rows(config.Fields.List())
+	if err != nil {
+		return nil, err
+	}
+
+	if current.to >= window.to {
+		return &common.ReadResult{
+			Rows:     int64(len(rows)),
+			Data:     rows,
+			NextPage: "",
+			Done:     true,
+		}, nil
+	}
+
+	nextPage, err := c.buildEventsURL(source, eventWindow{from: current.to + 1, to: window.to})
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.ReadResult{
+		Rows:     int64(len(rows)),
+		Data:     rows,
+		NextPage: common.NextPageToken(nextPage.String()),
+		Done:     false,
+	}, nil
+}
+
+// replayEvents adds events of the first page to the change log and tells if there are more events.
+// When all pages are requested, events are added until the list is exhausted.
+func (c *Connector) replayEvents(
+	ctx context.Context, link *urlbuilder.URL, changes *changeLog, allPages bool,
+) (bool, error) {
+	nextPage := link.String()
+
+	for nextPage != "" {
+		res, err := c.Client.Get(ctx, nextPage)
+		if err != nil {
+			return false, err
+		}
+
+		body, ok := res.Body()
+		if !ok {
+			return false, nil
+		}
+
+		events, err := jsonquery.New(body).ArrayOptional("data")
+		if err != nil {
+			return false, err
+		}
+
+		for _, event := range events {
+			if err = changes.add(event); err != nil {
+				return false, err
+			}
+		}
+
+		nextPage, err = makeNextRecordsURL(link)(body)
+		if err != nil {
+			return false, err
+		}
+
+		if !allPages {
+			return nextPage != "", nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c *Connector) buildEventsURL(source changeEventSource, window eventWindow) (*urlbuilder.URL, error) {
+	url, err := c.getURL("events")
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("limit", strconv.Itoa(DefaultPageSize))
+	url.WithQueryParam("type", source.typePrefix+".*")
+	url.WithQueryParam("created[gte]", strconv.FormatInt(window.from, 10))
+	url.WithQueryParam("created[lte]", strconv.FormatInt(window.to, 10))
+
+	return url, nil
+}
+
+// changeLog collapses events to a single entry per object.
+type changeLog struct {
+	source changeEventSource
+	// order holds object identifiers as they were first seen, newest change first.
+	order     []string
+	snapshots map[string]*ajson.Node
+	deleted   map[string]bool
+}
+
+func newChangeLog(source changeEventSource) *changeLog {
+	return &changeLog{
+		source:    source,
+		order:     make([]string, 0),
+		snapshots: make(map[string]*ajson.Node),
+		deleted:   make(map[string]bool),
+	}
+}
+
+// add records the event, only the newest snapshot of each object is kept.
+//
+// Event example:
+//
+//	{
+//		"id": "evt_1RnN2mES6gLOjP91CWx8Kx0v",
+//		"object": "event",
+//		"type": "customer.subscription.updated",
+//		"data": {"object": {"id": "sub_1RnMzCES6gLOjP91Zr0b3sZK", "object": "subscription", ...}}
+//	}
+func (l *changeLog) add(event *ajson.Node) error {
+	eventType, err := jsonquery.New(event).StringRequired("type")
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := jsonquery.New(event, "data").ObjectRequired("object")
+	if err != nil {
+		return err
+	}
+
+	kind, err := jsonquery.New(snapshot).StrWithDefault("object", "")
+	if err != nil {
+		return err
+	}
+
+	if kind != l.source.objectKind {
+		return nil
+	}
+
+	identifier, err := jsonquery.New(snapshot).StringRequired("id")
+	if err != nil {
+		return err
+	}
+
+	if strings.HasSuffix(eventType, ".deleted") {
+		l.deleted[identifier] = true
+	}
+
+	if _, seen := l.snapshots[identifier]; seen {
+		return nil
+	}
+
+	l.order = append(l.order, identifier)
+	l.snapshots[identifier] = snapshot
+
+	return nil
+}
+
+func (l *changeLog) rows(fields []string) ([]common.ReadResultRow, error) {
+	identifiers := slices.Clone(l.order)
+	slices.Reverse(identifiers)
+
+	snapshots := make([]*ajson.Node, len(identifiers))
+	for index, identifier := range identifiers {
+		snapshots[index] = l.snapshots[identifier]
+	}
+
+	rows, err := common.MakeMarshaledDataFunc(flattenCustomFields)(snapshots, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	for index, identifier := range identifiers {
+		rows[index].Id = identifier
+		rows[index].Deleted = l.deleted[identifier]
+	}
+
+	return rows, nil
+}
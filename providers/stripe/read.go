@@ -15,7 +15,10 @@ import (
 // Read retrieves a list of items for a given object.
 // Features:
 //   - NextPage: Supported for those objects that Stripe paginates.
-//   - Incremental Reading: The `Since` parameter is not supported.
+//   - Incremental Reading: For objects published to the event log the `Since` parameter
+//     replays `/v1/events`, which catches created, updated and deleted records.
+//     When `Since` is older than 30 days of event retention, all records are read instead.
+//     Event snapshots cannot be expanded. Other objects are filtered by creation time.
 //   - AssociatedObjects: This parameter allows fetching nested objects. You need to specify list of fields to expand.
 //     For more details, refer to the Stripe documentation on expanding objects:
 //     https://docs.stripe.com/api/expanding_objects
@@ -24,6 +27,10 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		return nil, err
 	}
 
+	if source, window, ok := readFromEvents(config); ok {
+		return c.readChanges(ctx, config, source, window)
+	}
+
 	url, err := c.buildReadURL(config)
 	if err != nil {
 		return nil, err
@@ -58,7 +65,9 @@ func (c *Connector) buildReadURL(params common.ReadParams) (*urlbuilder.URL, err
 
 	url.WithQueryParam("limit", strconv.Itoa(DefaultPageSize))
 
-	if !params.Since.IsZero() && incrementalObjects.Has(params.ObjectName) {
+	// Objects tracked by events are fully read when the window exceeds event retention.
+	_, tracked := changeEventSources[params.ObjectName]
+	if !params.Since.IsZero() && incrementalObjects.Has(params.ObjectName) && !tracked {
 		url.WithQueryParam("created[gte]", strconv.FormatInt(params.Since.Unix(), 10))
 	}
 
@@ -3,6 +3,7 @@ package stripe
 import (
 	"errors"
 	"net/http"
+	"strconv"
 	"testing"
 	"time"
 
@@ -25,6 +26,15 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 	responseCustomersWithMetadata := testutils.DataFromFile(t, "read/customers/with-metadata.json")
 	responsePaymentsExpandedCustomer := testutils.DataFromFile(t, "read/payment_intents/expand_customer.json")
 	responseInvoices := testutils.DataFromFile(t, "read/invoices/incremental.json")
+	responseSubscriptionEventsCrowded := testutils.DataFromFile(t, "read/events/subscriptions-crowded.json")
+	responseSubscriptionEventsOlderHalf := testutils.DataFromFile(t, "read/events/subscriptions-older-half.json")
+	responseSubscriptionEventsNewerHalf := testutils.DataFromFile(t, "read/events/subscriptions-newer-half.json")
+
+	// Window of events spans one day, it doesn't fit a single page and is halved.
+	eventsSince := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	eventsUntil := eventsSince.Add(24 * time.Hour)
+	eventsHalf := strconv.FormatInt(eventsSince.Unix()+12*60*60, 10)
+	eventsNextHalf := strconv.FormatInt(eventsSince.Unix()+12*60*60+1, 10)
 
 	tests := []testroutines.Read{
 		{
@@ -171,7 +181,7 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			ExpectedErrs: nil,
 		},
 		{
-			Name: "Incremental read of Invoices",
+			Name: "Invoices changed before event retention period are read in full",
 			Input: common.ReadParams{
 				ObjectName: "invoices",
 				Fields:     connectors.Fields("description"),
@@ -182,7 +192,7 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 				If: mockcond.And{
 					mockcond.Path("/v1/invoices"),
 					mockcond.QueryParam("limit", "100"),
-					mockcond.QueryParam("created[gte]", "1753116395"),
+					mockcond.QueryParamsMissing("created[gte]"),
 				},
 				Then: mockserver.Response(http.StatusOK, responseInvoices),
 			}.Server(),
@@ -202,11 +212,98 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 					},
 				}},
 				NextPage: testroutines.URLTestServer + "/v1/invoices?" +
-					"created[gte]=1753116395&limit=100&starting_after=in_1RnN00ES6gLOjP91auKbmxwS",
+					"limit=100&starting_after=in_1RnN00ES6gLOjP91auKbmxwS",
+				Done: false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Subscription events are collapsed to the latest state of each subscription",
+			Input: common.ReadParams{
+				ObjectName: "subscriptions",
+				Fields:     connectors.Fields("status", "tier"),
+				Since:      eventsSince,
+				Until:      eventsUntil,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.Path("/v1/events"),
+						mockcond.QueryParam("type", "customer.subscription.*"),
+						mockcond.QueryParam("created[lte]", strconv.FormatInt(eventsUntil.Unix(), 10)),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSubscriptionEventsCrowded),
+				}, {
+					If: mockcond.And{
+						mockcond.Path("/v1/events"),
+						mockcond.QueryParam("type", "customer.subscription.*"),
+						mockcond.QueryParam("limit", "100"),
+						mockcond.QueryParam("created[gte]", strconv.FormatInt(eventsSince.Unix(), 10)),
+						mockcond.QueryParam("created[lte]", eventsHalf),
+					},
+					Then: mockserver.Response(http.StatusOK, responseSubscriptionEventsOlderHalf),
+				}},
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 3,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"status": "trialing"},
+					Raw:    map[string]any{"customer": "cus_SbijkQ1Fw0Pz9n"},
+					Id:     "sub_1RnMvZES6gLOjP91Ya7sJm1W",
+				}, {
+					Fields: map[string]any{"status": "active"},
+					Raw:    map[string]any{"customer": "cus_SbilhMtxD8vrU5"},
+					Id:     "sub_1RnMxAES6gLOjP91Dk2LpQ0a",
+				}, {
+					Fields: map[string]any{"status": "active"},
+					Raw:    map[string]any{"customer": "cus_Sbim60412VKvja"},
+					Id:     "sub_1RnMzCES6gLOjP91Zr0b3sZK",
+				}},
+				NextPage: common.NextPageToken(testroutines.URLTestServer +
+					"/v1/events?limit=100&type=customer.subscription.*" +
+					"&created[gte]=" + eventsNextHalf + "&created[lte]=" + strconv.FormatInt(eventsUntil.Unix(), 10)),
 				Done: false,
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Next page of subscription events continues with the newer half of the window",
+			Input: common.ReadParams{
+				ObjectName: "subscriptions",
+				Fields:     connectors.Fields("status", "tier"),
+				NextPage: common.NextPageToken(testroutines.URLTestServer +
+					"/v1/events?limit=100&type=customer.subscription.*" +
+					"&created[gte]=" + eventsNextHalf + "&created[lte]=" + strconv.FormatInt(eventsUntil.Unix(), 10)),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/v1/events"),
+					mockcond.QueryParam("created[gte]", eventsNextHalf),
+					mockcond.QueryParam("created[lte]", strconv.FormatInt(eventsUntil.Unix(), 10)),
+				},
+				Then: mockserver.Response(http.StatusOK, responseSubscriptionEventsNewerHalf),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"status": "past_due", "tier": "gold"},
+					Raw:    map[string]any{"customer": "cus_SbilhMtxD8vrU5"},
+					Id:     "sub_1RnMxAES6gLOjP91Dk2LpQ0a",
+				}, {
+					Fields:  map[string]any{"status": "canceled"},
+					Raw:     map[string]any{"customer": "cus_Sbim60412VKvja"},
+					Id:      "sub_1RnMzCES6gLOjP91Zr0b3sZK",
+					Deleted: true,
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
 		{
 			Name: "Read customer with custom fields flattened to root level",
 			Input: common.ReadParams{
@@ -0,0 +1,115 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/internal/simultaneously"
+	"github.com/spyzhov/ajson"
+)
+
+var _ connectors.BatchRecordReaderConnector = &Connector{}
+
+// maxRecordsToFetchConcurrently keeps record lookups well below the Stripe rate limit of 25 requests
+// per second in test mode, unless the governor of the connection allows a different number in flight.
+// https://docs.stripe.com/rate-limits
+const maxRecordsToFetchConcurrently = 10
+
+// GetRecordsByIds retrieves records one by one, Stripe list endpoints cannot filter by identifiers.
+// Associations are expanded in place, ex: "customer" replaces the customer identifier with the object.
+// Records that no longer exist are omitted from the result.
+// https://docs.stripe.com/api/expanding_objects
+func (c *Connector) GetRecordsByIds( // nolint:revive
+	ctx context.Context,
+	objectName string,
+	ids []string,
+	fields []string,
+	associations []string,
+) ([]common.ReadResultRow, error) {
+	// Sanitize method arguments.
+	config := common.ReadParams{
+		ObjectName:        objectName,
+		Fields:            datautils.NewSetFromList(fields),
+		AssociatedObjects: associations,
+	}
+
+	if err := config.ValidateParams(true); err != nil {
+		return nil, err
+	}
+
+	// Every job fills its own slot, order of identifiers is preserved.
+	records := make([]*common.ReadResultRow, len(ids))
+	jobs := make([]simultaneously.Job, len(ids))
+
+	for index, identifier := range ids {
+		jobs[index] = func(ctx context.Context) error {
+			record, err := c.getRecord(ctx, config, identifier)
+			if err != nil {
+				if errors.Is(err, common.ErrNotFound) {
+					return nil
+				}
+
+				return err
+			}
+
+			records[index] = record
+
+			return nil
+		}
+	}
+
+	connGovernor := c.Client.HTTPClient.Governor()
+	ctx = governor.WithContext(ctx, connGovernor)
+
+	if err := simultaneously.DoCtx(ctx, connGovernor.Concurrency(maxRecordsToFetchConcurrently), jobs...); err != nil {
+		return nil, err
+	}
+
+	rows := make([]common.ReadResultRow, 0, len(ids))
+
+	for _, record := range records {
+		if record != nil {
+			rows = append(rows, *record)
+		}
+	}
+
+	return rows, nil
+}
+
+func (c *Connector) getRecord(
+	ctx context.Context, config common.ReadParams, identifier string,
+) (*common.ReadResultRow, error) {
+	url, err := c.getURL(config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	url.AddPath(identifier)
+
+	if len(config.AssociatedObjects) != 0 {
+		url.WithQueryParamList("expand[]", config.AssociatedObjects)
+	}
+
+	res, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	body, ok := res.Body()
+	if !ok {
+		return nil, common.ErrNotFound
+	}
+
+	rows, err := common.MakeMarshaledDataFunc(flattenCustomFields)([]*ajson.Node{body}, config.Fields.List())
+	if err != nil {
+		return nil, err
+	}
+
+	rows[0].Id = identifier
+
+	return &rows[0], nil
+}
@@ -0,0 +1,49 @@
+package stripe
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"gotest.tools/v3/assert"
+)
+
+func TestGetRecordsByIds(t *testing.T) {
+	t.Parallel()
+
+	responseCustomer := testutils.DataFromFile(t, "record/customer.json")
+	errorNotFound := testutils.DataFromFile(t, "delete/err-not-found.json")
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: []mockserver.Case{{
+			If: mockcond.And{
+				mockcond.MethodGET(),
+				mockcond.Path("/v1/customers/cus_SmKQ5bM4zHb1Jz"),
+				mockcond.QueryParam("expand[]", "invoice_settings.default_payment_method"),
+			},
+			Then: mockserver.Response(http.StatusOK, responseCustomer),
+		}, {
+			If:   mockcond.Path("/v1/customers/cus_deleted"),
+			Then: mockserver.Response(http.StatusNotFound, errorNotFound),
+		}},
+	}.Server()
+	defer server.Close()
+
+	conn := mustTestConnector(t, server.URL)
+
+	rows, err := conn.GetRecordsByIds(t.Context(), "customers",
+		[]string{"cus_deleted", "cus_SmKQ5bM4zHb1Jz"},
+		[]string{"email", "name"},
+		[]string{"invoice_settings.default_payment_method"},
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, len(rows), 1)
+	assert.Equal(t, rows[0].Id, "cus_SmKQ5bM4zHb1Jz")
+	assert.DeepEqual(t, rows[0].Fields, map[string]any{
+		"email": "jane.doe@example.com",
+		"name":  "Jane Doe",
+	})
+}
@@ -0,0 +1,327 @@
+package stripe
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/go-playground/validator"
+)
+
+var _ connectors.SubscribeConnector = &Connector{}
+
+// SubscriptionRequest describes the webhook endpoint that receives events.
+type SubscriptionRequest struct {
+	// WebhookEndpoint is the URL Stripe delivers events to.
+	WebhookEndpoint string `json:"webhookEndpoint" validate:"required"`
+	// Description is shown next to the endpoint in the Stripe dashboard.
+	Description string `json:"description,omitempty"`
+	// APIVersion pins the shape of event payloads, account default is used when empty.
+	// It can only be chosen when the endpoint is created.
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// SubscriptionResult holds the webhook endpoint created for the subscription.
+type SubscriptionResult struct {
+	Endpoint WebhookEndpoint `json:"endpoint"`
+}
+
+// WebhookEndpoint is the resource returned by the Webhook Endpoints API.
+// https://docs.stripe.com/api/webhook_endpoints/object
+type WebhookEndpoint struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	EnabledEvents []string `json:"enabled_events"` // nolint:tagliatelle
+	// Secret is used to verify signatures of delivered events.
+	// Stripe returns it only when the endpoint is created, updates carry it over.
+	Secret string `json:"secret,omitempty"`
+	Status string `json:"status"`
+}
+
+// Events are named after the resource with the action suffix, ex: "customer.subscription.deleted".
+// https://docs.stripe.com/api/events/types
+var eventActions = map[common.SubscriptionEventType]string{ // nolint:gochecknoglobals
+	common.SubscriptionEventTypeCreate: "created",
+	common.SubscriptionEventTypeUpdate: "updated",
+	common.SubscriptionEventTypeDelete: "deleted",
+}
+
+// subscribableEvents lists actions Stripe publishes for each object.
+// Event name prefixes are shared with the event log, see changeEventSources.
+var subscribableEvents = map[string][]common.SubscriptionEventType{ // nolint:gochecknoglobals
+	"accounts":               {common.SubscriptionEventTypeUpdate},
+	"charges":                {common.SubscriptionEventTypeUpdate},
+	"coupons":                createUpdateDelete(),
+	"credit_notes":           createUpdate(),
+	"customers":              createUpdateDelete(),
+	"disputes":               createUpdate(),
+	"invoiceitems":           {common.SubscriptionEventTypeCreate, common.SubscriptionEventTypeDelete},
+	"invoices":               createUpdateDelete(),
+	"payment_intents":        {common.SubscriptionEventTypeCreate},
+	"payouts":                createUpdate(),
+	"plans":                  createUpdateDelete(),
+	"prices":                 createUpdateDelete(),
+	"products":               createUpdateDelete(),
+	"promotion_codes":        createUpdate(),
+	"refunds":                createUpdate(),
+	"setup_intents":          {common.SubscriptionEventTypeCreate},
+	"subscription_schedules": createUpdate(),
+	"subscriptions":          createUpdateDelete(),
+	"tax_rates":              createUpdate(),
+	"topups":                 {common.SubscriptionEventTypeCreate},
+	"transfers":              createUpdate(),
+}
+
+func (c *Connector) EmptySubscriptionParams() *common.SubscribeParams {
+	return &common.SubscribeParams{
+		Request: &SubscriptionRequest{},
+	}
+}
+
+func (c *Connector) EmptySubscriptionResult() *common.SubscriptionResult {
+	return &common.SubscriptionResult{
+		Result: &SubscriptionResult{},
+	}
+}
+
+// Subscribe creates a single webhook endpoint listening to events of all requested objects.
+// Create, update and delete events are translated to Stripe event names,
+// ObjectEvents.PassThroughEvents are enabled as is, ex: "invoice.payment_failed".
+// https://docs.stripe.com/api/webhook_endpoints/create
+func (c *Connector) Subscribe(
+	ctx context.Context,
+	params common.SubscribeParams,
+) (*common.SubscriptionResult, error) {
+	req, err := validateRequest(params)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledEvents, err := getProviderEventNames(params.SubscriptionEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := newEndpointPayload(req.WebhookEndpoint, enabledEvents)
+	if req.Description != "" {
+		payload["description"] = req.Description
+	}
+
+	if req.APIVersion != "" {
+		payload["api_version"] = req.APIVersion
+	}
+
+	url, err := c.getWebhookEndpointsURL()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := c.writeWebhookEndpoint(ctx, url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.SubscriptionResult{
+		Result:       &SubscriptionResult{Endpoint: *endpoint},
+		ObjectEvents: params.SubscriptionEvents,
+		Status:       common.SubscriptionStatusSuccess,
+	}, nil
+}
+
+// UpdateSubscription replaces the list of events enabled on the existing webhook endpoint.
+// https://docs.stripe.com/api/webhook_endpoints/update
+func (c *Connector) UpdateSubscription(
+	ctx context.Context,
+	params common.SubscribeParams,
+	previousResult *common.SubscriptionResult,
+) (*common.SubscriptionResult, error) {
+	req, err := validateRequest(params)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := validateResult(previousResult)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledEvents, err := getProviderEventNames(params.SubscriptionEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := newEndpointPayload(req.WebhookEndpoint, enabledEvents)
+	if req.Description != "" {
+		payload["description"] = req.Description
+	}
+
+	url, err := c.getWebhookEndpointsURL()
+	if err != nil {
+		return nil, err
+	}
+
+	url.AddPath(previous.Endpoint.ID)
+
+	endpoint, err := c.writeWebhookEndpoint(ctx, url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.Secret = previous.Endpoint.Secret
+
+	return &common.SubscriptionResult{
+		Result:       &SubscriptionResult{Endpoint: *endpoint},
+		ObjectEvents: params.SubscriptionEvents,
+		Status:       common.SubscriptionStatusSuccess,
+	}, nil
+}
+
+// DeleteSubscription removes the webhook endpoint, no further events are delivered.
+// https://docs.stripe.com/api/webhook_endpoints/delete
+func (c *Connector) DeleteSubscription(ctx context.Context, result common.SubscriptionResult) error {
+	subscription, err := validateResult(&result)
+	if err != nil {
+		return err
+	}
+
+	url, err := c.getWebhookEndpointsURL()
+	if err != nil {
+		return err
+	}
+
+	url.AddPath(subscription.Endpoint.ID)
+
+	if _, err = c.Client.Delete(ctx, url.String()); err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint %s: %w", subscription.Endpoint.ID, err)
+	}
+
+	return nil
+}
+
+func (c *Connector) getWebhookEndpointsURL() (*urlbuilder.URL, error) {
+	return c.getURL("webhook_endpoints")
+}
+
+func (c *Connector) writeWebhookEndpoint(
+	ctx context.Context, url *urlbuilder.URL, payload map[string]string,
+) (*WebhookEndpoint, error) {
+	resp, err := c.Client.Post(ctx, url.String(), payload, common.HeaderFormURLEncoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write webhook endpoint: %w", err)
+	}
+
+	endpoint, err := common.UnmarshalJSON[WebhookEndpoint](resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// newEndpointPayload creates form data for the endpoint.
+// Arrays are encoded with indexed keys, ex: "enabled_events[0]=customer.created".
+func newEndpointPayload(webhookURL string, enabledEvents []string) map[string]string {
+	payload := map[string]string{
+		"url": webhookURL,
+	}
+
+	for index, event := range enabledEvents {
+		payload["enabled_events["+strconv.Itoa(index)+"]"] = event
+	}
+
+	return payload
+}
+
+// getProviderEventNames converts requested object events into a sorted list of Stripe event names.
+func getProviderEventNames(subscriptionEvents map[common.ObjectName]common.ObjectEvents) ([]string, error) {
+	names := make([]string, 0)
+
+	for objectName, objectEvents := range subscriptionEvents {
+		for _, event := range objectEvents.Events {
+			name, err := getProviderEventName(objectName.String(), event)
+			if err != nil {
+				return nil, err
+			}
+
+			names = append(names, name)
+		}
+
+		names = append(names, objectEvents.PassThroughEvents...)
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%w: no events to subscribe to", errMissingParams)
+	}
+
+	slices.Sort(names)
+
+	return slices.Compact(names), nil
+}
+
+func getProviderEventName(objectName string, event common.SubscriptionEventType) (string, error) {
+	supported, ok := subscribableEvents[objectName]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", errUnsupportedObject, objectName)
+	}
+
+	if !slices.Contains(supported, event) {
+		return "", fmt.Errorf("%w: %s for object %s", errUnsupportedEventType, event, objectName)
+	}
+
+	return changeEventSources[objectName].typePrefix + "." + eventActions[event], nil
+}
+
+func validateRequest(params common.SubscribeParams) (*SubscriptionRequest, error) {
+	if params.Request == nil {
+		return nil, fmt.Errorf("%w: request is nil", errMissingParams)
+	}
+
+	req, ok := params.Request.(*SubscriptionRequest)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected '%T' got '%T'", errInvalidRequestType, req, params.Request)
+	}
+
+	validate := validator.New()
+
+	if err := validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("%w: request is invalid: %w", errInvalidRequestType, err)
+	}
+
+	return req, nil
+}
+
+func validateResult(result *common.SubscriptionResult) (*SubscriptionResult, error) {
+	if result == nil || result.Result == nil {
+		return nil, fmt.Errorf("%w: missing subscription result", errMissingParams)
+	}
+
+	subscription, ok := result.Result.(*SubscriptionResult)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected '%T' got '%T'", errInvalidRequestType, subscription, result.Result)
+	}
+
+	if subscription.Endpoint.ID == "" {
+		return nil, fmt.Errorf("%w: missing webhook endpoint identifier", errMissingParams)
+	}
+
+	return subscription, nil
+}
+
+func createUpdate() []common.SubscriptionEventType {
+	return []common.SubscriptionEventType{
+		common.SubscriptionEventTypeCreate,
+		common.SubscriptionEventTypeUpdate,
+	}
+}
+
+func createUpdateDelete() []common.SubscriptionEventType {
+	return []common.SubscriptionEventType{
+		common.SubscriptionEventTypeCreate,
+		common.SubscriptionEventTypeUpdate,
+		common.SubscriptionEventTypeDelete,
+	}
+}
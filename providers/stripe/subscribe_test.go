@@ -0,0 +1,203 @@
+package stripe
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"gotest.tools/v3/assert"
+)
+
+const testEndpointID = "we_1RqZ7bES6gLOjP91Hx2kQm3A"
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	responseCreated := testutils.DataFromFile(t, "subscribe/endpoint-created.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodPOST(),
+			mockcond.Path("/v1/webhook_endpoints"),
+			mockcond.HeaderContentURLFormEncoded(),
+			mockcond.Body(url.Values{
+				"url":               {"https://example.com/webhooks/stripe"},
+				"description":       {"Ampersand"},
+				"enabled_events[0]": {"customer.created"},
+				"enabled_events[1]": {"customer.deleted"},
+				"enabled_events[2]": {"customer.subscription.updated"},
+				"enabled_events[3]": {"invoice.payment_failed"},
+			}.Encode()),
+		},
+		Then: mockserver.Response(http.StatusOK, responseCreated),
+	}.Server()
+	defer server.Close()
+
+	conn := mustTestConnector(t, server.URL)
+
+	subscriptionEvents := map[common.ObjectName]common.ObjectEvents{
+		"customers": {
+			Events: []common.SubscriptionEventType{
+				common.SubscriptionEventTypeCreate,
+				common.SubscriptionEventTypeDelete,
+			},
+		},
+		"subscriptions": {
+			Events:            []common.SubscriptionEventType{common.SubscriptionEventTypeUpdate},
+			PassThroughEvents: []string{"invoice.payment_failed"},
+		},
+	}
+
+	result, err := conn.Subscribe(t.Context(), common.SubscribeParams{
+		Request: &SubscriptionRequest{
+			WebhookEndpoint: "https://example.com/webhooks/stripe",
+			Description:     "Ampersand",
+		},
+		SubscriptionEvents: subscriptionEvents,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, result.Status, common.SubscriptionStatusSuccess)
+	assert.DeepEqual(t, result.ObjectEvents, subscriptionEvents)
+
+	subscription, ok := result.Result.(*SubscriptionResult)
+	assert.Assert(t, ok)
+	assert.Equal(t, subscription.Endpoint.ID, testEndpointID)
+	assert.Equal(t, subscription.Endpoint.Secret, "whsec_wRNftLajMZNeslQOP6vEPm4iVx5NlZ6z")
+	assert.Equal(t, len(subscription.Endpoint.EnabledEvents), 4)
+}
+
+func TestSubscribeRejectsUnsupportedEvents(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Dummy()
+	defer server.Close()
+
+	conn := mustTestConnector(t, server.URL)
+
+	tests := []struct {
+		name   string
+		params common.SubscribeParams
+		err    error
+	}{
+		{
+			name:   "Request is required",
+			params: common.SubscribeParams{},
+			err:    errMissingParams,
+		},
+		{
+			name: "Webhook endpoint is required",
+			params: common.SubscribeParams{
+				Request: &SubscriptionRequest{},
+			},
+			err: errInvalidRequestType,
+		},
+		{
+			name: "Object without events",
+			params: common.SubscribeParams{
+				Request: &SubscriptionRequest{WebhookEndpoint: "https://example.com"},
+				SubscriptionEvents: map[common.ObjectName]common.ObjectEvents{
+					"balance_transactions": {Events: []common.SubscriptionEventType{common.SubscriptionEventTypeCreate}},
+				},
+			},
+			err: errUnsupportedObject,
+		},
+		{
+			name: "Accounts are never deleted via events",
+			params: common.SubscribeParams{
+				Request: &SubscriptionRequest{WebhookEndpoint: "https://example.com"},
+				SubscriptionEvents: map[common.ObjectName]common.ObjectEvents{
+					"accounts": {Events: []common.SubscriptionEventType{common.SubscriptionEventTypeDelete}},
+				},
+			},
+			err: errUnsupportedEventType,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := conn.Subscribe(t.Context(), tt.params)
+		assert.ErrorIs(t, err, tt.err, tt.name)
+	}
+}
+
+func TestUpdateSubscription(t *testing.T) {
+	t.Parallel()
+
+	responseUpdated := testutils.DataFromFile(t, "subscribe/endpoint-updated.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodPOST(),
+			mockcond.Path("/v1/webhook_endpoints/" + testEndpointID),
+			mockcond.Body(url.Values{
+				"url":               {"https://example.com/webhooks/stripe"},
+				"enabled_events[0]": {"product.updated"},
+			}.Encode()),
+		},
+		Then: mockserver.Response(http.StatusOK, responseUpdated),
+	}.Server()
+	defer server.Close()
+
+	conn := mustTestConnector(t, server.URL)
+
+	result, err := conn.UpdateSubscription(t.Context(), common.SubscribeParams{
+		Request: &SubscriptionRequest{WebhookEndpoint: "https://example.com/webhooks/stripe"},
+		SubscriptionEvents: map[common.ObjectName]common.ObjectEvents{
+			"products": {Events: []common.SubscriptionEventType{common.SubscriptionEventTypeUpdate}},
+		},
+	}, &common.SubscriptionResult{
+		Result: &SubscriptionResult{Endpoint: WebhookEndpoint{
+			ID:     testEndpointID,
+			Secret: "whsec_wRNftLajMZNeslQOP6vEPm4iVx5NlZ6z",
+		}},
+	})
+	assert.NilError(t, err)
+
+	subscription, ok := result.Result.(*SubscriptionResult)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, subscription.Endpoint.EnabledEvents, []string{"product.updated"})
+	// Secret is not returned on update, it must survive.
+	assert.Equal(t, subscription.Endpoint.Secret, "whsec_wRNftLajMZNeslQOP6vEPm4iVx5NlZ6z")
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	t.Parallel()
+
+	responseDeleted := testutils.DataFromFile(t, "subscribe/endpoint-deleted.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodDELETE(),
+			mockcond.Path("/v1/webhook_endpoints/" + testEndpointID),
+		},
+		Then: mockserver.Response(http.StatusOK, responseDeleted),
+	}.Server()
+	defer server.Close()
+
+	conn := mustTestConnector(t, server.URL)
+
+	err := conn.DeleteSubscription(t.Context(), common.SubscriptionResult{
+		Result: &SubscriptionResult{Endpoint: WebhookEndpoint{ID: testEndpointID}},
+	})
+	assert.NilError(t, err)
+
+	err = conn.DeleteSubscription(t.Context(), common.SubscriptionResult{})
+	assert.ErrorIs(t, err, errMissingParams)
+}
+
+func mustTestConnector(t *testing.T, serverURL string) *Connector {
+	t.Helper()
+
+	conn, err := constructTestConnector(serverURL)
+	if err != nil {
+		t.Fatalf("failed to construct connector: %v", err)
+	}
+
+	return conn
+}
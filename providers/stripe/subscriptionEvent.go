@@ -0,0 +1,275 @@
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+const (
+	StripeSignatureHeader = "Stripe-Signature"
+
+	// DefaultSignatureTolerance is the age of the signed timestamp accepted by Stripe libraries.
+	DefaultSignatureTolerance = 5 * time.Minute
+
+	// signatureScheme is the only scheme used for live signatures, "v0" is sent by test webhooks.
+	signatureScheme = "v1"
+)
+
+type (
+	// SubscriptionEvent is a Stripe event delivered to the webhook endpoint.
+	// https://docs.stripe.com/api/events/object
+	SubscriptionEvent map[string]any
+
+	StripeVerificationParams struct {
+		// Secret of the webhook endpoint, starts with "whsec_".
+		Secret string
+		// Tolerance is the maximum age of the signature, DefaultSignatureTolerance is used when zero.
+		Tolerance time.Duration
+	}
+)
+
+var (
+	_ common.SubscriptionEvent       = SubscriptionEvent{}
+	_ common.SubscriptionUpdateEvent = SubscriptionEvent{}
+)
+
+// VerifyWebhookMessage checks the Stripe-Signature header.
+// The header carries the timestamp and one or more signatures, ex: "t=1492774577,v1=5257a869...,v1=...".
+// Signature is HMAC-SHA256 of "<timestamp>.<body>" keyed with the endpoint secret.
+// Request is not trusted if no signature matches or the timestamp is outside the tolerance window,
+// the latter protects against replay attacks.
+// https://docs.stripe.com/webhooks#verify-manually
+func (c *Connector) VerifyWebhookMessage(
+	_ context.Context,
+	request *common.WebhookRequest,
+	params *common.VerificationParams,
+) (bool, error) {
+	if request == nil || params == nil {
+		return false, fmt.Errorf("%w: request and params cannot be nil", errMissingParams)
+	}
+
+	verificationParams, err := common.AssertType[*StripeVerificationParams](params.Param)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", errMissingParams, err)
+	}
+
+	if verificationParams.Secret == "" {
+		return false, fmt.Errorf("%w: missing webhook secret", errMissingParams)
+	}
+
+	header := request.Headers.Get(StripeSignatureHeader)
+	if header == "" {
+		return false, fmt.Errorf("%w: missing %s header", ErrMissingSignature, StripeSignatureHeader)
+	}
+
+	timestamp, signatures, err := parseSignatureHeader(header)
+	if err != nil {
+		return false, err
+	}
+
+	tolerance := verificationParams.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultSignatureTolerance
+	}
+
+	signedAt := time.Unix(timestamp, 0)
+	if age := time.Since(signedAt); age > tolerance || age < -tolerance {
+		return false, nil
+	}
+
+	expectedSignature := computeSignature(verificationParams.Secret, timestamp, request.Body)
+
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expectedSignature)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// parseSignatureHeader returns the timestamp and "v1" signatures, other schemes are ignored.
+func parseSignatureHeader(header string) (int64, []string, error) {
+	var (
+		timestamp  int64
+		signatures []string
+		err        error
+	)
+
+	for _, item := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(item), "=")
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "t":
+			timestamp, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return 0, nil, fmt.Errorf("%w: malformed timestamp: %w", ErrInvalidSignature, err)
+			}
+		case signatureScheme:
+			signatures = append(signatures, value)
+		}
+	}
+
+	if timestamp == 0 {
+		return 0, nil, fmt.Errorf("%w: header has no timestamp", ErrInvalidSignature)
+	}
+
+	if len(signatures) == 0 {
+		return 0, nil, fmt.Errorf("%w: header has no %s signature", ErrMissingSignature, signatureScheme)
+	}
+
+	return timestamp, signatures, nil
+}
+
+func computeSignature(secret string, timestamp int64, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	h.Write([]byte("."))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (evt SubscriptionEvent) EventType() (common.SubscriptionEventType, error) {
+	eventName, err := evt.RawEventName()
+	if err != nil {
+		return common.SubscriptionEventTypeOther, err
+	}
+
+	for eventType, action := range eventActions {
+		if strings.HasSuffix(eventName, "."+action) {
+			return eventType, nil
+		}
+	}
+
+	return common.SubscriptionEventTypeOther, nil
+}
+
+func (evt SubscriptionEvent) RawEventName() (string, error) {
+	return evt.asMap().GetString("type")
+}
+
+// ObjectName maps the kind of the attached object back to the object name, ex: "subscription" => "subscriptions".
+// Nested resources not known to the connector are reported by their kind, ex: "tax_id".
+func (evt SubscriptionEvent) ObjectName() (string, error) {
+	object, err := evt.object()
+	if err != nil {
+		return "", err
+	}
+
+	kind, err := object.GetString("object")
+	if err != nil {
+		return "", err
+	}
+
+	for objectName, source := range changeEventSources {
+		if source.objectKind == kind {
+			return objectName, nil
+		}
+	}
+
+	return kind, nil
+}
+
+// Workspace returns the connected account the event originated from.
+// Events of the platform account don't have it.
+func (evt SubscriptionEvent) Workspace() (string, error) {
+	account, ok := evt["account"].(string)
+	if !ok {
+		return "", nil
+	}
+
+	return account, nil
+}
+
+func (evt SubscriptionEvent) RecordId() (string, error) {
+	object, err := evt.object()
+	if err != nil {
+		return "", err
+	}
+
+	return object.GetString("id")
+}
+
+func (evt SubscriptionEvent) EventTimeStampNano() (int64, error) {
+	created, err := evt.asMap().AsInt("created")
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Unix(created, 0).UnixNano(), nil
+}
+
+func (evt SubscriptionEvent) RawMap() (map[string]any, error) {
+	return maps.Clone(evt), nil
+}
+
+// UpdatedFields lists fields present in "previous_attributes", they are sent with "*.updated" events.
+func (evt SubscriptionEvent) UpdatedFields() ([]string, error) {
+	data, err := evt.data()
+	if err != nil {
+		return nil, err
+	}
+
+	previous, ok := data["previous_attributes"].(map[string]any)
+	if !ok {
+		return []string{}, nil
+	}
+
+	fields := make([]string, 0, len(previous))
+	for field := range previous {
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func (evt SubscriptionEvent) data() (common.StringMap, error) {
+	data, err := evt.asMap().Get("data")
+	if err != nil {
+		return nil, err
+	}
+
+	dataMap, err := common.AssertType[map[string]any](data)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.StringMap(dataMap), nil
+}
+
+func (evt SubscriptionEvent) object() (common.StringMap, error) {
+	data, err := evt.data()
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := data.Get("object")
+	if err != nil {
+		return nil, err
+	}
+
+	objectMap, err := common.AssertType[map[string]any](object)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.StringMap(objectMap), nil
+}
+
+// asMap returns the event as a StringMap.
+func (evt SubscriptionEvent) asMap() common.StringMap {
+	return common.StringMap(evt)
+}
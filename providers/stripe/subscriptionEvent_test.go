@@ -0,0 +1,148 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"gotest.tools/v3/assert"
+)
+
+const testWebhookSecret = "whsec_wRNftLajMZNeslQOP6vEPm4iVx5NlZ6z"
+
+func TestSubscriptionEvent(t *testing.T) {
+	t.Parallel()
+
+	evtStr := `{
+		"id": "evt_1RqZ9xES6gLOjP91k0TQmRvn",
+		"object": "event",
+		"account": "acct_1Ql8ZTES6gLOjP91",
+		"api_version": "2025-07-30.basil",
+		"created": 1755958275,
+		"data": {
+			"object": {
+				"id": "sub_1RnMzCES6gLOjP91Zr0b3sZK",
+				"object": "subscription",
+				"cancel_at_period_end": true,
+				"status": "active"
+			},
+			"previous_attributes": {
+				"cancel_at_period_end": false
+			}
+		},
+		"livemode": false,
+		"type": "customer.subscription.updated"
+	}`
+
+	var evt SubscriptionEvent
+
+	err := json.Unmarshal([]byte(evtStr), &evt)
+	assert.NilError(t, err)
+
+	eventType, err := evt.EventType()
+	assert.NilError(t, err)
+	assert.Equal(t, eventType, common.SubscriptionEventTypeUpdate)
+
+	rawEventName, err := evt.RawEventName()
+	assert.NilError(t, err)
+	assert.Equal(t, rawEventName, "customer.subscription.updated")
+
+	objectName, err := evt.ObjectName()
+	assert.NilError(t, err)
+	assert.Equal(t, objectName, "subscriptions")
+
+	workspace, err := evt.Workspace()
+	assert.NilError(t, err)
+	assert.Equal(t, workspace, "acct_1Ql8ZTES6gLOjP91")
+
+	recordID, err := evt.RecordId()
+	assert.NilError(t, err)
+	assert.Equal(t, recordID, "sub_1RnMzCES6gLOjP91Zr0b3sZK")
+
+	timestamp, err := evt.EventTimeStampNano()
+	assert.NilError(t, err)
+	assert.Equal(t, timestamp, time.Unix(1755958275, 0).UnixNano())
+
+	updatedFields, err := evt.UpdatedFields()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, updatedFields, []string{"cancel_at_period_end"})
+}
+
+func TestVerifyWebhookMessage(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	conn := mustTestConnector(t, "http://localhost")
+	body := []byte(`{"id":"evt_1RqZ9xES6gLOjP91k0TQmRvn","type":"customer.created"}`)
+	now := time.Now().Unix()
+
+	sign := func(timestamp int64, secret string) string {
+		return "t=" + strconv.FormatInt(timestamp, 10) + ",v1=" + computeSignature(secret, timestamp, body)
+	}
+
+	tests := []struct {
+		name     string
+		header   string
+		expected bool
+		err      error
+	}{
+		{
+			name:     "Valid signature",
+			header:   sign(now, testWebhookSecret),
+			expected: true,
+		},
+		{
+			name: "Any of the signatures may match during secret rotation",
+			header: sign(now, "whsec_expired") + ",v1=" +
+				computeSignature(testWebhookSecret, now, body) + ",v0=6ffbb59b2300aae63f272406069a9788598b792a",
+			expected: true,
+		},
+		{
+			name:     "Signature of another secret",
+			header:   sign(now, "whsec_other"),
+			expected: false,
+		},
+		{
+			name:     "Replayed message is outside of tolerance",
+			header:   sign(now-int64((10*time.Minute).Seconds()), testWebhookSecret),
+			expected: false,
+		},
+		{
+			name:   "Missing header",
+			header: "",
+			err:    ErrMissingSignature,
+		},
+		{
+			name:   "Header without signature",
+			header: "t=" + strconv.FormatInt(now, 10),
+			err:    ErrMissingSignature,
+		},
+		{
+			name:   "Malformed timestamp",
+			header: "t=yesterday,v1=5257a869e7ecebeda32affa62cdca3fa51cad7e77a0e56ff536d0ce8e108d8bd",
+			err:    ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		headers := http.Header{}
+		if tt.header != "" {
+			headers.Set(StripeSignatureHeader, tt.header)
+		}
+
+		ok, err := conn.VerifyWebhookMessage(t.Context(),
+			&common.WebhookRequest{Headers: headers, Body: body},
+			&common.VerificationParams{Param: &StripeVerificationParams{Secret: testWebhookSecret}},
+		)
+
+		if tt.err != nil {
+			assert.ErrorIs(t, err, tt.err, tt.name)
+		} else {
+			assert.NilError(t, err, tt.name)
+		}
+
+		assert.Equal(t, ok, tt.expected, tt.name)
+	}
+}
@@ -8,6 +8,11 @@ import (
 	"github.com/spyzhov/ajson"
 )
 
+// Stripe safely retries requests that carry the same idempotency key,
+// the result of the first request is replayed for 24 hours.
+// https://docs.stripe.com/api/idempotent_requests
+const idempotencyKeyHeader = "Idempotency-Key"
+
 func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
 	if err := config.ValidateParams(); err != nil {
 		return nil, err
@@ -28,7 +33,10 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 		url.AddPath(config.RecordId)
 	}
 
-	res, err := write(ctx, url.String(), config.RecordData, common.HeaderFormURLEncoded)
+	headers := append([]common.Header{common.HeaderFormURLEncoded},
+		common.IdempotencyHeader(idempotencyKeyHeader, config.IdempotencyKey)...)
+
+	res, err := write(ctx, url.String(), config.RecordData, headers...)
 	if err != nil {
 		return nil, err
 	}
@@ -62,3 +70,7 @@ func constructWriteResult(node *ajson.Node) (*common.WriteResult, error) {
 		Data:     data,
 	}, nil
 }
+
+func (c *Connector) IdempotencyMode() common.IdempotencyMode {
+	return common.IdempotencyModeNative
+}
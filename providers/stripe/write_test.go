@@ -81,6 +81,25 @@ func TestWrite(t *testing.T) { // nolint:funlen,cyclop
 			Expected:     &common.WriteResult{Success: true},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Idempotency key is forwarded",
+			Input: common.WriteParams{
+				ObjectName:     "customers",
+				RecordData:     make(map[string]any),
+				IdempotencyKey: "4a8e2b6c-retry-safe",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.Path("/v1/customers"),
+					mockcond.Header(http.Header{"Idempotency-Key": []string{"4a8e2b6c-retry-safe"}}),
+				},
+				Then: mockserver.Response(http.StatusOK),
+			}.Server(),
+			Expected:     &common.WriteResult{Success: true},
+			ExpectedErrs: nil,
+		},
 		{
 			Name:  "Valid creation of a customer",
 			Input: common.WriteParams{ObjectName: "customers", RecordData: make(map[string]any)},
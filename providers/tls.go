@@ -0,0 +1,190 @@
+package providers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+var (
+	ErrTLSClientCertificate = errors.New("invalid TLS client certificate")
+	ErrTLSRootCAs           = errors.New("invalid TLS root certificate authorities")
+	ErrTLSTransport         = errors.New("TLS material cannot be applied to the client transport")
+)
+
+// TLSParams is the TLS material of the connection.
+// Tenants hosted on premises may require a client certificate (mutual TLS)
+// or serve certificates issued by a private certificate authority.
+// The material is applied to API requests and to requests of token endpoints alike.
+type TLSParams struct {
+	// ClientCertificatePEM is the client certificate chain, the leaf certificate comes first.
+	ClientCertificatePEM []byte
+	// ClientKeyPEM is the private key of the client certificate.
+	ClientKeyPEM []byte
+
+	// ClientPKCS12 holds the client certificate chain with the private key.
+	// It is an alternative to the PEM encoded certificate and key.
+	ClientPKCS12 []byte
+	// ClientPKCS12Password decrypts ClientPKCS12.
+	ClientPKCS12Password string
+
+	// RootCAsPEM are certificates of authorities trusted in addition to the system roots.
+	RootCAsPEM []byte
+
+	// ServerName overrides the name sent with SNI and verified against the server certificate.
+	ServerName string
+}
+
+// Config returns the TLS configuration holding the material.
+func (p *TLSParams) Config() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if err := p.apply(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (p *TLSParams) apply(config *tls.Config) error {
+	certificate, ok, err := p.clientCertificate()
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	if len(p.RootCAsPEM) != 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(p.RootCAsPEM) {
+			return fmt.Errorf("%w: no PEM encoded certificates found", ErrTLSRootCAs)
+		}
+
+		config.RootCAs = pool
+	}
+
+	if p.ServerName != "" {
+		config.ServerName = p.ServerName
+	}
+
+	return nil
+}
+
+func (p *TLSParams) clientCertificate() (tls.Certificate, bool, error) {
+	hasPEM := len(p.ClientCertificatePEM) != 0 || len(p.ClientKeyPEM) != 0
+	hasPKCS12 := len(p.ClientPKCS12) != 0
+
+	switch {
+	case hasPEM && hasPKCS12:
+		return tls.Certificate{}, false,
+			fmt.Errorf("%w: either PEM or PKCS#12 material is expected, not both", ErrTLSClientCertificate)
+	case hasPEM:
+		certificate, err := tls.X509KeyPair(p.ClientCertificatePEM, p.ClientKeyPEM)
+		if err != nil {
+			return tls.Certificate{}, false, fmt.Errorf("%w: %w", ErrTLSClientCertificate, err)
+		}
+
+		return certificate, true, nil
+	case hasPKCS12:
+		key, leaf, chain, err := pkcs12.DecodeChain(p.ClientPKCS12, p.ClientPKCS12Password)
+		if err != nil {
+			return tls.Certificate{}, false, fmt.Errorf("%w: %w", ErrTLSClientCertificate, err)
+		}
+
+		certificate := tls.Certificate{
+			Certificate: [][]byte{leaf.Raw},
+			PrivateKey:  key,
+			Leaf:        leaf,
+		}
+
+		for _, intermediate := range chain {
+			certificate.Certificate = append(certificate.Certificate, intermediate.Raw)
+		}
+
+		return certificate, true, nil
+	default:
+		return tls.Certificate{}, false, nil
+	}
+}
+
+// String describes the material without revealing it.
+func (p *TLSParams) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+
+	return fmt.Sprintf("TLSParams{ClientCertificate: %v, RootCAs: %v, ServerName: %q}",
+		p.hasClientCertificate(), len(p.RootCAsPEM) != 0, p.ServerName)
+}
+
+// GoString prevents the material from being printed with the %#v verb.
+func (p *TLSParams) GoString() string {
+	return p.String()
+}
+
+// LogValue describes the material without revealing it.
+func (p *TLSParams) LogValue() slog.Value {
+	if p == nil {
+		return slog.StringValue("<nil>")
+	}
+
+	return slog.GroupValue(
+		slog.Bool("clientCertificate", p.hasClientCertificate()),
+		slog.Bool("rootCAs", len(p.RootCAsPEM) != 0),
+		slog.String("serverName", p.ServerName),
+	)
+}
+
+func (p *TLSParams) hasClientCertificate() bool {
+	return len(p.ClientCertificatePEM) != 0 || len(p.ClientPKCS12) != 0
+}
+
+// newTLSClient returns a copy of the client with the TLS material applied to its transport.
+// TLS settings of the transport, which are not given by the material, are preserved.
+func newTLSClient(client *http.Client, params *TLSParams) (*http.Client, error) {
+	if params == nil {
+		return client, nil
+	}
+
+	base := getClient(client)
+
+	var transport *http.Transport
+
+	switch roundTripper := base.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone() // nolint:forcetypeassert
+	case *http.Transport:
+		transport = roundTripper.Clone()
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrTLSTransport, roundTripper)
+	}
+
+	config := transport.TLSClientConfig
+	if config == nil {
+		config = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	if err := params.apply(config); err != nil {
+		return nil, err
+	}
+
+	transport.TLSClientConfig = config
+
+	tlsClient := *base
+	tlsClient.Transport = transport
+
+	return &tlsClient, nil
+}
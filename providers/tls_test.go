@@ -0,0 +1,456 @@
+package providers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const testServerName = "gitlab.internal"
+
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// issueTestCertificate signs the template with the parent, the certificate is self-signed when parent is nil.
+func issueTestCertificate(t *testing.T, template *x509.Certificate, parent *testCertificate) *testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+type mutualTLSFixture struct {
+	server *httptest.Server
+	ca     *testCertificate
+	client *testCertificate
+}
+
+// newMutualTLSServer serves a certificate of the private CA and requires a client certificate issued by it.
+// The token endpoint and the API respond with the common name of the client certificate.
+func newMutualTLSServer(t *testing.T) *mutualTLSFixture {
+	t.Helper()
+
+	ca := issueTestCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Private CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+
+	serverCert := issueTestCertificate(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: testServerName},
+		DNSNames:    []string{testServerName},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+	}, ca)
+
+	clientCert := issueTestCertificate(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "connector"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+	}, ca)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commonName := r.TLS.PeerCertificates[0].Subject.CommonName
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/token" {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"access_token": "token-for-" + commonName,
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"commonName":    commonName,
+			"authorization": r.Header.Get("Authorization") + r.Header.Get("X-Api-Key"),
+		})
+	}))
+
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{serverCert.cert.Raw},
+			PrivateKey:  serverCert.key,
+		}},
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return &mutualTLSFixture{
+		server: server,
+		ca:     ca,
+		client: clientCert,
+	}
+}
+
+func requestTestServer(t *testing.T, client interface {
+	Do(req *http.Request) (*http.Response, error)
+}, url string,
+) (map[string]string, error) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	rsp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rsp.Body.Close()
+
+	var body map[string]string
+	if err := json.NewDecoder(rsp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	return body, nil
+}
+
+func newTestApiKeyInfo() *ProviderInfo {
+	return &ProviderInfo{
+		AuthType: ApiKey,
+		ApiKeyOpts: &ApiKeyOpts{
+			AttachmentType: Header,
+			Header:         &ApiKeyOptsHeader{Name: "X-Api-Key"},
+		},
+	}
+}
+
+func TestNewClientMutualTLS(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	fixture := newMutualTLSServer(t)
+
+	pfx, err := pkcs12.Modern.Encode(fixture.client.key, fixture.client.cert, nil, "secret")
+	if err != nil {
+		t.Fatalf("failed to encode PKCS#12: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		tls         *TLSParams
+		expectedErr bool
+	}{
+		{
+			name:        "Private CA is not trusted without TLS material",
+			tls:         nil,
+			expectedErr: true,
+		},
+		{
+			name: "Client certificate is required by the server",
+			tls: &TLSParams{
+				RootCAsPEM: fixture.ca.certPEM,
+				ServerName: testServerName,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "PEM client certificate with private CA",
+			tls: &TLSParams{
+				ClientCertificatePEM: fixture.client.certPEM,
+				ClientKeyPEM:         fixture.client.keyPEM,
+				RootCAsPEM:           fixture.ca.certPEM,
+				ServerName:           testServerName,
+			},
+		},
+		{
+			name: "PKCS#12 client certificate with private CA",
+			tls: &TLSParams{
+				ClientPKCS12:         pfx,
+				ClientPKCS12Password: "secret",
+				RootCAsPEM:           fixture.ca.certPEM,
+				ServerName:           testServerName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := newTestApiKeyInfo().NewClient(t.Context(), &NewClientParams{
+				ApiKeyCreds: &ApiKeyParams{Key: "api-key"},
+				TLS:         tt.tls,
+			})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			body, err := requestTestServer(t, client, fixture.server.URL+"/projects")
+			if tt.expectedErr {
+				if err == nil {
+					t.Fatalf("expected TLS handshake to fail")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if body["commonName"] != "connector" || body["authorization"] != "api-key" {
+				t.Fatalf("unexpected response: %v", body)
+			}
+		})
+	}
+}
+
+func TestNewClientMutualTLSTokenEndpoint(t *testing.T) {
+	t.Parallel()
+
+	fixture := newMutualTLSServer(t)
+
+	info := &ProviderInfo{
+		AuthType: Oauth2,
+		Oauth2Opts: &Oauth2Opts{
+			GrantType: ClientCredentials,
+			TokenURL:  fixture.server.URL + "/token",
+		},
+	}
+
+	client, err := info.NewClient(context.Background(), &NewClientParams{
+		OAuth2ClientCreds: &OAuth2ClientCredentialsParams{
+			Config: &clientcredentials.Config{
+				ClientID:     "client-id",
+				ClientSecret: "client-secret",
+				TokenURL:     fixture.server.URL + "/token",
+			},
+		},
+		TLS: &TLSParams{
+			ClientCertificatePEM: fixture.client.certPEM,
+			ClientKeyPEM:         fixture.client.keyPEM,
+			RootCAsPEM:           fixture.ca.certPEM,
+			ServerName:           testServerName,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	body, err := requestTestServer(t, client, fixture.server.URL+"/projects")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["authorization"] != "Bearer token-for-connector" {
+		t.Fatalf("token was not obtained over mutual TLS: %v", body)
+	}
+}
+
+func TestNewClientMutualTLSKeepsTokenClientOfContext(t *testing.T) {
+	t.Parallel()
+
+	fixture := newMutualTLSServer(t)
+
+	tokenClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body: io.NopCloser(strings.NewReader(
+					`{"access_token":"token-from-context","token_type":"Bearer","expires_in":3600}`,
+				)),
+				Request: req,
+			}, nil
+		}),
+	}
+
+	info := &ProviderInfo{
+		AuthType: Oauth2,
+		Oauth2Opts: &Oauth2Opts{
+			GrantType: ClientCredentials,
+			TokenURL:  fixture.server.URL + "/token",
+		},
+	}
+
+	ctx := context.WithValue(t.Context(), oauth2.HTTPClient, tokenClient)
+
+	client, err := info.NewClient(ctx, &NewClientParams{
+		OAuth2ClientCreds: &OAuth2ClientCredentialsParams{
+			Config: &clientcredentials.Config{
+				ClientID:     "client-id",
+				ClientSecret: "client-secret",
+				TokenURL:     fixture.server.URL + "/token",
+			},
+		},
+		TLS: &TLSParams{
+			ClientCertificatePEM: fixture.client.certPEM,
+			ClientKeyPEM:         fixture.client.keyPEM,
+			RootCAsPEM:           fixture.ca.certPEM,
+			ServerName:           testServerName,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	body, err := requestTestServer(t, client, fixture.server.URL+"/projects")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["authorization"] != "Bearer token-from-context" {
+		t.Fatalf("token was not obtained with the client of the context: %v", body)
+	}
+}
+
+func TestTLSParamsErrors(t *testing.T) {
+	t.Parallel()
+
+	fixture := newMutualTLSServer(t)
+
+	tests := []struct {
+		name     string
+		client   *http.Client
+		tls      *TLSParams
+		expected error
+	}{
+		{
+			name:     "Key doesn't match the certificate",
+			tls:      &TLSParams{ClientCertificatePEM: fixture.client.certPEM, ClientKeyPEM: fixture.ca.keyPEM},
+			expected: ErrTLSClientCertificate,
+		},
+		{
+			name:     "PKCS#12 archive is malformed",
+			tls:      &TLSParams{ClientPKCS12: []byte("not an archive"), ClientPKCS12Password: "secret"},
+			expected: ErrTLSClientCertificate,
+		},
+		{
+			name:     "Root CAs are not PEM encoded",
+			tls:      &TLSParams{RootCAsPEM: []byte("not a certificate")},
+			expected: ErrTLSRootCAs,
+		},
+		{
+			name:     "Custom transport cannot be configured",
+			client:   &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)},
+			tls:      &TLSParams{ServerName: testServerName},
+			expected: ErrTLSTransport,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newTestApiKeyInfo().NewClient(t.Context(), &NewClientParams{
+				Client:      tt.client,
+				ApiKeyCreds: &ApiKeyParams{Key: "api-key"},
+				TLS:         tt.tls,
+			})
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTLSParamsRedacted(t *testing.T) {
+	t.Parallel()
+
+	fixture := newMutualTLSServer(t)
+
+	params := &TLSParams{
+		ClientCertificatePEM: fixture.client.certPEM,
+		ClientKeyPEM:         fixture.client.keyPEM,
+		ServerName:           testServerName,
+	}
+
+	var logged strings.Builder
+
+	slog.New(slog.NewTextHandler(&logged, nil)).Info("connecting", "tls", params)
+
+	outputs := []string{
+		fmt.Sprintf("%v", params),
+		fmt.Sprintf("%+v", params),
+		fmt.Sprintf("%#v", params),
+		logged.String(),
+	}
+
+	for _, output := range outputs {
+		if strings.Contains(output, "PRIVATE KEY") || strings.Contains(output, "CERTIFICATE") {
+			t.Fatalf("TLS material is revealed: %v", output)
+		}
+
+		if !strings.Contains(output, testServerName) {
+			t.Fatalf("TLS params are not described: %v", output)
+		}
+	}
+}
@@ -323,6 +323,17 @@ type OAuth2ClientCredentialsParams struct {
 	Options []common.OAuthOption
 }
 
+// OAuth1Params is the parameters to create an OAuth 1.0a client.
+type OAuth1Params struct {
+	ConsumerKey    string
+	ConsumerSecret string
+	Token          string
+	TokenSecret    string
+	// Realm overrides the realm derived by the provider, see Oauth1Opts.Realm.
+	Realm   string
+	Options []common.CustomAuthClientOption
+}
+
 type CustomAuthParams struct {
 	Values  map[string]string
 	Options []common.CustomAuthClientOption
@@ -364,6 +375,22 @@ type NewClientParams struct {
 	// CustomCreds is the custom auth credentials to use for the client. If the provider uses
 	// custom auth, this field must be set.
 	CustomCreds *CustomAuthParams
+
+	// OAuth1Creds is the consumer and token credentials to use for the client. If the provider uses
+	// oauth1, this field must be set. Providers that accept OAuth 1.0a next to their primary
+	// auth type (their catalog entry has oauth1 options) use it whenever this field is set.
+	OAuth1Creds *OAuth1Params
+
+	// TLS is the client certificate and trusted authorities of the connection.
+	// It is optional, the material is applied to a copy of Client.
+	TLS *TLSParams
+
+	// CredentialSource loads api key, basic and custom auth credentials whenever a request is sent.
+	// When supplied, it replaces the static values of ApiKeyCreds, BasicCreds and CustomCreds,
+	// while their client options still apply. Credentials cached by the source are invalidated
+	// once the provider responds with unauthorized status.
+	// OAuth clients don't read it and reject it, their tokens are tracked with common.WithTokenUpdated.
+	CredentialSource common.CredentialSource
 }
 
 // NewClient will create a new authenticated client based on the provider's auth type.
@@ -372,9 +399,40 @@ func (i *ProviderInfo) NewClient(ctx context.Context, params *NewClientParams) (
 		params = &NewClientParams{}
 	}
 
-	switch i.AuthType {
+	client, err := newTLSClient(params.Client, params.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrClient, err)
+	}
+
+	if _, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); !ok && params.TLS != nil {
+		// Token endpoints are reached with the same TLS material as the API,
+		// unless the caller has chosen the client for token requests.
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+	}
+
+	authType := i.AuthType
+	if params.OAuth1Creds != nil && i.Oauth1Options() != nil {
+		authType = Oauth1
+	}
+
+	if params.CredentialSource != nil && (authType == Oauth1 || authType == Oauth2) {
+		return nil, fmt.Errorf("%w: credential source is not supported by %s auth", ErrClient, authType)
+	}
+
+	switch authType {
 	case None:
-		return createUnauthenticatedClient(ctx, params.Client, params.Debug)
+		return createUnauthenticatedClient(ctx, client, params.Debug)
+	case Oauth1:
+		if i.Oauth1Options() == nil {
+			return nil, fmt.Errorf("%w: oauth1 options not found", ErrClient)
+		}
+
+		if params.OAuth1Creds == nil {
+			return nil, fmt.Errorf("%w: oauth1 credentials not found", ErrClient)
+		}
+
+		return createOAuth1HTTPClient(ctx, client, params.Debug, params.OnUnauthorized,
+			params.IsUnauthorized, i, params.OAuth1Creds)
 	case Oauth2:
 		if i.Oauth2Opts == nil {
 			return nil, fmt.Errorf("%w: %s", ErrClient, "oauth2 options not found")
@@ -385,50 +443,52 @@ func (i *ProviderInfo) NewClient(ctx context.Context, params *NewClientParams) (
 			fallthrough
 		case AuthorizationCode:
 			return createOAuth2AuthCodeHTTPClient(
-				ctx, params.Client, params.Debug, params.OnUnauthorized, params.IsUnauthorized, i, params.OAuth2AuthCodeCreds)
+				ctx, client, params.Debug, params.OnUnauthorized, params.IsUnauthorized, i, params.OAuth2AuthCodeCreds)
 		case ClientCredentials:
 			return createOAuth2ClientCredentialsHTTPClient(
-				ctx, params.Client, params.Debug, params.OnUnauthorized, params.IsUnauthorized, i, params.OAuth2ClientCreds)
+				ctx, client, params.Debug, params.OnUnauthorized, params.IsUnauthorized, i, params.OAuth2ClientCreds)
 		case Password:
 			return createOAuth2PasswordHTTPClient(
-				ctx, params.Client, params.Debug, params.OnUnauthorized, params.IsUnauthorized, i, params.OAuth2AuthCodeCreds)
+				ctx, client, params.Debug, params.OnUnauthorized, params.IsUnauthorized, i, params.OAuth2AuthCodeCreds)
 		default:
 			return nil, fmt.Errorf("%w: unsupported grant type %q", ErrClient, i.Oauth2Opts.GrantType)
 		}
 	case Basic:
-		if params.BasicCreds == nil {
+		if params.BasicCreds == nil && params.CredentialSource == nil {
 			return nil, fmt.Errorf("%w: %s", ErrClient, "basic credentials not found")
 		}
 
 		return createBasicAuthHTTPClient(
-			ctx, params.Client, params.Debug, params.OnUnauthorized, params.IsUnauthorized, i,
-			params.BasicCreds.User, params.BasicCreds.Pass, params.BasicCreds.Options)
+			ctx, client, params.Debug, params.onUnauthorized(), params.IsUnauthorized, i,
+			params.BasicCreds, params.CredentialSource)
 	case ApiKey:
 		if i.ApiKeyOpts == nil {
 			return nil, fmt.Errorf("%w: api key options not found", ErrClient)
 		}
 
-		if params.ApiKeyCreds == nil {
-			return nil, fmt.Errorf("%w: api key credentials not found", ErrClient)
-		}
+		if params.CredentialSource == nil {
+			if params.ApiKeyCreds == nil {
+				return nil, fmt.Errorf("%w: api key credentials not found", ErrClient)
+			}
 
-		if len(params.ApiKeyCreds.Key) == 0 {
-			return nil, fmt.Errorf("%w: api key not given", ErrClient)
+			if len(params.ApiKeyCreds.Key) == 0 {
+				return nil, fmt.Errorf("%w: api key not given", ErrClient)
+			}
 		}
 
-		return createApiKeyHTTPClient(ctx, params.Client, params.Debug, params.OnUnauthorized,
-			params.IsUnauthorized, i, params.ApiKeyCreds)
+		return createApiKeyHTTPClient(ctx, client, params.Debug, params.onUnauthorized(),
+			params.IsUnauthorized, i, params.ApiKeyCreds, params.CredentialSource)
 	case Custom:
 		if i.CustomOpts == nil {
 			return nil, fmt.Errorf("%w: custom options not found", ErrClient)
 		}
 
-		if params.CustomCreds == nil {
+		if params.CustomCreds == nil && params.CredentialSource == nil {
 			return nil, fmt.Errorf("%w: custom credentials not found", ErrClient)
 		}
 
-		return createCustomHTTPClient(ctx, params.Client, params.Debug, params.OnUnauthorized,
-			params.IsUnauthorized, i, params.CustomCreds)
+		return createCustomHTTPClient(ctx, client, params.Debug, params.onUnauthorized(),
+			params.IsUnauthorized, i, params.CustomCreds, params.CredentialSource)
 	case Jwt:
 		// We shouldn't hit this case, because no providerInfo has auth type set to JWT yet.
 		fallthrough
@@ -437,6 +497,15 @@ func (i *ProviderInfo) NewClient(ctx context.Context, params *NewClientParams) (
 	}
 }
 
+// onUnauthorized returns the handler of unauthorized responses for clients reading the credential source.
+func (p *NewClientParams) onUnauthorized() UnauthorizedHandler {
+	if p.CredentialSource == nil {
+		return p.OnUnauthorized
+	}
+
+	return invalidateOnUnauthorized(p.CredentialSource, p.OnUnauthorized)
+}
+
 func getClient(client *http.Client) *http.Client {
 	if client == nil {
 		return http.DefaultClient
@@ -468,9 +537,8 @@ func createBasicAuthHTTPClient( //nolint:ireturn
 	unauth UnauthorizedHandler,
 	isUnauth IsUnauthorizedDecider,
 	info *ProviderInfo,
-	user string,
-	pass string,
-	options []common.HeaderAuthClientOption,
+	cfg *BasicParams,
+	source common.CredentialSource,
 ) (common.AuthenticatedHTTPClient, error) {
 	opts := []common.HeaderAuthClientOption{
 		common.WithHeaderClient(getClient(client)),
@@ -499,13 +567,19 @@ func createBasicAuthHTTPClient( //nolint:ireturn
 				}))
 	}
 
-	if len(options) > 0 {
-		opts = append(opts, options...)
+	if cfg != nil && len(cfg.Options) > 0 {
+		opts = append(opts, cfg.Options...)
 	}
 
 	var err error
 
-	authClient, err = common.NewBasicAuthHTTPClient(ctx, user, pass, opts...)
+	if source != nil {
+		authClient, err = common.NewHeaderAuthHTTPClient(ctx,
+			append(opts, common.WithDynamicHeaders(basicHeadersFromSource(source)))...)
+	} else {
+		authClient, err = common.NewBasicAuthHTTPClient(ctx, cfg.User, cfg.Pass, opts...)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to create basic auth client: %w", ErrClient, err)
 	}
@@ -651,7 +725,68 @@ func createCustomHTTPClient(ctx context.Context, //nolint:funlen,cyclop
 	isUnauth IsUnauthorizedDecider,
 	info *ProviderInfo,
 	cfg *CustomAuthParams,
+	source common.CredentialSource,
 ) (common.AuthenticatedHTTPClient, error) {
+	var opts []common.CustomAuthClientOption
+
+	if source != nil {
+		// Headers and query parameters are evaluated on every request.
+		opts = append(opts, common.WithCustomDynamicAuth(info.customAuthFromSource(source)))
+	} else {
+		staticOpts, err := getStaticCustomAuthOptions(info, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, staticOpts...)
+	}
+
+	opts = append(opts, common.WithCustomClient(getClient(client)))
+
+	if dbg {
+		opts = append(opts, common.WithCustomDebug(common.PrintRequestAndResponse))
+	}
+
+	var customClient common.AuthenticatedHTTPClient
+
+	if isUnauth != nil {
+		opts = append(opts, common.WithCustomIsUnauthorizedHandler(isUnauth))
+	}
+
+	if unauth != nil {
+		opts = append(opts,
+			common.WithCustomUnauthorizedHandler(
+				func(
+					hdrs []common.Header,
+					params []common.QueryParam,
+					req *http.Request,
+					rsp *http.Response,
+				) (*http.Response, error) {
+					return unauth(customClient, &UnauthorizedEvent{
+						Headers:     hdrs,
+						QueryParams: params,
+						Provider:    info,
+						Request:     req,
+						Response:    rsp,
+					})
+				}))
+	}
+
+	if cfg != nil && len(cfg.Options) > 0 {
+		opts = append(opts, cfg.Options...)
+	}
+
+	var err error
+
+	customClient, err = common.NewCustomAuthHTTPClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create custom auth client: %w", ErrClient, err)
+	}
+
+	return customClient, nil
+}
+
+func getStaticCustomAuthOptions(info *ProviderInfo, cfg *CustomAuthParams) ([]common.CustomAuthClientOption, error) {
 	// Make sure that all the inputs are provided in the config values.
 	for _, input := range info.CustomOpts.Inputs {
 		val, ok := cfg.Values[input.Name]
@@ -683,13 +818,40 @@ func createCustomHTTPClient(ctx context.Context, //nolint:funlen,cyclop
 		opts = append(opts, common.WithCustomQueryParams(queryParams...))
 	}
 
-	opts = append(opts, common.WithCustomClient(getClient(client)))
+	return opts, nil
+}
+
+func createOAuth1HTTPClient( //nolint:ireturn
+	ctx context.Context,
+	client *http.Client,
+	dbg bool,
+	unauth UnauthorizedHandler,
+	isUnauth IsUnauthorizedDecider,
+	info *ProviderInfo,
+	cfg *OAuth1Params,
+) (common.AuthenticatedHTTPClient, error) {
+	oauth1Opts := info.Oauth1Options()
+
+	signer := &common.OAuth1Signer{
+		Credentials: common.OAuth1Credentials{
+			ConsumerKey:    cfg.ConsumerKey,
+			ConsumerSecret: cfg.ConsumerSecret,
+			Token:          cfg.Token,
+			TokenSecret:    cfg.TokenSecret,
+		},
+		Method: oauth1Opts.SignatureMethod,
+		Realm:  info.oauth1Realm(oauth1Opts, cfg),
+	}
+
+	opts := []common.CustomAuthClientOption{
+		common.WithCustomClient(getClient(client)),
+	}
 
 	if dbg {
 		opts = append(opts, common.WithCustomDebug(common.PrintRequestAndResponse))
 	}
 
-	var customClient common.AuthenticatedHTTPClient
+	var authClient common.AuthenticatedHTTPClient
 
 	if isUnauth != nil {
 		opts = append(opts, common.WithCustomIsUnauthorizedHandler(isUnauth))
@@ -704,7 +866,7 @@ func createCustomHTTPClient(ctx context.Context, //nolint:funlen,cyclop
 					req *http.Request,
 					rsp *http.Response,
 				) (*http.Response, error) {
-					return unauth(customClient, &UnauthorizedEvent{
+					return unauth(authClient, &UnauthorizedEvent{
 						Headers:     hdrs,
 						QueryParams: params,
 						Provider:    info,
@@ -718,12 +880,14 @@ func createCustomHTTPClient(ctx context.Context, //nolint:funlen,cyclop
 		opts = append(opts, cfg.Options...)
 	}
 
-	customClient, err = common.NewCustomAuthHTTPClient(ctx, opts...)
+	var err error
+
+	authClient, err = common.NewOAuth1HTTPClient(ctx, signer, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create custom auth client: %w", ErrClient, err)
+		return nil, fmt.Errorf("%w: failed to create oauth1 client: %w", ErrClient, err)
 	}
 
-	return customClient, nil
+	return authClient, nil
 }
 
 func getCustomParams(info *ProviderInfo, cfg *CustomAuthParams) (common.QueryParams, error) {
@@ -794,7 +958,12 @@ func createApiKeyHTTPClient( //nolint:ireturn,cyclop,funlen
 	isUnauth IsUnauthorizedDecider,
 	info *ProviderInfo,
 	cfg *ApiKeyParams,
+	source common.CredentialSource,
 ) (common.AuthenticatedHTTPClient, error) {
+	if cfg == nil {
+		cfg = &ApiKeyParams{}
+	}
+
 	apiKey := cfg.Key
 
 	switch info.ApiKeyOpts.AttachmentType {
@@ -836,7 +1005,13 @@ func createApiKeyHTTPClient( //nolint:ireturn,cyclop,funlen
 
 		var err error
 
-		authClient, err = common.NewApiKeyHeaderAuthHTTPClient(ctx, info.ApiKeyOpts.Header.Name, apiKey, opts...)
+		if source != nil {
+			authClient, err = common.NewHeaderAuthHTTPClient(ctx,
+				append(opts, common.WithDynamicHeaders(info.apiKeyHeadersFromSource(source)))...)
+		} else {
+			authClient, err = common.NewApiKeyHeaderAuthHTTPClient(ctx, info.ApiKeyOpts.Header.Name, apiKey, opts...)
+		}
+
 		if err != nil {
 			return nil, fmt.Errorf("%w: failed to create api key client: %w", ErrClient, err)
 		}
@@ -876,7 +1051,13 @@ func createApiKeyHTTPClient( //nolint:ireturn,cyclop,funlen
 
 		var err error
 
-		authClient, err = common.NewApiKeyQueryParamAuthHTTPClient(ctx, info.ApiKeyOpts.Query.Name, apiKey, opts...)
+		if source != nil {
+			authClient, err = common.NewQueryParamAuthHTTPClient(ctx,
+				append(opts, common.WithDynamicQueryParams(info.apiKeyQueryParamsFromSource(source)))...)
+		} else {
+			authClient, err = common.NewApiKeyQueryParamAuthHTTPClient(ctx, info.ApiKeyOpts.Query.Name, apiKey, opts...)
+		}
+
 		if err != nil {
 			return nil, fmt.Errorf("%w: failed to create api key client: %w", ErrClient, err)
 		}
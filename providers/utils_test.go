@@ -1,7 +1,10 @@
 package providers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
@@ -357,6 +360,16 @@ func TestReadModuleInfo(t *testing.T) { // nolint:funlen,maintidx
 							ObjectRecordLimits: nil,
 							Supported:          true,
 						},
+						Upsert: BatchWriteSupportConfig{
+							DefaultRecordLimit: goutils.Pointer(100), // nolint:mnd
+							ObjectRecordLimits: nil,
+							Supported:          true,
+						},
+						Delete: BatchWriteSupportConfig{
+							DefaultRecordLimit: goutils.Pointer(100), // nolint:mnd
+							ObjectRecordLimits: nil,
+							Supported:          true,
+						},
 					},
 					Read:  true,
 					Write: true,
@@ -424,6 +437,16 @@ func TestReadModuleInfo(t *testing.T) { // nolint:funlen,maintidx
 							ObjectRecordLimits: nil,
 							Supported:          true,
 						},
+						Upsert: BatchWriteSupportConfig{
+							DefaultRecordLimit: goutils.Pointer(100), // nolint:mnd
+							ObjectRecordLimits: nil,
+							Supported:          true,
+						},
+						Delete: BatchWriteSupportConfig{
+							DefaultRecordLimit: goutils.Pointer(100), // nolint:mnd
+							ObjectRecordLimits: nil,
+							Supported:          true,
+						},
 					},
 					Read:  true,
 					Write: true,
@@ -527,3 +550,54 @@ func createCatalogVars(pairs ...string) []catalogreplacer.CatalogVariable {
 
 	return result
 }
+
+func TestNewClientOAuth1(t *testing.T) {
+	t.Parallel()
+
+	var authorization string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	info, err := ReadInfo(Netsuite, catalogreplacer.CustomCatalogVariable{Plan: catalogreplacer.SubstitutionPlan{
+		From: "workspace",
+		To:   "1234567-sb1",
+	}})
+	if err != nil {
+		t.Fatalf("failed to read info: %v", err)
+	}
+
+	client, err := info.NewClient(context.Background(), &NewClientParams{
+		Client: server.Client(),
+		OAuth1Creds: &OAuth1Params{
+			ConsumerKey:    "key",
+			ConsumerSecret: "secret",
+			Token:          "token",
+			TokenSecret:    "tokenSecret",
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	rsp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("failed to send request: %v", err)
+	}
+
+	_ = rsp.Body.Close()
+
+	if !strings.HasPrefix(authorization, `OAuth realm="1234567_SB1", oauth_consumer_key="key", `) ||
+		!strings.Contains(authorization, `oauth_signature_method="HMAC-SHA256"`) {
+		t.Fatalf("unexpected authorization header: %s", authorization)
+	}
+}
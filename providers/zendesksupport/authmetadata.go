@@ -0,0 +1,80 @@
+package zendesksupport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/logging"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+)
+
+var (
+	ErrDiscoveryFailure   = errors.New("failed to collect post authentication data")
+	ErrAnonymousUser      = errors.New("token is not authorized for any user")
+	ErrWorkspaceMismatch  = errors.New("token belongs to a different subdomain than the workspace")
+	ErrMissingUserAddress = errors.New("user profile has no address")
+)
+
+// GetPostAuthInfo confirms that the token was issued by the subdomain given as the workspace.
+// Zendesk answers requests of unknown users as anonymous, therefore the user must be resolved.
+func (c *Connector) GetPostAuthInfo(ctx context.Context) (*common.PostAuthInfo, error) {
+	subdomain, rsp, err := c.retrieveSubdomain(ctx)
+	if err != nil {
+		return nil, errors.Join(ErrDiscoveryFailure, err)
+	}
+
+	if !strings.EqualFold(subdomain, c.workspace) {
+		return nil, errors.Join(ErrDiscoveryFailure,
+			fmt.Errorf("%w: %s is not %s", ErrWorkspaceMismatch, subdomain, c.workspace))
+	}
+
+	return &common.PostAuthInfo{
+		RawResponse:          rsp,
+		ProviderWorkspaceRef: subdomain,
+	}, nil
+}
+
+type currentUserResponse struct {
+	User struct {
+		ID  *int64 `json:"id"`
+		URL string `json:"url"`
+	} `json:"user"`
+}
+
+// retrieveSubdomain reads the subdomain from the address of the authorized user.
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#show-self
+func (c *Connector) retrieveSubdomain(ctx context.Context) (string, *common.JSONHTTPResponse, error) {
+	ctx = logging.With(ctx, "connector", "zendeskSupport")
+
+	link, err := urlbuilder.New(c.BaseURL, apiVersion, "users/me")
+	if err != nil {
+		return "", nil, err
+	}
+
+	rsp, err := c.Client.Get(ctx, link.String())
+	if err != nil {
+		return "", nil, err
+	}
+
+	user, err := common.UnmarshalJSON[currentUserResponse](rsp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if user == nil || user.User.ID == nil {
+		return "", nil, ErrAnonymousUser
+	}
+
+	address, err := url.Parse(user.User.URL)
+	if err != nil || address.Hostname() == "" {
+		return "", nil, ErrMissingUserAddress
+	}
+
+	subdomain, _, _ := strings.Cut(address.Hostname(), ".")
+
+	return subdomain, rsp, nil
+}
@@ -0,0 +1,73 @@
+package zendesksupport
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+)
+
+func TestGetPostAuthInfo(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		response     string
+		expectedErrs []error
+	}{
+		{
+			name:     "Token of the workspace",
+			response: `{"user":{"id":35436,"url":"https://test-workspace.zendesk.com/api/v2/users/35436.json"}}`,
+		},
+		{
+			name:         "Token of another subdomain",
+			response:     `{"user":{"id":35436,"url":"https://acme.zendesk.com/api/v2/users/35436.json"}}`,
+			expectedErrs: []error{ErrDiscoveryFailure, ErrWorkspaceMismatch},
+		},
+		{
+			name:         "Anonymous user",
+			response:     `{"user":{"id":null,"url":null}}`,
+			expectedErrs: []error{ErrDiscoveryFailure, ErrAnonymousUser},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/api/v2/users/me"),
+				Then:  mockserver.ResponseString(http.StatusOK, tt.response),
+			}.Server()
+			defer server.Close()
+
+			connector, err := constructTestConnector(server.URL)
+			if err != nil {
+				t.Fatalf("%s: failed to setup connector: %v", tt.name, err)
+			}
+
+			output, err := connector.GetPostAuthInfo(t.Context())
+
+			for _, expectedErr := range tt.expectedErrs {
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("%s: expected error (%v), got (%v)", tt.name, expectedErr, err)
+				}
+			}
+
+			if len(tt.expectedErrs) != 0 {
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s: expected no errors, got: (%v)", tt.name, err)
+			}
+
+			if output.ProviderWorkspaceRef != "test-workspace" || output.CatalogVars != nil {
+				t.Fatalf("%s: unexpected output (%v)", tt.name, output)
+			}
+		})
+	}
+}
@@ -15,8 +15,9 @@ const apiVersion = "/api/v2"
 // https://developer.zendesk.com/api-reference/ticketing/introduction/
 // https://developer.zendesk.com/api-reference/help_center/help-center-api/introduction/
 type Connector struct {
-	BaseURL string
-	Client  *common.JSONHTTPClient
+	BaseURL   string
+	Client    *common.JSONHTTPClient
+	workspace string
 }
 
 func NewConnector(opts ...Option) (conn *Connector, outErr error) {
@@ -30,6 +31,7 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		Client: &common.JSONHTTPClient{
 			HTTPClient: httpClient,
 		},
+		workspace: params.Workspace.Name,
 	}
 
 	providerInfo, err := providers.ReadInfo(conn.Provider(), &params.Workspace)
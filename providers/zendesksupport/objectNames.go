@@ -9,6 +9,12 @@ import (
 const (
 	objectNameTickets  = "tickets"
 	objectNameRequests = "requests"
+	objectNameUsers    = "users"
+
+	objectNameDeletedTickets = "deleted_tickets"
+	objectNameDeletedUsers   = "deleted_users"
+
+	ticketStatusDeleted = "deleted"
 )
 
 // Supported object names can be found under schemas.json.
@@ -40,3 +46,16 @@ var objectsWithCustomFields = map[common.ModuleID]datautils.StringSet{ // nolint
 		objectNameRequests,
 	),
 }
+
+// deletedObjects lists removed records of the object, used when ReadParams.Deleted is set.
+var deletedObjects = map[string]string{ // nolint:gochecknoglobals
+	// https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-deleted-tickets
+	objectNameTickets: objectNameDeletedTickets,
+	// https://developer.zendesk.com/api-reference/ticketing/users/users/#list-deleted-users
+	objectNameUsers: objectNameDeletedUsers,
+}
+
+var deletedObjectNames = datautils.NewStringSet( // nolint:gochecknoglobals
+	objectNameDeletedTickets,
+	objectNameDeletedUsers,
+)
@@ -52,6 +52,33 @@ func getNextRecordsURL(node *ajson.Node) (string, error) {
 	return jsonquery.New(node).StrWithDefault("after_url", "")
 }
 
+// getNextCursor returns the cursor of cursor-based incremental exports.
+// The stream end is reached when no records were updated past the cursor.
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#pagination
+func getNextCursor(node *ajson.Node) (string, error) {
+	isStreamEnd, err := jsonquery.New(node).BoolWithDefault("end_of_stream", false)
+	if err != nil {
+		return "", err
+	}
+
+	if isStreamEnd {
+		return "", nil
+	}
+
+	return jsonquery.New(node).StrWithDefault("after_cursor", "")
+}
+
+// getSyncToken returns the export cursor regardless of the stream end.
+// Starting from this cursor the next incremental read will get only newer changes.
+func getSyncToken(rsp *common.JSONHTTPResponse) (string, error) {
+	body, ok := rsp.Body()
+	if !ok {
+		return "", common.ErrEmptyJSONHTTPResponse
+	}
+
+	return jsonquery.New(body).StrWithDefault("after_cursor", "")
+}
+
 func getRecords(objectName string) common.NodeRecordsFunc {
 	return func(node *ajson.Node) ([]*ajson.Node, error) {
 		responseFieldName := metadata.Schemas.LookupArrayFieldName(common.ModuleRoot, objectName)
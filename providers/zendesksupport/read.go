@@ -3,6 +3,7 @@ package zendesksupport
 import (
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/amp-labs/connectors/common"
@@ -10,6 +11,16 @@ import (
 	"github.com/amp-labs/connectors/providers/zendesksupport/metadata"
 )
 
+// Read fetches records of the object.
+//
+// Tickets and users are read via cursor-based incremental exports, which never skip records
+// updated within the same second. The export cursor is the next page token and, once the stream ends,
+// is reported as the sync token to resume from on the next incremental read.
+//
+// Exported tickets that were deleted are flagged as such. When ReadParams.Deleted is set,
+// deleted tickets and users are listed instead. Deleted tickets are listed from the most recently deleted,
+// the listing stops at the first ticket deleted before ReadParams.Since.
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-export-cursor-based
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	if err := config.ValidateParams(true); err != nil {
 		return nil, err
@@ -19,6 +30,12 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		return nil, common.ErrOperationNotSupportedForObject
 	}
 
+	if config.Deleted {
+		if deletedObjectName, ok := deletedObjects[config.ObjectName]; ok {
+			config.ObjectName = deletedObjectName
+		}
+	}
+
 	url, err := c.buildReadURL(config)
 	if err != nil {
 		return nil, err
@@ -34,22 +51,49 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		return nil, err
 	}
 
-	return common.ParseResult(
+	nextPageFunc := getNextRecordsURL
+	if isCursorExport(config.ObjectName) {
+		nextPageFunc = getNextCursor
+	}
+
+	result, err := common.ParseResult(
 		rsp,
 		getRecords(config.ObjectName),
-		getNextRecordsURL,
+		nextPageFunc,
 		common.MakeMarshaledDataFunc(c.attachReadCustomFields(customFields)),
 		config.Fields,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	markDeletedRecords(config.ObjectName, result)
+
+	if config.ObjectName == objectNameDeletedTickets {
+		skipTicketsDeletedBefore(config.Since, result)
+	}
+
+	if err = attachSideloads(rsp, config.AssociatedObjects, result); err != nil {
+		return nil, err
+	}
+
+	if isCursorExport(config.ObjectName) && result.Done {
+		// The cursor of the last page resumes the stream on the next read.
+		result.SyncToken, err = getSyncToken(rsp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 
 func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, error) {
-	if len(config.NextPage) != 0 {
+	if len(config.NextPage) != 0 && !isCursorExport(config.ObjectName) {
 		// Next page
 		return urlbuilder.New(config.NextPage.String())
 	}
 
-	// First page
 	url, err := c.getReadURL(config.ObjectName)
 	if err != nil {
 		return nil, err
@@ -58,14 +102,39 @@ func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, err
 	pageSizeStr := metadata.Schemas.PageSize(config.ObjectName)
 	isIncremental := metadata.Schemas.IsIncrementalRead(config.ObjectName)
 
-	if isIncremental {
+	if len(config.AssociatedObjects) != 0 {
+		// https://developer.zendesk.com/documentation/ticketing/using-the-zendesk-api/side_loading/
+		url.WithQueryParam("include", strings.Join(config.AssociatedObjects, ","))
+	}
+
+	switch {
+	case isCursorExport(config.ObjectName):
+		// Cursor stays valid across reads, therefore it either continues this read or resumes the last one.
+		// https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#cursor-based-incremental-exports
+		switch {
+		case len(config.NextPage) != 0:
+			url.WithQueryParam("cursor", config.NextPage.String())
+		case config.SyncToken != "":
+			url.WithQueryParam("cursor", config.SyncToken)
+		default:
+			url.WithQueryParam("start_time", formatStartTime(config))
+		}
+
+		url.WithQueryParam("per_page", pageSizeStr)
+	case isIncremental:
 		// Incremental endpoints requires start query parameter.
 		// Even if no Since parameter is empty the start_time must be set to 0.
 		// This is effectively to say read everything since the beginning of time.
 		// https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#start_time
 		url.WithQueryParam("start_time", formatStartTime(config))
 		url.WithQueryParam("per_page", pageSizeStr)
-	} else {
+	case config.ObjectName == objectNameDeletedTickets:
+		// Listing has no time filter, the newest come first so that it can stop at Since.
+		// https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-deleted-tickets
+		url.WithQueryParam("sort_by", "deleted_at")
+		url.WithQueryParam("sort_order", "desc")
+		url.WithQueryParam("page[size]", pageSizeStr)
+	default:
 		// Different objects have different pagination types.
 		// https://developer.zendesk.com/api-reference/introduction/pagination/#using-offset-pagination
 		ptype := metadata.Schemas.LookupPaginationType(config.ObjectName)
@@ -77,6 +146,57 @@ func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, err
 	return url, nil
 }
 
+// isCursorExport returns true for objects read via cursor-based incremental exports.
+func isCursorExport(objectName string) bool {
+	return metadata.Schemas.IsIncrementalRead(objectName) &&
+		metadata.Schemas.LookupPaginationType(objectName) == "cursor"
+}
+
+// markDeletedRecords flags records which were removed in Zendesk.
+// Incremental ticket export returns deleted tickets with the "deleted" status,
+// while deleted object listings contain nothing else.
+func markDeletedRecords(objectName string, result *common.ReadResult) {
+	listsDeleted := deletedObjectNames.Has(objectName)
+
+	for index, row := range result.Data {
+		if listsDeleted || row.Raw["status"] == ticketStatusDeleted {
+			result.Data[index].Deleted = true
+		}
+	}
+}
+
+// skipTicketsDeletedBefore drops tickets deleted before the given time.
+// Tickets are sorted by deletion time, therefore the remaining pages are not needed either.
+func skipTicketsDeletedBefore(since time.Time, result *common.ReadResult) {
+	if since.IsZero() {
+		return
+	}
+
+	rows := make([]common.ReadResultRow, 0, len(result.Data))
+
+	for _, row := range result.Data {
+		deletedAt, ok := row.Raw["deleted_at"].(string)
+		if !ok {
+			rows = append(rows, row)
+
+			continue
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, deletedAt)
+		if err == nil && timestamp.Before(since) {
+			result.NextPage = ""
+			result.Done = true
+
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	result.Data = rows
+	result.Rows = int64(len(rows))
+}
+
 func formatStartTime(config common.ReadParams) string {
 	if config.Since.IsZero() {
 		return "0"
@@ -3,6 +3,7 @@ package zendesksupport
 import (
 	"errors"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -151,6 +152,39 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Deleted tickets stop at the first ticket deleted before since",
+			Input: common.ReadParams{
+				ObjectName: "tickets",
+				Fields:     connectors.Fields("id"),
+				Since:      time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC),
+				Deleted:    true,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.Path("/api/v2/deleted_tickets"),
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"deleted_tickets": [
+						{"id": 9, "deleted_at": "2024-12-26T08:00:00Z"},
+						{"id": 4, "deleted_at": "2024-12-20T08:00:00Z"}
+					],
+					"meta": {"has_more": true, "after_cursor": "xyz"},
+					"links": {"next": "https://test-workspace.zendesk.com/api/v2/deleted_tickets?page[after]=xyz"}
+				}`),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields:  map[string]any{"id": float64(9)},
+					Raw:     map[string]any{"deleted_at": "2024-12-26T08:00:00Z"},
+					Deleted: true,
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -173,6 +207,8 @@ func TestIncrementalRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintid
 	responseUsersFirstPage := testutils.DataFromFile(t, "read/incremental/users-1-first-page.json")
 	responseUsersLastPage := testutils.DataFromFile(t, "read/incremental/users-2-last-page.json")
 	responseOrganizations := testutils.DataFromFile(t, "read/incremental/organizations.json")
+	responseTicketsSideloads := testutils.DataFromFile(t, "read/incremental/tickets-sideloads.json")
+	responseDeletedTickets := testutils.DataFromFile(t, "read/deleted/deleted-tickets.json")
 
 	tests := []testroutines.Read{
 		{
@@ -269,7 +305,8 @@ func TestIncrementalRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintid
 					Fields: map[string]any{"name": "The Customer"},
 					Raw:    map[string]any{"email": "customer@example.com"},
 				}},
-				NextPage: "https://d3v-ampersand.zendesk.com/api/v2/incremental/users/cursor?cursor=MTczOTkwMTY5OC4wfHwzODYzNDM4MTYyMDM3MXw%3D&per_page=19", // nolint:lll
+				// Export cursor is the next page token.
+				NextPage: "MTczOTkwMTY5OC4wfHwzODYzNDM4MTYyMDM3MXw=",
 				Done:     false,
 			},
 			ExpectedErrs: nil,
@@ -324,6 +361,131 @@ func TestIncrementalRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintid
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Tickets next page continues from the cursor with sideloaded users and groups",
+			Input: common.ReadParams{
+				ObjectName:        "tickets",
+				Fields:            connectors.Fields("subject"),
+				NextPage:          "MTczNTEwODQzNC4wfHwyM3w=",
+				AssociatedObjects: []string{"users", "groups"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.Path("/api/v2/incremental/tickets/cursor"),
+						mockcond.QueryParam("cursor", "MTczNTEwODQzNC4wfHwyM3w="),
+						mockcond.QueryParam("include", "users,groups"),
+						mockcond.QueryParam("per_page", "2000"),
+						mockcond.QueryParamsMissing("start_time"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseTicketsSideloads),
+				}, {
+					If:   mockcond.Path("/api/v2/ticket_fields"),
+					Then: mockserver.Response(http.StatusOK, responseTicketsCustomFields),
+				}},
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return testroutines.ComparatorSubsetChanges(serverURL, actual, expected) &&
+					ticketAssociations(actual.Data[0]) == ticketAssociations(expected.Data[0])
+			},
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"subject": "Printer is on fire"},
+					Raw:    map[string]any{"status": "open"},
+					Associations: map[string][]common.Association{
+						"users": {
+							{ObjectId: "26363656426259", AssociationType: "requester"},
+							{ObjectId: "26363656426259", AssociationType: "submitter"},
+							{ObjectId: "26363624373267", AssociationType: "assignee"},
+						},
+						"groups": {
+							{ObjectId: "26363643448211", AssociationType: "group"},
+						},
+					},
+				}, {
+					// Exported tickets include the deleted ones.
+					Fields:  map[string]any{"subject": "Spam"},
+					Raw:     map[string]any{"status": "deleted"},
+					Deleted: true,
+				}},
+				NextPage: "MTczNTEwODQzNC4wfHw4fA==",
+				Done:     false,
+				// Sync token is given once the stream ends.
+				SyncToken: "",
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Tickets are resumed from the sync token",
+			Input: common.ReadParams{
+				ObjectName: "tickets",
+				Fields:     connectors.Fields("id"),
+				Since:      time.Unix(1726674883, 0),
+				SyncToken:  "MTczMzEwODQzNC4wfHwyMHw=",
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.Path("/api/v2/incremental/tickets/cursor"),
+						mockcond.QueryParam("cursor", "MTczMzEwODQzNC4wfHwyMHw="),
+						mockcond.QueryParamsMissing("start_time"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseTickets),
+				}, {
+					If:   mockcond.Path("/api/v2/ticket_fields"),
+					Then: mockserver.Response(http.StatusOK, responseTicketsCustomFields),
+				}},
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": float64(5)},
+					Raw:    map[string]any{"status": "open"},
+				}},
+				// Stream has ended, the cursor is kept for the next read.
+				NextPage:  "",
+				Done:      true,
+				SyncToken: "MTczNTEwODQzNC4wfHwyM3w=",
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Deleted tickets are listed",
+			Input: common.ReadParams{
+				ObjectName: "tickets",
+				Fields:     connectors.Fields("id", "deleted_at"),
+				Deleted:    true,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.Path("/api/v2/deleted_tickets"),
+					mockcond.QueryParam("page[size]", "100"),
+					mockcond.QueryParam("sort_by", "deleted_at"),
+					mockcond.QueryParam("sort_order", "desc"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseDeletedTickets),
+			}.Server(),
+			Comparator: testroutines.ComparatorSubsetChanges,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"id":         float64(9),
+						"deleted_at": "2024-12-26T08:00:00Z",
+					},
+					Raw:     map[string]any{"previous_state": "open"},
+					Deleted: true,
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -352,3 +514,16 @@ func constructTestConnector(serverURL string) (*Connector, error) {
 
 	return connector, nil
 }
+
+// ticketAssociations summarizes associations as text, ignoring sideloaded payloads.
+func ticketAssociations(row common.ReadResultRow) string {
+	var summary strings.Builder
+
+	for _, name := range []string{"users", "groups"} {
+		for _, association := range row.Associations[name] {
+			summary.WriteString(name + ":" + association.AssociationType + ":" + association.ObjectId + ";")
+		}
+	}
+
+	return summary.String()
+}
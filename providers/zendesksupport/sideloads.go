@@ -0,0 +1,138 @@
+package zendesksupport
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/internal/jsonquery"
+)
+
+// sideloadReferences lists fields of the record referencing sideloaded objects.
+// Sideloads not listed here are matched by the "<singular>_id" field, e.g. "brand_id" for "brands".
+// https://developer.zendesk.com/documentation/ticketing/using-the-zendesk-api/side_loading/#supported-endpoints
+var sideloadReferences = map[string][]string{ // nolint:gochecknoglobals
+	"users": {
+		"requester_id",
+		"submitter_id",
+		"assignee_id",
+		"collaborator_ids",
+		"follower_ids",
+	},
+}
+
+// attachSideloads connects records with related objects which were included in the response.
+// Each sideload is a top level array of the response named after the requested object.
+func attachSideloads(rsp *common.JSONHTTPResponse, sideloads []string, result *common.ReadResult) error {
+	if len(sideloads) == 0 || len(result.Data) == 0 {
+		return nil
+	}
+
+	body, ok := rsp.Body()
+	if !ok {
+		return common.ErrEmptyJSONHTTPResponse
+	}
+
+	for _, sideload := range sideloads {
+		nodes, err := jsonquery.New(body).ArrayOptional(sideload)
+		if err != nil {
+			return err
+		}
+
+		records, err := jsonquery.Convertor.ArrayToMap(nodes)
+		if err != nil {
+			return err
+		}
+
+		recordsByID := make(map[string]map[string]any, len(records))
+		for _, record := range records {
+			recordsByID[formatID(record["id"])] = record
+		}
+
+		for index, row := range result.Data {
+			associations := findSideloadAssociations(row.Raw, sideload, recordsByID)
+			if len(associations) == 0 {
+				continue
+			}
+
+			if result.Data[index].Associations == nil {
+				result.Data[index].Associations = make(map[string][]common.Association)
+			}
+
+			result.Data[index].Associations[sideload] = associations
+		}
+	}
+
+	return nil
+}
+
+// findSideloadAssociations follows reference fields of the record.
+// The association type is the name of the reference, e.g. "requester" or "group".
+func findSideloadAssociations(
+	raw map[string]any, sideload string, recordsByID map[string]map[string]any,
+) []common.Association {
+	references, ok := sideloadReferences[sideload]
+	if !ok {
+		references = []string{naming.NewPluralString(sideload).Singular().String() + "_id"}
+	}
+
+	associations := make([]common.Association, 0)
+
+	for _, reference := range references {
+		for _, identifier := range referencedIDs(raw[reference]) {
+			record, found := recordsByID[identifier]
+			if !found {
+				continue
+			}
+
+			associations = append(associations, common.Association{
+				ObjectId:        identifier,
+				AssociationType: referenceName(reference),
+				Raw:             record,
+			})
+		}
+	}
+
+	return associations
+}
+
+// referencedIDs handles references to a single record as well as lists of identifiers.
+func referencedIDs(value any) []string {
+	list, ok := value.([]any)
+	if !ok {
+		list = []any{value}
+	}
+
+	identifiers := make([]string, 0, len(list))
+
+	for _, item := range list {
+		if identifier := formatID(item); identifier != "" {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+
+	return identifiers
+}
+
+func referenceName(field string) string {
+	if name, found := strings.CutSuffix(field, "_ids"); found {
+		return name
+	}
+
+	name, _ := strings.CutSuffix(field, "_id")
+
+	return name
+}
+
+// formatID converts numeric identifiers of JSON records to text.
+func formatID(value any) string {
+	switch identifier := value.(type) {
+	case float64:
+		return strconv.FormatFloat(identifier, 'f', -1, 64)
+	case string:
+		return identifier
+	default:
+		return ""
+	}
+}
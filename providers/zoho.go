@@ -112,5 +112,51 @@ func init() {
 				},
 			},
 		},
+		// The data center of the account is named by the OAuth callback ("location", "accounts-server")
+		// or by the token response ("api_domain"), the remaining domains are derived from it.
+		Metadata: &ProviderMetadata{
+			PostAuthentication: []MetadataItemPostAuthentication{
+				{
+					Name: "zoho_location",
+					ModuleDependencies: &ModuleDependencies{
+						ModuleZohoCRM:             ModuleDependency{},
+						ModuleZohoDesk:            ModuleDependency{},
+						ModuleZohoServiceDeskPlus: ModuleDependency{},
+					},
+				},
+				{
+					Name: "zoho_api_domain",
+					ModuleDependencies: &ModuleDependencies{
+						ModuleZohoCRM:             ModuleDependency{},
+						ModuleZohoDesk:            ModuleDependency{},
+						ModuleZohoServiceDeskPlus: ModuleDependency{},
+					},
+				},
+				{
+					Name: "zoho_desk_domain",
+					ModuleDependencies: &ModuleDependencies{
+						ModuleZohoCRM:             ModuleDependency{},
+						ModuleZohoDesk:            ModuleDependency{},
+						ModuleZohoServiceDeskPlus: ModuleDependency{},
+					},
+				},
+				{
+					Name: "zoho_token_domain",
+					ModuleDependencies: &ModuleDependencies{
+						ModuleZohoCRM:             ModuleDependency{},
+						ModuleZohoDesk:            ModuleDependency{},
+						ModuleZohoServiceDeskPlus: ModuleDependency{},
+					},
+				},
+				{
+					Name: "zoho_servicedeskplus_domain",
+					ModuleDependencies: &ModuleDependencies{
+						ModuleZohoCRM:             ModuleDependency{},
+						ModuleZohoDesk:            ModuleDependency{},
+						ModuleZohoServiceDeskPlus: ModuleDependency{},
+					},
+				},
+			},
+		},
 	})
 }
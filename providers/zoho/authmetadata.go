@@ -0,0 +1,19 @@
+package zoho
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// GetPostAuthInfo returns the data center hosting the account along with its domains.
+// Zoho accounts live in a single data center, whose API rejects tokens issued by other data centers.
+// The data center is named by the OAuth callback ("location" and "accounts-server" parameters)
+// and by the token response ("api_domain"), which are passed to the connector as metadata.
+// No request is sent, the access token is never offered to other data centers.
+// https://www.zoho.com/crm/developer/docs/api/v6/multi-dc.html
+func (c *Connector) GetPostAuthInfo(_ context.Context) (*common.PostAuthInfo, error) {
+	return &common.PostAuthInfo{
+		CatalogVars: c.authMetadata.AsMap(),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package zoho
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+)
+
+func TestGetPostAuthInfo(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		metadata     map[string]string
+		expected     *map[string]string
+		expectedErrs []error
+	}{
+		{
+			name:     "Location of the OAuth callback",
+			metadata: map[string]string{"zoho_location": "eu"},
+			expected: &map[string]string{
+				"zoho_location":               "eu",
+				"zoho_api_domain":             "www.zohoapis.eu",
+				"zoho_desk_domain":            "desk.zoho.eu",
+				"zoho_token_domain":           "accounts.zoho.eu",
+				"zoho_servicedeskplus_domain": "sdpondemand.manageengine.eu",
+			},
+		},
+		{
+			name:     "Accounts server of the OAuth callback",
+			metadata: map[string]string{"zoho_token_domain": "https://accounts.zoho.in"},
+			expected: &map[string]string{
+				"zoho_location":               "in",
+				"zoho_api_domain":             "www.zohoapis.in",
+				"zoho_desk_domain":            "desk.zoho.in",
+				"zoho_token_domain":           "accounts.zoho.in",
+				"zoho_servicedeskplus_domain": "sdpondemand.manageengine.in",
+			},
+		},
+		{
+			name:     "API domain of the token response",
+			metadata: map[string]string{"zoho_api_domain": "https://www.zohoapis.jp"},
+			expected: &map[string]string{
+				"zoho_location":               "jp",
+				"zoho_api_domain":             "www.zohoapis.jp",
+				"zoho_desk_domain":            "desk.zoho.jp",
+				"zoho_token_domain":           "accounts.zoho.jp",
+				"zoho_servicedeskplus_domain": "servicedeskplus.jp",
+			},
+		},
+		{
+			name:     "US data center is used by default",
+			metadata: map[string]string{},
+			expected: &map[string]string{
+				"zoho_location":               "us",
+				"zoho_api_domain":             "www.zohoapis.com",
+				"zoho_desk_domain":            "desk.zoho.com",
+				"zoho_token_domain":           "accounts.zoho.com",
+				"zoho_servicedeskplus_domain": "sdpondemand.manageengine.com",
+			},
+		},
+		{
+			name:         "Unknown location",
+			metadata:     map[string]string{"zoho_location": "mars"},
+			expectedErrs: []error{ErrInvalidLocation},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authMetadata, err := NewAuthMetadataVars(tt.metadata).Resolve()
+
+			for _, expectedErr := range tt.expectedErrs {
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("%s: expected error (%v), got (%v)", tt.name, expectedErr, err)
+				}
+			}
+
+			if len(tt.expectedErrs) != 0 {
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s: expected no errors, got: (%v)", tt.name, err)
+			}
+
+			// No request is sent, the client has no server to reach.
+			connector, err := NewConnector(
+				WithAuthenticatedClient(mockutils.NewClient()),
+				WithLocation(authMetadata.Location),
+				WithDomains(&authMetadata.LocationDomains),
+			)
+			if err != nil {
+				t.Fatalf("%s: failed to setup connector: %v", tt.name, err)
+			}
+
+			output, err := connector.GetPostAuthInfo(t.Context())
+			if err != nil {
+				t.Fatalf("%s: expected no errors, got: (%v)", tt.name, err)
+			}
+
+			if !reflect.DeepEqual(output.CatalogVars, tt.expected) {
+				t.Fatalf("%s: expected (%v), got (%v)", tt.name, *tt.expected, *output.CatalogVars)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package zoho
+
+import "strings"
+
+const (
+	locationKey              = "zoho_location"
+	apiDomainKey             = "zoho_api_domain"
+	deskDomainKey            = "zoho_desk_domain"
+	tokenDomainKey           = "zoho_token_domain"
+	serviceDeskPlusDomainKey = "zoho_servicedeskplus_domain"
+)
+
+// AuthMetadataVars is a complete list of authentication metadata associated with connector.
+// This model serves as a documentation of map[string]string contents.
+type AuthMetadataVars struct {
+	// Location is the data center of the account, ex: "us", "eu".
+	Location string
+	LocationDomains
+}
+
+// NewAuthMetadataVars parses map into the model.
+// Domains may be given as URLs, ex: "api_domain" of the token response is "https://www.zohoapis.eu".
+func NewAuthMetadataVars(dictionary map[string]string) *AuthMetadataVars {
+	return &AuthMetadataVars{
+		Location: dictionary[locationKey],
+		LocationDomains: LocationDomains{
+			ApiDomain:             normalizeDomain(dictionary[apiDomainKey]),
+			DeskDomain:            normalizeDomain(dictionary[deskDomainKey]),
+			ServiceDeskPlusDomain: normalizeDomain(dictionary[serviceDeskPlusDomainKey]),
+			TokenDomain:           normalizeDomain(dictionary[tokenDomainKey]),
+		},
+	}
+}
+
+// AsMap converts model back to the map.
+func (v AuthMetadataVars) AsMap() *map[string]string {
+	return &map[string]string{
+		locationKey:              v.Location,
+		apiDomainKey:             v.ApiDomain,
+		deskDomainKey:            v.DeskDomain,
+		tokenDomainKey:           v.TokenDomain,
+		serviceDeskPlusDomainKey: v.ServiceDeskPlusDomain,
+	}
+}
+
+// Resolve completes the metadata using what is known about the data center of the account.
+// The location is taken as given, otherwise it is found by the token domain ("accounts-server"
+// of the OAuth callback) or by the API domain ("api_domain" of the token response).
+// The US location is used when none is known.
+// Domains given explicitly take precedence over the ones of the location.
+func (v AuthMetadataVars) Resolve() (*AuthMetadataVars, error) {
+	location := v.findLocation()
+
+	domains, err := GetDomainsForLocation(location)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.ApiDomain != "" {
+		domains.ApiDomain = v.ApiDomain
+	}
+
+	if v.DeskDomain != "" {
+		domains.DeskDomain = v.DeskDomain
+	}
+
+	if v.TokenDomain != "" {
+		domains.TokenDomain = v.TokenDomain
+	}
+
+	if v.ServiceDeskPlusDomain != "" {
+		domains.ServiceDeskPlusDomain = v.ServiceDeskPlusDomain
+	}
+
+	return &AuthMetadataVars{
+		Location:        location,
+		LocationDomains: *domains,
+	}, nil
+}
+
+func (v AuthMetadataVars) findLocation() string {
+	if v.Location != "" {
+		return v.Location
+	}
+
+	for _, location := range locations {
+		domains, err := GetDomainsForLocation(location)
+		if err != nil {
+			continue
+		}
+
+		if (v.TokenDomain != "" && v.TokenDomain == domains.TokenDomain) ||
+			(v.ApiDomain != "" && v.ApiDomain == domains.ApiDomain) {
+			return location
+		}
+	}
+
+	return defaultLocation
+}
+
+// normalizeDomain strips the scheme of the URL naming the domain.
+func normalizeDomain(domain string) string {
+	domain = strings.TrimPrefix(domain, "https://")
+
+	return strings.ToLower(strings.TrimSuffix(domain, "/"))
+}
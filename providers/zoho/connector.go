@@ -10,9 +10,10 @@ import (
 )
 
 const (
-	crmAPIVersion   = "crm/v6"
-	deskAPIVersion  = "api/v1"
-	defaultLocation = "us"
+	crmAPIVersion             = "crm/v6"
+	deskAPIVersion            = "api/v1"
+	serviceDeskPlusAPIVersion = "api/v3"
+	defaultLocation           = "us"
 )
 
 type Connector struct {
@@ -22,6 +23,8 @@ type Connector struct {
 	moduleInfo   *providers.ModuleInfo
 	providerInfo *providers.ProviderInfo
 	moduleID     common.ModuleID
+	// authMetadata describes the data center of the account.
+	authMetadata AuthMetadataVars
 
 	// servicedeskplusAdapter handles the ServiceDesk Plus module.
 	// It provides dedicated support for ServiceDesk Plus-specific endpoints and metadata.
@@ -58,6 +61,11 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) { // nolint: f
 		domains = params.domains
 	}
 
+	conn.authMetadata = AuthMetadataVars{
+		Location:        params.location,
+		LocationDomains: *domains,
+	}
+
 	providerInfo, err := providers.ReadInfo(conn.Provider(),
 		catalogreplacer.CustomCatalogVariable{
 			Plan: catalogreplacer.SubstitutionPlan{
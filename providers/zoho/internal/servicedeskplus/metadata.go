@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/internal/simultaneously"
 )
 
@@ -48,6 +49,8 @@ func (a *Adapter) ListObjectMetadata(
 		})
 	}
 
+	ctx = governor.WithContext(ctx, a.Client.HTTPClient.Governor())
+
 	if err := simultaneously.DoCtx(ctx, -1, callbacks...); err != nil {
 		return nil, err
 	}
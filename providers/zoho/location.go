@@ -11,6 +11,9 @@ var (
 	ErrInvalidLocation = errors.New("invalid location")
 )
 
+// Data centers hosting Zoho accounts.
+var locations = []string{"us", "eu", "in", "au", "cn", "jp", "ca", "uk", "sa"} // nolint:gochecknoglobals
+
 type LocationDomains struct {
 	ApiDomain             string `json:"api_domain"`
 	DeskDomain            string `json:"desk_domain"`
@@ -73,12 +76,12 @@ func GetDomainsForLocation(location string) (*LocationDomains, error) { // nolin
 	case "uk":
 		return &LocationDomains{
 			ServiceDeskPlusDomain: "servicedeskplus.uk",
-			TokenDomain:           "accounts.zoho.sa",
+			TokenDomain:           "accounts.zoho.uk",
 		}, nil
 	case "sa":
 		return &LocationDomains{
 			ServiceDeskPlusDomain: "servicedeskplus.sa",
-			TokenDomain:           "accounts.zoho.uk",
+			TokenDomain:           "accounts.zoho.sa",
 		}, nil
 	default:
 		return nil, fmt.Errorf("%w %q; must be one of: us, eu, in, au, cn, jp, ca, uk, sa (case-insensitive)",
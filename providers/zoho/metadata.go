@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/common/naming"
 	"github.com/amp-labs/connectors/internal/goutils"
 	"github.com/amp-labs/connectors/internal/simultaneously"
@@ -129,6 +130,8 @@ func (c *Connector) ListObjectMetadata( // nolint:wsl_v5
 		})
 	}
 
+	ctx = governor.WithContext(ctx, c.Client.HTTPClient.Governor())
+
 	if err := simultaneously.DoCtx(ctx, -1, callbacks...); err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/governor"
 	"github.com/amp-labs/connectors/common/naming"
 	"github.com/amp-labs/connectors/common/urlbuilder"
 	"github.com/amp-labs/connectors/internal/datautils"
@@ -237,6 +238,8 @@ func (c *Connector) putOrPostSubscribe(
 		})
 	}
 
+	ctx = governor.WithContext(ctx, c.Client.HTTPClient.Governor())
+
 	if err := simultaneously.DoCtx(ctx, -1, callbacks...); err != nil {
 		return nil, fmt.Errorf("error processing subscription events concurrently: %w", err)
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/amp-labs/connectors/common/scanning/credscanning"
 	"github.com/amp-labs/connectors/internal/future"
 	"github.com/amp-labs/connectors/internal/goutils"
+	"github.com/amp-labs/connectors/oauthflow"
 	"github.com/amp-labs/connectors/providers"
 	"github.com/amp-labs/connectors/scripts/utils/credutils"
 	"golang.org/x/oauth2"
@@ -326,7 +327,7 @@ func setup() *OAuthApp {
 		slog.Warn("no scopes attached, ensure that the provider doesn't require scopes")
 	}
 
-	oauthScopes := strings.Split(scopes, ",")
+	oauthScopes := oauthflow.MapScopes(providerInfo.Oauth2Opts.ScopeMappings, strings.Split(scopes, ","))
 	var codeVerifier *string
 
 	switch providerInfo.Oauth2Opts.GrantType {
@@ -35,6 +35,20 @@ import (
 //		"accessToken": "**************",
 //		"refreshToken": "**************"
 //	}
+//
+// Providers accepting OAuth 1.0a signed requests, ex: netsuite, are proxied with token-based authentication
+// when the consumer key is given instead of the client ID:
+//
+//	{
+//		"provider": "netsuite",
+//		"metadata": {
+//		    "workspace": "1234567-sb1"
+//		},
+//		"consumerKey": "**************",
+//		"consumerSecret": "**************",
+//		"token": "**************",
+//		"tokenSecret": "**************"
+//	}
 
 // Remember to run the script in the same directory as the script.
 // go run proxy.go
@@ -70,6 +84,10 @@ var readers = []scanning.Reader{
 	credscanning.Fields.Username.GetJSONReader(DefaultCredsFile),
 	credscanning.Fields.Password.GetJSONReader(DefaultCredsFile),
 	credscanning.Fields.ApiSecret.GetJSONReader(DefaultCredsFile),
+	credscanning.Fields.ConsumerKey.GetJSONReader(DefaultCredsFile),
+	credscanning.Fields.ConsumerSecret.GetJSONReader(DefaultCredsFile),
+	credscanning.Fields.Token.GetJSONReader(DefaultCredsFile),
+	credscanning.Fields.TokenSecret.GetJSONReader(DefaultCredsFile),
 }
 
 var debug = flag.Bool("debug", false, "Enable debug logging")
@@ -121,6 +139,12 @@ func main() {
 func createProviderProxy(
 	ctx context.Context, info *providers.ProviderInfo, factory proxyserv.Factory,
 ) *proxyserv.Proxy {
+	// OAuth 1.0a is accepted next to the auth type of the provider.
+	if consumerKey, _ := factory.Registry.GetString(credscanning.Fields.ConsumerKey.Name); consumerKey != "" &&
+		info.Oauth1Options() != nil {
+		return factory.CreateProxyOAuth1(ctx)
+	}
+
 	switch info.AuthType {
 	case providers.Oauth2:
 		if info.Oauth2Opts == nil {
@@ -145,6 +169,8 @@ func createProviderProxy(
 		return factory.CreateProxyBasic(ctx)
 	case providers.Custom:
 		return factory.CreateProxyCustom(ctx)
+	case providers.Oauth1:
+		return factory.CreateProxyOAuth1(ctx)
 	default:
 		log.Fatalf("Unsupported auth type: %s", info.AuthType)
 	}
@@ -0,0 +1,64 @@
+package proxyserv
+
+import (
+	"context"
+	"log"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/scanning"
+	"github.com/amp-labs/connectors/common/scanning/credscanning"
+	"github.com/amp-labs/connectors/generic"
+	"github.com/amp-labs/connectors/providers"
+)
+
+func (f Factory) CreateProxyOAuth1(ctx context.Context) *Proxy {
+	params := createOAuth1Params(f.Registry)
+	providerInfo := getProviderConfig(f.Provider, f.CatalogVariables)
+	httpClient := setupOAuth1HTTPClient(ctx, providerInfo, params, f.Debug, f.Metadata)
+	baseURL := getBaseURL(providerInfo)
+
+	return newProxy(baseURL, httpClient)
+}
+
+func createOAuth1Params(registry scanning.Registry) *providers.OAuth1Params {
+	consumerKey := registry.MustString(credscanning.Fields.ConsumerKey.Name)
+	consumerSecret := registry.MustString(credscanning.Fields.ConsumerSecret.Name)
+
+	if len(consumerKey) == 0 || len(consumerSecret) == 0 {
+		log.Fatalf("Missing consumer key or consumer secret")
+	}
+
+	// Token credentials are absent for two-legged authentication.
+	token, _ := registry.GetString(credscanning.Fields.Token.Name)
+	tokenSecret, _ := registry.GetString(credscanning.Fields.TokenSecret.Name)
+
+	return &providers.OAuth1Params{
+		ConsumerKey:    consumerKey,
+		ConsumerSecret: consumerSecret,
+		Token:          token,
+		TokenSecret:    tokenSecret,
+	}
+}
+
+func setupOAuth1HTTPClient(
+	ctx context.Context, prov *providers.ProviderInfo, params *providers.OAuth1Params, debug bool,
+	metadata map[string]string,
+) common.AuthenticatedHTTPClient {
+	client, err := prov.NewClient(ctx, &providers.NewClientParams{
+		Debug:       debug,
+		OAuth1Creds: params,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	cc, err := generic.NewConnector(prov.Name,
+		generic.WithAuthenticatedClient(client),
+		generic.WithMetadata(metadata),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return cc.HTTPClient().Client
+}
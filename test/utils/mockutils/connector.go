@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/amp-labs/connectors/common/urlbuilder"
@@ -40,3 +41,38 @@ func NewClient() *http.Client {
 		},
 	}
 }
+
+// NewRedirectClient returns a client sending every request to the mock server.
+// Connectors calling several hosts, which cannot be replaced by SetBaseURL, can be tested this way.
+// The original host is kept in the Host header, use mockcond.Host to tell hosts apart.
+func NewRedirectClient(mockServerURL string) *http.Client {
+	client := NewClient()
+	client.Transport = redirectTransport{
+		target: mockServerURL,
+		next:   client.Transport,
+	}
+
+	return client
+}
+
+type redirectTransport struct {
+	target string
+	next   http.RoundTripper
+}
+
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(t.target)
+	if err != nil {
+		return nil, err
+	}
+
+	redirected := req.Clone(req.Context())
+	if redirected.Host == "" {
+		redirected.Host = req.URL.Host
+	}
+
+	redirected.URL.Scheme = target.Scheme
+	redirected.URL.Host = target.Host
+
+	return t.next.RoundTrip(redirected)
+}
@@ -13,6 +13,14 @@ func Path(expectedPath string) Check {
 	}
 }
 
+// Host returns a check expecting the request to be addressed to the host.
+// Useful with mockutils.NewRedirectClient, which keeps the original host of redirected requests.
+func Host(expectedHost string) Check {
+	return func(w http.ResponseWriter, r *http.Request) bool {
+		return r.Host == expectedHost
+	}
+}
+
 // Method returns a check expecting HTTP method name to match the template.
 func Method(methodName string) Check {
 	return func(w http.ResponseWriter, r *http.Request) bool {
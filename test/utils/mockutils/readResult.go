@@ -52,6 +52,21 @@ func (readResultComparator) SubsetFields(actual, expected *common.ReadResult) bo
 	return true
 }
 
+// ChangeState checks that sync token and per row identity and deletion flags are equal.
+func (readResultComparator) ChangeState(actual, expected *common.ReadResult) bool {
+	if actual.SyncToken != expected.SyncToken || len(actual.Data) < len(expected.Data) {
+		return false
+	}
+
+	for i := range expected.Data {
+		if actual.Data[i].Id != expected.Data[i].Id || actual.Data[i].Deleted != expected.Data[i].Deleted {
+			return false
+		}
+	}
+
+	return true
+}
+
 func invalidTest(message string) {
 	panic("invalid test, there is no point to check if empty set belongs to any set; " + message)
 }
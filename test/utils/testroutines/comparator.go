@@ -29,6 +29,13 @@ func ComparatorSubsetRead(serverURL string, actual, expected *common.ReadResult)
 		c
 }
 
+// ComparatorSubsetChanges extends ComparatorSubsetRead for incremental reads.
+// It additionally checks the sync token and which records were reported as deleted.
+func ComparatorSubsetChanges(serverURL string, actual, expected *common.ReadResult) bool {
+	return ComparatorSubsetRead(serverURL, actual, expected) &&
+		mockutils.ReadResultComparator.ChangeState(actual, expected)
+}
+
 // ComparatorPagination will check pagination related fields.
 // Note: you may use an alias for Mock-Server-URL which will be dynamically resolved at runtime.
 // Example: